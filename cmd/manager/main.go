@@ -19,7 +19,10 @@ import (
 	"github.com/integr8ly/integreatly-operator/pkg/apis"
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/integreatly-operator/pkg/controller"
+	"github.com/integr8ly/integreatly-operator/pkg/controller/installation"
 	integreatlymetrics "github.com/integr8ly/integreatly-operator/pkg/metrics"
+	// Register the built-in products with the product registry
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/all"
 	"github.com/integr8ly/integreatly-operator/pkg/webhooks"
 	"github.com/integr8ly/integreatly-operator/version"
 
@@ -57,6 +60,16 @@ func init() {
 	customMetrics.Registry.MustRegister(integreatlymetrics.RHMIInfo)
 	customMetrics.Registry.MustRegister(integreatlymetrics.RHMIVersion)
 	customMetrics.Registry.MustRegister(integreatlymetrics.RHMIStatus)
+	customMetrics.Registry.MustRegister(integreatlymetrics.ProductReconcileDuration)
+	customMetrics.Registry.MustRegister(integreatlymetrics.RHMICondition)
+	customMetrics.Registry.MustRegister(integreatlymetrics.ProductVersionDrift)
+	customMetrics.Registry.MustRegister(integreatlymetrics.UpgradeHistory)
+	customMetrics.Registry.MustRegister(integreatlymetrics.BackupSnapshots)
+	customMetrics.Registry.MustRegister(integreatlymetrics.BackupSnapshotsPruned)
+	customMetrics.Registry.MustRegister(integreatlymetrics.BackupLastVerified)
+	customMetrics.Registry.MustRegister(integreatlymetrics.BackupVerificationSuccess)
+	customMetrics.Registry.MustRegister(integreatlymetrics.UserSyncChanges)
+	customMetrics.Registry.MustRegister(integreatlymetrics.UserSyncLastTimestamp)
 	integreatlymetrics.OperatorVersion.Add(1)
 }
 
@@ -266,6 +279,36 @@ func setupWebhooks(mgr manager.Manager) error {
 		},
 	})
 
+	rhmiRegister, err := webhooks.WebhookRegisterFor(&integreatlyv1alpha1.RHMI{})
+	if err != nil {
+		return err
+	}
+
+	webhooks.Config.AddWebhook(webhooks.IntegreatlyWebhook{
+		Name:     "rhmi",
+		Register: rhmiRegister,
+		Rule: webhooks.NewRule().
+			OneResource("integreatly.org", "v1alpha1", "rhmis").
+			ForCreate().
+			ForUpdate().
+			NamespacedScope(),
+	})
+
+	webhooks.Config.AddWebhook(webhooks.IntegreatlyWebhook{
+		Name: "rhmi-installation",
+		Rule: webhooks.NewRule().
+			OneResource("integreatly.org", "v1alpha1", "rhmis").
+			ForCreate().
+			NamespacedScope(),
+		Register: webhooks.AdmissionWebhookRegister{
+			Type: webhooks.ValidatingType,
+			Path: "/validate-rhmi-installation",
+			Hook: &admission.Webhook{
+				Handler: installation.NewRHMIValidatingHandler(),
+			},
+		},
+	})
+
 	if err := webhooks.Config.SetupServer(mgr); err != nil {
 		return err
 	}
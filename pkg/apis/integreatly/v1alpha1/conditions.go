@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ConditionType string
+
+var (
+	// ConditionAvailable is true when every stage of the installation, or the product, has completed
+	ConditionAvailable ConditionType = "Available"
+	// ConditionProgressing is true while the installation, or the product, is being installed or upgraded
+	ConditionProgressing ConditionType = "Progressing"
+	// ConditionDegraded is true when the last reconcile of the installation, or the product, failed
+	ConditionDegraded ConditionType = "Degraded"
+	// ConditionUpgrading is true while an approved upgrade of the installation to a new version of RHMI is in
+	// progress
+	ConditionUpgrading ConditionType = "Upgrading"
+	// ConditionPreflightPassed is true once the preflight checks of the installation have passed
+	ConditionPreflightPassed ConditionType = "PreflightPassed"
+)
+
+// Condition describes one aspect of the current state of a resource, following the conventions of the
+// Kubernetes API conditions
+type Condition struct {
+	Type   ConditionType          `json:"type"`
+	Status corev1.ConditionStatus `json:"status"`
+	// ObservedGeneration is the generation of the resource the condition was set for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is the last time the status of the condition changed
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Reason is a CamelCase identifier of the cause of the last transition
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// SetCondition adds the condition to the conditions, or updates the existing condition of the same type.
+// The transition time is only updated when the status of the condition changes. It returns true when the
+// conditions were changed
+func SetCondition(conditions *[]Condition, newCondition Condition) bool {
+	if newCondition.LastTransitionTime.IsZero() {
+		newCondition.LastTransitionTime = metav1.Now()
+	}
+
+	for i, condition := range *conditions {
+		if condition.Type != newCondition.Type {
+			continue
+		}
+		if condition.Status == newCondition.Status {
+			newCondition.LastTransitionTime = condition.LastTransitionTime
+		}
+		changed := condition.Status != newCondition.Status ||
+			condition.Reason != newCondition.Reason ||
+			condition.Message != newCondition.Message ||
+			condition.ObservedGeneration != newCondition.ObservedGeneration
+		(*conditions)[i] = newCondition
+		return changed
+	}
+
+	*conditions = append(*conditions, newCondition)
+	return true
+}
+
+// FindCondition returns the condition of the type, or nil if the conditions do not contain it
+func FindCondition(conditions []Condition, conditionType ConditionType) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsConditionTrue checks if the condition of the type is present and true
+func IsConditionTrue(conditions []Condition, conditionType ConditionType) bool {
+	condition := FindCondition(conditions, conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
+
+// NewCondition builds a condition for the generation of a resource from a boolean status
+func NewCondition(conditionType ConditionType, status bool, generation int64, reason, message string) Condition {
+	conditionStatus := corev1.ConditionFalse
+	if status {
+		conditionStatus = corev1.ConditionTrue
+	}
+	return Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// SetCondition sets a condition of the installation for its current generation
+func (i *RHMI) SetCondition(conditionType ConditionType, status bool, reason, message string) bool {
+	return SetCondition(&i.Status.Conditions, NewCondition(conditionType, status, i.GetGeneration(), reason, message))
+}
+
+// SetCondition sets a condition of the RHMIConfig for its current generation
+func (c *RHMIConfig) SetCondition(conditionType ConditionType, status bool, reason, message string) bool {
+	return SetCondition(&c.Status.Conditions, NewCondition(conditionType, status, c.GetGeneration(), reason, message))
+}
+
+// SetCondition sets a condition of the product for the generation of the installation
+func (p *RHMIProductStatus) SetCondition(conditionType ConditionType, status bool, generation int64, reason, message string) bool {
+	return SetCondition(&p.Conditions, NewCondition(conditionType, status, generation, reason, message))
+}
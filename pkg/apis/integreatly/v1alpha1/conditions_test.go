@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetCondition(t *testing.T) {
+	transitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	tests := []struct {
+		name               string
+		conditions         []Condition
+		condition          Condition
+		wantChanged        bool
+		wantTransitionTime bool
+	}{
+		{
+			name:        "test new condition is added",
+			conditions:  []Condition{},
+			condition:   NewCondition(ConditionAvailable, true, 1, "InstallationComplete", ""),
+			wantChanged: true,
+		},
+		{
+			name: "test unchanged condition keeps its transition time",
+			conditions: []Condition{
+				{Type: ConditionAvailable, Status: corev1.ConditionTrue, ObservedGeneration: 1, Reason: "InstallationComplete", LastTransitionTime: transitionTime},
+			},
+			condition:          NewCondition(ConditionAvailable, true, 1, "InstallationComplete", ""),
+			wantChanged:        false,
+			wantTransitionTime: true,
+		},
+		{
+			name: "test changed reason keeps the transition time",
+			conditions: []Condition{
+				{Type: ConditionDegraded, Status: corev1.ConditionTrue, ObservedGeneration: 1, Reason: "ReconcileFailed", LastTransitionTime: transitionTime},
+			},
+			condition:          NewCondition(ConditionDegraded, true, 1, "ProcessingError", "failed"),
+			wantChanged:        true,
+			wantTransitionTime: true,
+		},
+		{
+			name: "test changed status updates the transition time",
+			conditions: []Condition{
+				{Type: ConditionDegraded, Status: corev1.ConditionTrue, ObservedGeneration: 1, Reason: "ReconcileFailed", LastTransitionTime: transitionTime},
+			},
+			condition:   NewCondition(ConditionDegraded, false, 1, "NoErrors", ""),
+			wantChanged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := SetCondition(&tt.conditions, tt.condition)
+			if changed != tt.wantChanged {
+				t.Errorf("SetCondition() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if len(tt.conditions) != 1 {
+				t.Fatalf("expected a single condition but got %d", len(tt.conditions))
+			}
+
+			condition := FindCondition(tt.conditions, tt.condition.Type)
+			if condition == nil {
+				t.Fatalf("expected condition %s to be set", tt.condition.Type)
+			}
+			if condition.Status != tt.condition.Status || condition.Reason != tt.condition.Reason {
+				t.Errorf("expected condition %v but got %v", tt.condition, *condition)
+			}
+			if tt.wantTransitionTime != condition.LastTransitionTime.Equal(&transitionTime) {
+				t.Errorf("expected transition time to be kept: %v, got %v", tt.wantTransitionTime, condition.LastTransitionTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaintenanceDuration is the length of the maintenance windows that do not set a duration
+	DefaultMaintenanceDuration = 6 * time.Hour
+	// MinMaintenanceDuration leaves time to approve an upgrade, as upgrades are not approved in the last
+	// hour of a maintenance window
+	MinMaintenanceDuration = 2 * time.Hour
+	MaxMaintenanceDuration = 24 * time.Hour
+
+	// BlackoutDateFormat is the format of the dates of blackout periods
+	BlackoutDateFormat = "2006-01-02"
+
+	// maintenanceSearchHorizon limits how far ahead a maintenance window outside of the blackouts is searched for
+	maintenanceSearchHorizon = 366 * 24 * time.Hour
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// GetWindows returns the maintenance windows of the spec, the window starting at ApplyFrom followed by Windows
+func (m Maintenance) GetWindows() []MaintenanceWindow {
+	windows := []MaintenanceWindow{}
+	if m.ApplyFrom != "" {
+		windows = append(windows, MaintenanceWindow{ApplyFrom: m.ApplyFrom})
+	}
+	return append(windows, m.Windows...)
+}
+
+// NextWindow returns the start and end of the first maintenance window that has not ended at from and does not
+// overlap a blackout period. A window in progress at from is returned
+func (m Maintenance) NextWindow(from time.Time) (time.Time, time.Time, error) {
+	windows := m.GetWindows()
+	if len(windows) == 0 {
+		return time.Time{}, time.Time{}, errors.New("no maintenance windows are set")
+	}
+
+	horizon := from.Add(maintenanceSearchHorizon)
+	for searchFrom := from; searchFrom.Before(horizon); {
+		var start, end time.Time
+		for _, window := range windows {
+			windowStart, windowEnd, err := window.Next(searchFrom)
+			if err != nil {
+				return time.Time{}, time.Time{}, err
+			}
+			if start.IsZero() || windowStart.Before(start) {
+				start, end = windowStart, windowEnd
+			}
+		}
+
+		blackout, err := m.findBlackout(start, end)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		if blackout == nil {
+			return start, end, nil
+		}
+		searchFrom = end
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("no maintenance window outside of the blackout periods found before %s", horizon.Format(DateFormat))
+}
+
+// InBlackout checks if t falls within any of the blackout periods
+func (m Maintenance) InBlackout(t time.Time) (bool, error) {
+	blackout, err := m.findBlackout(t, t.Add(time.Nanosecond))
+	return blackout != nil, err
+}
+
+// EndOfBlackout returns the end of the blackout period t falls within, or t when it is not in a blackout
+func (m Maintenance) EndOfBlackout(t time.Time) (time.Time, error) {
+	for {
+		blackout, err := m.findBlackout(t, t.Add(time.Nanosecond))
+		if err != nil || blackout == nil {
+			return t, err
+		}
+		_, t, _ = blackout.Period()
+	}
+}
+
+func (m Maintenance) findBlackout(start, end time.Time) (*Blackout, error) {
+	for i := range m.Blackouts {
+		blackoutStart, blackoutEnd, err := m.Blackouts[i].Period()
+		if err != nil {
+			return nil, err
+		}
+		if start.Before(blackoutEnd) && end.After(blackoutStart) {
+			return &m.Blackouts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// GetDuration returns the duration of the window, DefaultMaintenanceDuration when it is not set
+func (w MaintenanceWindow) GetDuration() (time.Duration, error) {
+	if w.Duration == "" {
+		return DefaultMaintenanceDuration, nil
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse maintenance window duration %s: %w", w.Duration, err)
+	}
+	return duration, nil
+}
+
+// Next returns the start and end of the first occurrence of the weekly window that has not ended at from
+func (w MaintenanceWindow) Next(from time.Time) (time.Time, time.Time, error) {
+	day, hour, minute, err := parseWeeklyTime(w.ApplyFrom)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	location, err := loadLocation(w.TimeZone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	duration, err := w.GetDuration()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	// calculate how far away from the window day the day of from is, within the current week
+	local := from.In(location)
+	dayDiff := (int(day) - int(local.Weekday()) + 7) % 7
+	start := time.Date(local.Year(), local.Month(), local.Day()+dayDiff, hour, minute, 0, 0, location)
+
+	// the window of the previous week can still be in progress, and the window of this week can have ended
+	if previous := start.AddDate(0, 0, -7); previous.Add(duration).After(from) {
+		start = previous
+	} else if !start.Add(duration).After(from) {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start.UTC(), start.Add(duration).UTC(), nil
+}
+
+// Period returns the start and the end of the blackout. The end is exclusive, at the start of the day after To
+func (b Blackout) Period() (time.Time, time.Time, error) {
+	location, err := loadLocation(b.TimeZone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	from, err := time.ParseInLocation(BlackoutDateFormat, b.From, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse blackout from value : expected format YYYY-MM-DD found: %s", b.From)
+	}
+	to, err := time.ParseInLocation(BlackoutDateFormat, b.To, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse blackout to value : expected format YYYY-MM-DD found: %s", b.To)
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("blackout from %s must not be after blackout to %s", b.From, b.To)
+	}
+	return from.UTC(), to.AddDate(0, 0, 1).UTC(), nil
+}
+
+// ValidateMaintenanceWindows ensures the maintenance windows and blackouts are correctly formatted and that a
+// maintenance window outside of the blackouts is found after now. The default maintenance window is validated
+// when no windows are set, as it is set by the RHMIConfig controller
+func ValidateMaintenanceWindows(maintenance Maintenance, now time.Time) error {
+	if maintenance.ApplyFrom == "" {
+		maintenance.ApplyFrom = DefaultMaintenanceApplyFrom
+	}
+
+	for _, window := range maintenance.GetWindows() {
+		if _, _, _, err := parseWeeklyTime(window.ApplyFrom); err != nil {
+			return err
+		}
+		if _, err := loadLocation(window.TimeZone); err != nil {
+			return err
+		}
+		duration, err := window.GetDuration()
+		if err != nil {
+			return err
+		}
+		if duration < MinMaintenanceDuration || duration > MaxMaintenanceDuration {
+			return fmt.Errorf("maintenance window duration must be between %s and %s, found: %s", MinMaintenanceDuration, MaxMaintenanceDuration, window.Duration)
+		}
+	}
+
+	for _, blackout := range maintenance.Blackouts {
+		if _, _, err := blackout.Period(); err != nil {
+			return err
+		}
+	}
+
+	if _, _, err := maintenance.NextWindow(now); err != nil {
+		return fmt.Errorf("failed to find the next maintenance window : %v", err)
+	}
+	return nil
+}
+
+// FormatMaintenanceDuration formats the duration of a maintenance window for the status. Whole hours are formatted
+// as "6hrs"
+func FormatMaintenanceDuration(duration time.Duration) string {
+	if duration%time.Hour == 0 {
+		return strconv.Itoa(int(duration/time.Hour)) + "hrs"
+	}
+	return duration.String()
+}
+
+// ParseMaintenanceDuration parses a duration formatted by FormatMaintenanceDuration
+func ParseMaintenanceDuration(duration string) (time.Duration, error) {
+	if strings.HasSuffix(duration, "hrs") {
+		hours, err := strconv.Atoi(strings.TrimSuffix(duration, "hrs"))
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(hours) * time.Hour, nil
+	}
+	return time.ParseDuration(duration)
+}
+
+// parseWeeklyTime parses a day and time in the format "DDD hh:mm"
+func parseWeeklyTime(value string) (time.Weekday, int, int, error) {
+	segments := strings.Split(value, " ")
+	if len(segments) != 2 {
+		return 0, 0, 0, fmt.Errorf("failed to parse maintenance window applyFrom value : expected format DDD HH:mm , found format %s", value)
+	}
+	day, ok := weekdays[strings.ToLower(segments[0])]
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("formatting failure, found invalid maintenance window applyFrom value. Expected: `DDD HH:mm` found: %s", value)
+	}
+	parsedTime, err := time.Parse("15:04", segments[1])
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failure while parsing maintenance window applyFrom value. Format expected: `DDD HH:mm` found: %s: %v", value, err)
+	}
+	return day, parsedTime.Hour(), parsedTime.Minute(), nil
+}
+
+func loadLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone %s: %w", timeZone, err)
+	}
+	return location, nil
+}
@@ -0,0 +1,157 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindowNext(t *testing.T) {
+	// Monday
+	from := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name          string
+		Window        MaintenanceWindow
+		ExpectedStart time.Time
+		ExpectedEnd   time.Time
+	}{
+		{
+			Name:          "test same day",
+			Window:        MaintenanceWindow{ApplyFrom: "Mon 00:00", Duration: "1h"},
+			ExpectedStart: from,
+			ExpectedEnd:   from.Add(time.Hour),
+		},
+		{
+			Name:          "test next day",
+			Window:        MaintenanceWindow{ApplyFrom: "Tue 00:00", Duration: "1h"},
+			ExpectedStart: from.AddDate(0, 0, 1),
+			ExpectedEnd:   from.AddDate(0, 0, 1).Add(time.Hour),
+		},
+		{
+			Name:          "test day before",
+			Window:        MaintenanceWindow{ApplyFrom: "SuN 00:00", Duration: "1h"},
+			ExpectedStart: from.AddDate(0, 0, 6),
+			ExpectedEnd:   from.AddDate(0, 0, 6).Add(time.Hour),
+		},
+		{
+			Name:          "test 3 days after with the default duration",
+			Window:        MaintenanceWindow{ApplyFrom: "Thu 02:00"},
+			ExpectedStart: from.AddDate(0, 0, 3).Add(2 * time.Hour),
+			ExpectedEnd:   from.AddDate(0, 0, 3).Add(8 * time.Hour),
+		},
+		{
+			Name:          "test window of the previous day in progress",
+			Window:        MaintenanceWindow{ApplyFrom: "Sun 22:00", Duration: "4h"},
+			ExpectedStart: from.Add(-2 * time.Hour),
+			ExpectedEnd:   from.Add(2 * time.Hour),
+		},
+		{
+			Name:          "test window in a time zone",
+			Window:        MaintenanceWindow{ApplyFrom: "Mon 09:00", Duration: "2h", TimeZone: "Asia/Tokyo"},
+			ExpectedStart: from,
+			ExpectedEnd:   from.Add(2 * time.Hour),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			start, end, err := tc.Window.Next(from)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !start.Equal(tc.ExpectedStart) || !end.Equal(tc.ExpectedEnd) {
+				t.Fatalf("expected window %s - %s, got %s - %s", tc.ExpectedStart, tc.ExpectedEnd, start, end)
+			}
+		})
+	}
+}
+
+func TestMaintenanceNextWindow(t *testing.T) {
+	// Monday
+	from := time.Date(2020, time.December, 14, 12, 0, 0, 0, time.UTC)
+
+	maintenance := Maintenance{
+		ApplyFrom: "Thu 02:00",
+		Windows: []MaintenanceWindow{
+			{ApplyFrom: "Tue 20:00", Duration: "3h"},
+		},
+		Blackouts: []Blackout{
+			{From: "2020-12-15", To: "2021-01-03", Reason: "year-end freeze"},
+		},
+	}
+
+	start, end, err := maintenance.NextWindow(from)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedStart := time.Date(2021, time.January, 5, 20, 0, 0, 0, time.UTC)
+	if !start.Equal(expectedStart) || !end.Equal(expectedStart.Add(3*time.Hour)) {
+		t.Fatalf("expected the first window after the blackout at %s, got %s - %s", expectedStart, start, end)
+	}
+
+	inBlackout, err := maintenance.InBlackout(time.Date(2021, time.January, 3, 23, 0, 0, 0, time.UTC))
+	if err != nil || !inBlackout {
+		t.Fatalf("expected the last day of the blackout to be in the blackout, err: %v", err)
+	}
+	endOfBlackout, err := maintenance.EndOfBlackout(from.AddDate(0, 0, 2))
+	if err != nil || !endOfBlackout.Equal(time.Date(2021, time.January, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected the blackout to end on the day after its last day, got %s, err: %v", endOfBlackout, err)
+	}
+}
+
+func TestValidateMaintenanceWindows(t *testing.T) {
+	now := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name        string
+		Maintenance Maintenance
+		ExpectError bool
+	}{
+		{
+			Name:        "test default maintenance window is valid",
+			Maintenance: Maintenance{},
+		},
+		{
+			Name: "test windows and blackouts are valid",
+			Maintenance: Maintenance{
+				ApplyFrom: "Thu 02:00",
+				Windows:   []MaintenanceWindow{{ApplyFrom: "sat 23:00", Duration: "2h30m", TimeZone: "America/New_York"}},
+				Blackouts: []Blackout{{From: "2020-06-01", To: "2020-06-05"}},
+			},
+		},
+		{
+			Name:        "test invalid window day",
+			Maintenance: Maintenance{Windows: []MaintenanceWindow{{ApplyFrom: "someday 02:00"}}},
+			ExpectError: true,
+		},
+		{
+			Name:        "test invalid time zone",
+			Maintenance: Maintenance{Windows: []MaintenanceWindow{{ApplyFrom: "Mon 02:00", TimeZone: "Mars/Olympus"}}},
+			ExpectError: true,
+		},
+		{
+			Name:        "test window too short to upgrade in",
+			Maintenance: Maintenance{Windows: []MaintenanceWindow{{ApplyFrom: "Mon 02:00", Duration: "1h"}}},
+			ExpectError: true,
+		},
+		{
+			Name:        "test blackout ending before it starts",
+			Maintenance: Maintenance{Blackouts: []Blackout{{From: "2020-06-05", To: "2020-06-01"}}},
+			ExpectError: true,
+		},
+		{
+			Name:        "test no window outside of the blackouts",
+			Maintenance: Maintenance{Blackouts: []Blackout{{From: "2020-01-01", To: "2022-01-01"}}},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := ValidateMaintenanceWindows(tc.Maintenance, now)
+			if tc.ExpectError != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.ExpectError, err)
+			}
+		})
+	}
+}
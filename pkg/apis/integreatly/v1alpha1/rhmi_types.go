@@ -1,7 +1,14 @@
 package v1alpha1
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type StatusPhase string
@@ -59,9 +66,9 @@ var (
 	ProductMarin3r             ProductName = "marin3r"
 	ProductGrafana             ProductName = "grafana"
 
-	// Could not find a way to determine these versions dynamically, so they are hard-coded
-	// It is preferable to determine the version of a product dynamically (from a CR, or configmap, etc)
-	// Follow up Jira: https://issues.redhat.com/browse/INTLY-5946
+	// The versions of the products this version of the operator installs. Reconcilers report the versions actually
+	// installed, read from the CSV of the operator or the status of the operand CR, as the observed versions of the
+	// product status, which are compared against these to detect version drift
 	VersionAMQOnline           ProductVersion = "1.4"
 	VersionApicurioRegistry    ProductVersion = "1.2.3.final"
 	VersionApicurito           ProductVersion = "7.6"
@@ -115,10 +122,15 @@ var (
 	EventProcessingError       string = "ProcessingError"
 	EventInstallationCompleted string = "InstallationCompleted"
 	EventPreflightCheckPassed  string = "PreflightCheckPassed"
+	EventPreflightCheckWarning string = "PreflightCheckWarning"
 	EventUpgradeApproved       string = "UpgradeApproved"
 
 	DefaultOriginPullSecretName      = "pull-secret"
 	DefaultOriginPullSecretNamespace = "openshift-config"
+
+	// ReservedIdentityProviderAliases are the aliases of the identity providers the operator sets up in the RHSSO
+	// realm, which can not be used by the providers of spec.authentication
+	ReservedIdentityProviderAliases = []string{"openshift-v4", "github"}
 )
 
 // RHMISpec defines the desired state of Installation
@@ -168,6 +180,334 @@ type RHMISpec struct {
 	//
 	// url
 	DeadMansSnitchSecret string `json:"deadMansSnitchSecret,omitempty"`
+
+	// Products allows the products listed by the installation
+	// type to be disabled or customised, keyed by product name.
+	// Products without an entry are installed with the
+	// defaults of the installation type
+	Products map[ProductName]ProductSpec `json:"products,omitempty"`
+
+	// ProductUpgrades sets how the install plans upgrading the
+	// product operators are approved. By default they are
+	// approved as soon as they are created
+	ProductUpgrades *ProductUpgradePolicy `json:"productUpgrades,omitempty"`
+
+	// Alerting configures where the alerts of the installation
+	// are sent in addition to the SMTP, PagerDuty and Dead Mans
+	// Snitch receivers
+	Alerting *AlertingSpec `json:"alerting,omitempty"`
+
+	// Authentication configures the identity providers federated
+	// into the master realm of the user SSO
+	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
+
+	// CatalogSource is the catalog source the product operators
+	// are installed from, unless a product sets its own. Defaults
+	// to the manifests embedded in the operator
+	CatalogSource *CatalogSourceSpec `json:"catalogSource,omitempty"`
+}
+
+type CatalogSourceType string
+
+var (
+	// CatalogSourceManifests serves the manifests embedded in the
+	// operator from ConfigMaps in the operator namespace of the
+	// product
+	CatalogSourceManifests CatalogSourceType = "Manifests"
+	// CatalogSourceRegistryImage serves the product operator from
+	// an operator registry image built for it
+	CatalogSourceRegistryImage CatalogSourceType = "RegistryImage"
+	// CatalogSourceIndexImage serves the product operator from an
+	// index image, such as a file-based catalog or a mirror of the
+	// Red Hat operator index
+	CatalogSourceIndexImage CatalogSourceType = "IndexImage"
+	// CatalogSourceExisting subscribes to a catalog source already
+	// on the cluster, which the operator does not manage
+	CatalogSourceExisting CatalogSourceType = "Existing"
+
+	CatalogSourceTypes = []CatalogSourceType{CatalogSourceManifests, CatalogSourceRegistryImage, CatalogSourceIndexImage, CatalogSourceExisting}
+)
+
+type CatalogSourceSpec struct {
+	// Type of the catalog source, one of Manifests, RegistryImage,
+	// IndexImage or Existing. Defaults to Manifests
+	Type CatalogSourceType `json:"type,omitempty"`
+
+	// Image of a RegistryImage or IndexImage catalog source
+	Image string `json:"image,omitempty"`
+
+	// PollInterval is how often an IndexImage catalog source
+	// checks its image for updates, such as "30m". The image is
+	// not polled by default
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+
+	// Name of an Existing catalog source
+	Name string `json:"name,omitempty"`
+
+	// Namespace of an Existing catalog source. OLM only resolves
+	// catalog sources of the namespace of the subscription and of
+	// the global catalog namespace. Defaults to
+	// openshift-marketplace
+	Namespace string `json:"namespace,omitempty"`
+
+	// Package pins the package of the product operator in an
+	// IndexImage or Existing catalog source, when it differs from
+	// the package of the embedded manifests. Only set for products
+	Package string `json:"package,omitempty"`
+
+	// Channel pins the channel of the product operator in an
+	// IndexImage or Existing catalog source, taking precedence
+	// over the channel of the product. Only set for products
+	Channel string `json:"channel,omitempty"`
+}
+
+type AlertReceiverType string
+
+var (
+	// AlertReceiverSlack sends alerts to a Slack incoming webhook.
+	// The secret must contain the url of the webhook
+	AlertReceiverSlack AlertReceiverType = "Slack"
+	// AlertReceiverMSTeams sends alerts to a Microsoft Teams
+	// connector through a webhook proxy. The secret must
+	// contain the url of the proxy
+	AlertReceiverMSTeams AlertReceiverType = "MSTeams"
+	// AlertReceiverWebhook sends alerts to a generic webhook.
+	// The secret must contain the url of the webhook
+	AlertReceiverWebhook AlertReceiverType = "Webhook"
+	// AlertReceiverOpsGenie sends alerts to OpsGenie. The secret
+	// must contain the apiKey and can contain the apiURL of the
+	// OpsGenie account
+	AlertReceiverOpsGenie AlertReceiverType = "OpsGenie"
+)
+
+type AlertingSpec struct {
+	// Receivers of the alerts, an alert is sent to every
+	// receiver it matches
+	Receivers []AlertReceiver `json:"receivers,omitempty"`
+}
+
+type AlertReceiver struct {
+	// Name of the receiver, unique in the installation
+	Name string `json:"name"`
+
+	// Type is one of Slack, MSTeams, Webhook or OpsGenie
+	Type AlertReceiverType `json:"type"`
+
+	// SecretRef is the name of a secret in the installation
+	// namespace containing the credentials of the receiver
+	SecretRef string `json:"secretRef"`
+
+	// Channel the Slack alerts are posted to, defaults to the
+	// channel of the webhook
+	Channel string `json:"channel,omitempty"`
+
+	// Severities restricts the alerts sent to the receiver to
+	// the ones with these severity labels
+	Severities []string `json:"severities,omitempty"`
+
+	// Products restricts the alerts sent to the receiver to the
+	// ones with these product labels
+	Products []ProductName `json:"products,omitempty"`
+
+	// Namespaces restricts the alerts sent to the receiver to
+	// the ones raised in these namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+type IdentityProviderType string
+
+var (
+	// IdentityProviderOIDC federates an OpenID Connect provider,
+	// such as Azure AD. The secret must contain the clientSecret
+	IdentityProviderOIDC IdentityProviderType = "OIDC"
+	// IdentityProviderSAML federates a SAML 2.0 provider. The
+	// secret is optional and can contain the signingCertificate
+	// the assertions are validated with
+	IdentityProviderSAML IdentityProviderType = "SAML"
+	// IdentityProviderLDAP federates the users of an LDAP or
+	// Active Directory server. The secret must contain the
+	// bindCredential
+	IdentityProviderLDAP IdentityProviderType = "LDAP"
+)
+
+type AuthenticationSpec struct {
+	// IdentityProviders federated into the realm. Providers
+	// removed from the list are removed from the realm
+	IdentityProviders []IdentityProviderSpec `json:"identityProviders,omitempty"`
+}
+
+type IdentityProviderSpec struct {
+	// Alias of the provider in the realm, unique in the
+	// installation
+	Alias string `json:"alias"`
+
+	// DisplayName shown on the login page, defaults to the alias
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Type is one of OIDC, SAML or LDAP
+	Type IdentityProviderType `json:"type"`
+
+	// SecretRef is the name of a secret in the installation
+	// namespace containing the credentials of the provider
+	SecretRef string `json:"secretRef,omitempty"`
+
+	// FirstBrokerLoginFlow is the alias of the authentication
+	// flow run the first time a user logs in through an OIDC or
+	// SAML provider, defaults to first broker login
+	FirstBrokerLoginFlow string `json:"firstBrokerLoginFlow,omitempty"`
+
+	// TrustEmail marks the emails of the users logging in
+	// through the provider as verified
+	TrustEmail bool `json:"trustEmail,omitempty"`
+
+	// HideOnLoginPage hides an OIDC or SAML provider from the
+	// login page, it can still be selected with kc_idp_hint
+	HideOnLoginPage bool `json:"hideOnLoginPage,omitempty"`
+
+	OIDC *OIDCIdentityProvider `json:"oidc,omitempty"`
+	SAML *SAMLIdentityProvider `json:"saml,omitempty"`
+	LDAP *LDAPIdentityProvider `json:"ldap,omitempty"`
+
+	// Mappers copy the claims or attributes of the provider to
+	// attributes of the users
+	Mappers []IdentityProviderMapper `json:"mappers,omitempty"`
+}
+
+type OIDCIdentityProvider struct {
+	AuthorizationURL string `json:"authorizationURL"`
+	TokenURL         string `json:"tokenURL"`
+	UserInfoURL      string `json:"userInfoURL,omitempty"`
+	// JwksURL the signatures of the tokens are validated with,
+	// the signatures are not validated when it is not set
+	JwksURL  string `json:"jwksURL,omitempty"`
+	Issuer   string `json:"issuer,omitempty"`
+	ClientID string `json:"clientID"`
+	// Scopes requested from the provider, defaults to openid
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+type SAMLIdentityProvider struct {
+	SingleSignOnServiceURL string `json:"singleSignOnServiceURL"`
+	SingleLogoutServiceURL string `json:"singleLogoutServiceURL,omitempty"`
+	// NameIDPolicyFormat defaults to the persistent name ID format
+	NameIDPolicyFormat string `json:"nameIDPolicyFormat,omitempty"`
+	// PrincipalAttribute identifies the users by an attribute of
+	// the assertion instead of its subject
+	PrincipalAttribute string `json:"principalAttribute,omitempty"`
+}
+
+type LDAPIdentityProvider struct {
+	// ConnectionURL of the server, such as ldaps://ad.example.com
+	ConnectionURL string `json:"connectionURL"`
+	BindDN        string `json:"bindDN"`
+	UsersDN       string `json:"usersDN"`
+	// ActiveDirectory sets the defaults of the attributes and
+	// object classes to the ones of Active Directory
+	ActiveDirectory bool `json:"activeDirectory,omitempty"`
+	// UsernameAttribute defaults to sAMAccountName for Active
+	// Directory and uid otherwise
+	UsernameAttribute string `json:"usernameAttribute,omitempty"`
+	// UserObjectClasses defaults to person, organizationalPerson
+	// and user for Active Directory and inetOrgPerson and
+	// organizationalPerson otherwise
+	UserObjectClasses []string `json:"userObjectClasses,omitempty"`
+	// UserSearchFilter restricts the users federated, such as
+	// (memberOf=cn=rhmi,ou=groups,dc=example,dc=com)
+	UserSearchFilter string `json:"userSearchFilter,omitempty"`
+}
+
+type IdentityProviderMapper struct {
+	// Name of the mapper, unique in the provider
+	Name string `json:"name"`
+	// Attribute is the claim, SAML attribute or LDAP attribute
+	// copied
+	Attribute string `json:"attribute"`
+	// UserAttribute is the attribute of the user it is copied to
+	UserAttribute string `json:"userAttribute"`
+}
+
+type ProductHealthCheck string
+
+var (
+	// HealthCheckDeploymentsReady verifies that every deployment and
+	// stateful set in the namespaces of the product is ready
+	HealthCheckDeploymentsReady ProductHealthCheck = "DeploymentsReady"
+	// HealthCheckNoCriticalAlerts verifies that none of the critical
+	// alerts of the product are firing
+	HealthCheckNoCriticalAlerts ProductHealthCheck = "NoCriticalAlerts"
+	// HealthCheckBlackboxTargetsUp verifies that the blackbox probes
+	// of the routes of the product succeed
+	HealthCheckBlackboxTargetsUp ProductHealthCheck = "BlackboxTargetsUp"
+)
+
+type ProductUpgradePolicy struct {
+	// Staged approves the install plans upgrading product operators
+	// one product at a time, waiting for the upgraded product to be
+	// healthy before approving the upgrade of the next product
+	Staged bool `json:"staged,omitempty"`
+
+	// Order lists the products upgraded first. Products that are
+	// not listed are upgraded after them, in the order of the
+	// installation stages
+	Order []ProductName `json:"order,omitempty"`
+
+	// HealthChecks verified for an upgraded product before the next
+	// product is upgraded. Defaults to DeploymentsReady
+	HealthChecks []ProductHealthCheck `json:"healthChecks,omitempty"`
+
+	// HealthTimeout is how long an upgraded product has to become
+	// healthy before the staged upgrade is halted. Defaults to 30m
+	HealthTimeout string `json:"healthTimeout,omitempty"`
+}
+
+type ProductSpec struct {
+	// Enabled decides if the product is installed. Products are
+	// enabled by default. Disabling an installed product
+	// uninstalls it
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Channel overrides the OLM channel the product operator
+	// is subscribed to
+	Channel string `json:"channel,omitempty"`
+
+	// OperatorVersion restricts the install plans approved for
+	// the product operator to the ones installing this version
+	OperatorVersion OperatorVersion `json:"operatorVersion,omitempty"`
+
+	// Overrides are merged over the product configuration
+	// stored by the operator, taking precedence over it
+	Overrides map[string]string `json:"overrides,omitempty"`
+
+	// SLO is the availability objective of the product, from
+	// which the error budget recording rules and burn rate
+	// alerts are generated. Products probed by blackbox targets
+	// default to 99.5% availability over 28 days
+	SLO *ProductSLO `json:"slo,omitempty"`
+
+	// CatalogSource is the catalog source the product operator is
+	// installed from, replacing the catalog source of the
+	// installation
+	CatalogSource *CatalogSourceSpec `json:"catalogSource,omitempty"`
+}
+
+type ProductSLO struct {
+	// Target is the availability objective in percent, such
+	// as "99.9"
+	Target string `json:"target,omitempty"`
+
+	// Window is the period the error budget is calculated
+	// over, such as "28d"
+	Window string `json:"window,omitempty"`
+
+	// BlackboxServices are the services of the blackbox targets
+	// the availability is measured with. Defaults to the
+	// blackbox targets of the product
+	BlackboxServices []string `json:"blackboxServices,omitempty"`
+
+	// ErrorRatio is a PromQL expression of the ratio of failed
+	// requests of the product, used instead of the blackbox
+	// targets. $window is replaced with the range of each rule
+	ErrorRatio string `json:"errorRatio,omitempty"`
 }
 
 type PullSecretSpec struct {
@@ -190,6 +530,108 @@ type RHMIStatus struct {
 	SMTPEnabled        bool                          `json:"smtpEnabled,omitempty"`
 	Version            string                        `json:"version,omitempty"`
 	ToVersion          string                        `json:"toVersion,omitempty"`
+	// Conditions of the installation: Available, Progressing, Degraded, Upgrading and PreflightPassed
+	Conditions []Condition `json:"conditions,omitempty"`
+	// PreflightChecks are the results of the checks run before the installation, the installation starts once
+	// none of them failed
+	PreflightChecks []PreflightCheckStatus `json:"preflightChecks,omitempty"`
+	// ProductUpgrades tracks the staged upgrades of the product operators
+	ProductUpgrades *ProductUpgradesStatus `json:"productUpgrades,omitempty"`
+	// UserSync is the last synchronisation of the OpenShift users to each product holding users
+	UserSync []UserSyncStatus `json:"userSync,omitempty"`
+}
+
+type UserSyncAction string
+
+var (
+	UserSyncAdd     UserSyncAction = "Add"
+	UserSyncDelete  UserSyncAction = "Delete"
+	UserSyncPromote UserSyncAction = "Promote"
+	UserSyncDemote  UserSyncAction = "Demote"
+
+	UserSyncActions = []UserSyncAction{UserSyncAdd, UserSyncDelete, UserSyncPromote, UserSyncDemote}
+)
+
+type UserSyncResult string
+
+var (
+	// UserSyncPlanned is reported for the changes of a dry run
+	UserSyncPlanned UserSyncResult = "Planned"
+	UserSyncApplied UserSyncResult = "Applied"
+	UserSyncFailed  UserSyncResult = "Failed"
+	// UserSyncDeferred is reported for the changes exceeding the changes applied per sync
+	UserSyncDeferred UserSyncResult = "Deferred"
+
+	UserSyncResults = []UserSyncResult{UserSyncPlanned, UserSyncApplied, UserSyncFailed, UserSyncDeferred}
+)
+
+// MaxUserSyncChanges is the number of changes listed in the status of a sync, the counts cover every change
+const MaxUserSyncChanges = 50
+
+type UserSyncChange struct {
+	Action UserSyncAction `json:"action"`
+	User   string         `json:"user"`
+	Result UserSyncResult `json:"result"`
+	// Message is the error of failed changes
+	Message string `json:"message,omitempty"`
+}
+
+type UserSyncStatus struct {
+	// Target is the product the users are synchronised to
+	Target   ProductName `json:"target"`
+	LastSync metav1.Time `json:"lastSync"`
+	DryRun   bool        `json:"dryRun,omitempty"`
+	Planned  int         `json:"planned"`
+	Applied  int         `json:"applied"`
+	Failed   int         `json:"failed"`
+	Deferred int         `json:"deferred"`
+	// Changes of the sync and their result, at most MaxUserSyncChanges of them
+	Changes []UserSyncChange `json:"changes,omitempty"`
+}
+
+type PreflightCheckResult string
+
+var (
+	PreflightCheckPassed PreflightCheckResult = "Passed"
+	// PreflightCheckWarning is reported for checks that found a problem that does not block the installation
+	PreflightCheckWarning PreflightCheckResult = "Warning"
+	// PreflightCheckFailed is reported for checks that block the installation until they pass
+	PreflightCheckFailed PreflightCheckResult = "Failed"
+)
+
+type PreflightCheckStatus struct {
+	Name   string               `json:"name"`
+	Result PreflightCheckResult `json:"result"`
+	// Message describes the outcome of the check
+	Message string `json:"message,omitempty"`
+}
+
+type ProductUpgradePhase string
+
+var (
+	// ProductUpgradeVerifying is set while the upgraded product is checked for health
+	ProductUpgradeVerifying ProductUpgradePhase = "Verifying"
+	// ProductUpgradeFailed is set when the upgraded product did not become healthy in time. The staged upgrade
+	// is halted until the product is healthy
+	ProductUpgradeFailed ProductUpgradePhase = "Failed"
+)
+
+type ProductUpgradesStatus struct {
+	// Current is the product upgrade approved last, until the product is healthy
+	Current *ProductUpgrade `json:"current,omitempty"`
+	// Pending are the products with an upgrade waiting for approval, in the order they are approved
+	Pending []ProductName `json:"pending,omitempty"`
+}
+
+type ProductUpgrade struct {
+	Product ProductName `json:"product"`
+	// InstallPlan is the name of the approved install plan, in the operator namespace of the product
+	InstallPlan string              `json:"installPlan"`
+	Namespace   string              `json:"namespace"`
+	ApprovedAt  metav1.Time         `json:"approvedAt"`
+	Phase       ProductUpgradePhase `json:"phase"`
+	// Message describes why the product is not healthy yet
+	Message string `json:"message,omitempty"`
 }
 
 type RHMIStageStatus struct {
@@ -206,6 +648,16 @@ type RHMIProductStatus struct {
 	Type            string          `json:"type,omitempty"`
 	Mobile          bool            `json:"mobile,omitempty"`
 	Status          StatusPhase     `json:"status"`
+	// ObservedOperatorVersion is the version of the operator installed on the cluster, read from its CSV
+	ObservedOperatorVersion OperatorVersion `json:"observedOperatorVersion,omitempty"`
+	// ObservedVersion is the version of the product installed on the cluster, when reported by the operand or by the
+	// product version label of the operator CSV
+	ObservedVersion ProductVersion `json:"observedVersion,omitempty"`
+	// VersionDrift is set when the observed versions do not match the versions expected by the operator,
+	// for example after a partially failed upgrade
+	VersionDrift bool `json:"versionDrift,omitempty"`
+	// Conditions of the product: Available, Progressing and Degraded
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -234,6 +686,53 @@ func (i *RHMI) GetProductStatusObject(product ProductName) *RHMIProductStatus {
 	}
 }
 
+// GetProductSpec returns the spec of the product, or an empty spec if the installation does not customise it
+func (i *RHMI) GetProductSpec(product ProductName) ProductSpec {
+	if spec, ok := i.Spec.Products[product]; ok {
+		return spec
+	}
+	return ProductSpec{}
+}
+
+// GetCatalogSource returns the catalog source of the product operator: the one of the product, else the one of the
+// installation, else the embedded manifests
+func (i *RHMI) GetCatalogSource(product ProductName) CatalogSourceSpec {
+	if spec := i.GetProductSpec(product).CatalogSource; spec != nil {
+		return *spec
+	}
+	if i.Spec.CatalogSource != nil {
+		return *i.Spec.CatalogSource
+	}
+	return CatalogSourceSpec{Type: CatalogSourceManifests}
+}
+
+func (i *RHMI) IsProductEnabled(product ProductName) bool {
+	return i.GetProductSpec(product).IsEnabled()
+}
+
+func (s ProductSpec) IsEnabled() bool {
+	return s.Enabled == nil || *s.Enabled
+}
+
+// GetChannel returns the channel override of the product, or the default channel when it is not overridden
+func (s ProductSpec) GetChannel(defaultChannel string) string {
+	if s.Channel != "" {
+		return s.Channel
+	}
+	return defaultChannel
+}
+
+// SetUserSyncStatus records the last sync of the users to the target of the status, replacing the previous one
+func (i *RHMI) SetUserSyncStatus(status UserSyncStatus) {
+	for j := range i.Status.UserSync {
+		if i.Status.UserSync[j].Target == status.Target {
+			i.Status.UserSync[j] = status
+			return
+		}
+	}
+	i.Status.UserSync = append(i.Status.UserSync, status)
+}
+
 func (i *RHMI) GetPullSecretSpec() *PullSecretSpec {
 	if i.Spec.PullSecret.Name != "" && i.Spec.PullSecret.Namespace != "" {
 		return &(i.Spec.PullSecret)
@@ -242,6 +741,224 @@ func (i *RHMI) GetPullSecretSpec() *PullSecretSpec {
 	}
 }
 
+// Default sets the type and namespace prefix of the installation when they are not set, to the same values the
+// operator uses for the RHMI CR it creates
+func (i *RHMI) Default() {
+	if i.Spec.Type == "" {
+		i.Spec.Type = string(InstallationTypeManaged)
+	}
+	if i.Spec.NamespacePrefix == "" {
+		i.Spec.NamespacePrefix = global.NamespacePrefix
+	}
+}
+
+func (i *RHMI) ValidateCreate() error {
+	return ValidateRHMISpec(i.Spec)
+}
+
+// ValidateUpdate validates the spec and rejects changes to the type and namespace prefix, which can not be changed
+// once the products are installed. Installations being deleted are not validated so that their finalizers can
+// always be removed
+func (i *RHMI) ValidateUpdate(old runtime.Object) error {
+	if i.DeletionTimestamp != nil {
+		return nil
+	}
+
+	oldInstallation, ok := old.(*RHMI)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for the previous RHMI", old)
+	}
+	if i.Spec.Type != oldInstallation.Spec.Type {
+		return fmt.Errorf("Value of spec.Type can not be changed after installation, found: %s expected: %s", i.Spec.Type, oldInstallation.Spec.Type)
+	}
+	if i.Spec.NamespacePrefix != oldInstallation.Spec.NamespacePrefix {
+		return fmt.Errorf("Value of spec.NamespacePrefix can not be changed after installation, found: %s expected: %s", i.Spec.NamespacePrefix, oldInstallation.Spec.NamespacePrefix)
+	}
+
+	return ValidateRHMISpec(i.Spec)
+}
+
+func (i *RHMI) ValidateDelete() error {
+	return nil
+}
+
+// ValidateRHMISpec ensures that the type is set, that the namespace prefix can prefix the names of the product
+// namespaces, that useClusterStorage is either true or false when it is set and that the secrets are referenced by
+// valid names. Whether the type is known is checked against the installation profiles by the operator
+func ValidateRHMISpec(spec RHMISpec) error {
+	if spec.Type == "" {
+		return errors.New("Value of spec.Type must be set")
+	}
+
+	// the prefix is followed by the name of the product, so it only has to be valid at the start of a namespace name
+	if errs := validation.IsDNS1123Label(spec.NamespacePrefix + "a"); len(errs) > 0 {
+		return fmt.Errorf("Value of spec.NamespacePrefix must be a valid namespace name prefix, found: %s: %s", spec.NamespacePrefix, strings.Join(errs, ", "))
+	}
+
+	// the cluster storage is not defaulted, the installation has to decide where the cloud resources are created
+	if useClusterStorage := strings.ToLower(spec.UseClusterStorage); useClusterStorage != "true" && useClusterStorage != "false" {
+		return fmt.Errorf("Value of spec.UseClusterStorage must be either true or false, found: %q", spec.UseClusterStorage)
+	}
+
+	type secretRef struct {
+		field string
+		name  string
+	}
+	secretRefs := []secretRef{
+		{"spec.SMTPSecret", spec.SMTPSecret},
+		{"spec.PagerDutySecret", spec.PagerDutySecret},
+		{"spec.DeadMansSnitchSecret", spec.DeadMansSnitchSecret},
+		{"spec.PullSecret.Name", spec.PullSecret.Name},
+	}
+	if spec.Alerting != nil {
+		for _, receiver := range spec.Alerting.Receivers {
+			secretRefs = append(secretRefs, secretRef{fmt.Sprintf("spec.Alerting.Receivers.SecretRef of receiver %s", receiver.Name), receiver.SecretRef})
+		}
+	}
+	if spec.Authentication != nil {
+		for _, idp := range spec.Authentication.IdentityProviders {
+			secretRefs = append(secretRefs, secretRef{fmt.Sprintf("spec.Authentication.IdentityProviders.SecretRef of provider %s", idp.Alias), idp.SecretRef})
+		}
+	}
+	for _, ref := range secretRefs {
+		if ref.name == "" {
+			continue
+		}
+		if errs := validation.IsDNS1123Subdomain(ref.name); len(errs) > 0 {
+			return fmt.Errorf("Value of %s must be a valid secret name, found: %s: %s", ref.field, ref.name, strings.Join(errs, ", "))
+		}
+	}
+
+	if spec.PullSecret.Namespace != "" {
+		if errs := validation.IsDNS1123Label(spec.PullSecret.Namespace); len(errs) > 0 {
+			return fmt.Errorf("Value of spec.PullSecret.Namespace must be a valid namespace name, found: %s: %s", spec.PullSecret.Namespace, strings.Join(errs, ", "))
+		}
+	}
+
+	if err := validateCatalogSource("spec.CatalogSource", spec.CatalogSource, false); err != nil {
+		return err
+	}
+	for name, product := range spec.Products {
+		if err := validateCatalogSource(fmt.Sprintf("spec.Products.CatalogSource of product %s", name), product.CatalogSource, true); err != nil {
+			return err
+		}
+	}
+
+	// the default backup image only stores the backups in S3, the pvc backend needs an image with a local backend
+	if overrides := spec.Products["backup"].Overrides; overrides["BACKEND"] == "pvc" && overrides["LOCAL_BACKEND_SUPPORTED"] != "true" {
+		return errors.New("Value pvc of spec.Products.backup.Overrides.BACKEND requires an IMAGE supporting the local backend, with LOCAL_BACKEND_SUPPORTED set to true")
+	}
+
+	return ValidateAuthentication(spec.Authentication)
+}
+
+// validateCatalogSource ensures that the catalog source sets the fields of its type only. The package and channel
+// differ between products, so they can only be pinned for a product
+func validateCatalogSource(field string, catalogSource *CatalogSourceSpec, product bool) error {
+	if catalogSource == nil {
+		return nil
+	}
+
+	switch catalogSource.Type {
+	case "", CatalogSourceManifests:
+		if catalogSource.Image != "" || catalogSource.Name != "" {
+			return fmt.Errorf("%s of type Manifests must not set the image or name", field)
+		}
+	case CatalogSourceRegistryImage, CatalogSourceIndexImage:
+		if catalogSource.Image == "" {
+			return fmt.Errorf("Value of %s.Image must be set for type %s", field, catalogSource.Type)
+		}
+	case CatalogSourceExisting:
+		if errs := validation.IsDNS1123Subdomain(catalogSource.Name); len(errs) > 0 {
+			return fmt.Errorf("Value of %s.Name must be a valid catalog source name, found: %s: %s", field, catalogSource.Name, strings.Join(errs, ", "))
+		}
+		if catalogSource.Namespace != "" {
+			if errs := validation.IsDNS1123Label(catalogSource.Namespace); len(errs) > 0 {
+				return fmt.Errorf("Value of %s.Namespace must be a valid namespace name, found: %s: %s", field, catalogSource.Namespace, strings.Join(errs, ", "))
+			}
+		}
+	default:
+		return fmt.Errorf("Value of %s.Type must be one of %v, found: %s", field, CatalogSourceTypes, catalogSource.Type)
+	}
+
+	if catalogSource.PollInterval != nil {
+		if catalogSource.Type != CatalogSourceIndexImage {
+			return fmt.Errorf("%s.PollInterval can only be set for type IndexImage", field)
+		}
+		if catalogSource.PollInterval.Duration <= 0 {
+			return fmt.Errorf("Value of %s.PollInterval must be positive, found: %s", field, catalogSource.PollInterval.Duration)
+		}
+	}
+	if catalogSource.Package != "" || catalogSource.Channel != "" {
+		if !product {
+			return fmt.Errorf("%s can not pin a package or channel, they can only be pinned for a product", field)
+		}
+		if catalogSource.Type != CatalogSourceIndexImage && catalogSource.Type != CatalogSourceExisting {
+			return fmt.Errorf("%s can only pin the package and channel for types IndexImage and Existing", field)
+		}
+	}
+	return nil
+}
+
+// ValidateAuthentication ensures that the identity providers have unique aliases, not used by the providers the
+// operator sets up, and that they set the configuration and credentials of their type
+func ValidateAuthentication(authentication *AuthenticationSpec) error {
+	if authentication == nil {
+		return nil
+	}
+
+	aliases := map[string]bool{}
+	for _, idp := range authentication.IdentityProviders {
+		if errs := validation.IsDNS1123Label(idp.Alias); len(errs) > 0 {
+			return fmt.Errorf("Value of spec.Authentication.IdentityProviders.Alias must be a valid alias, found: %s: %s", idp.Alias, strings.Join(errs, ", "))
+		}
+		if aliases[idp.Alias] {
+			return fmt.Errorf("spec.Authentication.IdentityProviders contains more than one provider %s", idp.Alias)
+		}
+		aliases[idp.Alias] = true
+		for _, reserved := range ReservedIdentityProviderAliases {
+			if idp.Alias == reserved {
+				return fmt.Errorf("Value of spec.Authentication.IdentityProviders.Alias can not be %s, it is used by the operator", idp.Alias)
+			}
+		}
+
+		switch idp.Type {
+		case IdentityProviderOIDC:
+			if idp.OIDC == nil || idp.OIDC.AuthorizationURL == "" || idp.OIDC.TokenURL == "" || idp.OIDC.ClientID == "" {
+				return fmt.Errorf("spec.Authentication.IdentityProviders.OIDC of provider %s must set the authorizationURL, tokenURL and clientID", idp.Alias)
+			}
+			if idp.SecretRef == "" {
+				return fmt.Errorf("Value of spec.Authentication.IdentityProviders.SecretRef of provider %s must be set", idp.Alias)
+			}
+		case IdentityProviderSAML:
+			if idp.SAML == nil || idp.SAML.SingleSignOnServiceURL == "" {
+				return fmt.Errorf("spec.Authentication.IdentityProviders.SAML of provider %s must set the singleSignOnServiceURL", idp.Alias)
+			}
+		case IdentityProviderLDAP:
+			if idp.LDAP == nil || idp.LDAP.ConnectionURL == "" || idp.LDAP.BindDN == "" || idp.LDAP.UsersDN == "" {
+				return fmt.Errorf("spec.Authentication.IdentityProviders.LDAP of provider %s must set the connectionURL, bindDN and usersDN", idp.Alias)
+			}
+			if idp.SecretRef == "" {
+				return fmt.Errorf("Value of spec.Authentication.IdentityProviders.SecretRef of provider %s must be set", idp.Alias)
+			}
+		default:
+			return fmt.Errorf("Value of spec.Authentication.IdentityProviders.Type of provider %s must be one of OIDC, SAML or LDAP, found: %s", idp.Alias, idp.Type)
+		}
+
+		mappers := map[string]bool{}
+		for _, mapper := range idp.Mappers {
+			if mapper.Name == "" || mapper.Attribute == "" || mapper.UserAttribute == "" {
+				return fmt.Errorf("spec.Authentication.IdentityProviders.Mappers of provider %s must set the name, attribute and userAttribute", idp.Alias)
+			}
+			if mappers[mapper.Name] {
+				return fmt.Errorf("spec.Authentication.IdentityProviders.Mappers of provider %s contains more than one mapper %s", idp.Alias, mapper.Name)
+			}
+			mappers[mapper.Name] = true
+		}
+	}
+	return nil
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // RHMIList contains a list of Installation
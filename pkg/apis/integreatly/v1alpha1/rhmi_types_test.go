@@ -0,0 +1,318 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func validRHMISpec() RHMISpec {
+	return RHMISpec{
+		Type:                 string(InstallationTypeManaged),
+		NamespacePrefix:      "redhat-rhmi-",
+		UseClusterStorage:    "true",
+		SMTPSecret:           "redhat-rhmi-smtp",
+		PagerDutySecret:      "redhat-rhmi-pagerduty",
+		DeadMansSnitchSecret: "redhat-rhmi-deadmanssnitch",
+	}
+}
+
+func TestRHMI_Default(t *testing.T) {
+	installation := &RHMI{}
+	installation.Default()
+	if installation.Spec.Type != string(InstallationTypeManaged) || installation.Spec.NamespacePrefix != global.NamespacePrefix {
+		t.Fatalf("expected the type and namespace prefix to be defaulted, got %v", installation.Spec)
+	}
+
+	installation = &RHMI{Spec: RHMISpec{Type: string(InstallationTypeWorkshop), NamespacePrefix: "test-"}}
+	installation.Default()
+	if installation.Spec.Type != string(InstallationTypeWorkshop) || installation.Spec.NamespacePrefix != "test-" {
+		t.Fatalf("expected the type and namespace prefix to be kept, got %v", installation.Spec)
+	}
+}
+
+func TestValidateRHMISpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(spec *RHMISpec)
+		wantErr bool
+	}{
+		{
+			name:   "test valid spec passes",
+			modify: func(spec *RHMISpec) {},
+		},
+		{
+			name:   "test unset secrets pass",
+			modify: func(spec *RHMISpec) { *spec = RHMISpec{Type: "custom", NamespacePrefix: "rhmi-", UseClusterStorage: "true"} },
+		},
+		{
+			name:    "test unset cluster storage fails",
+			modify:  func(spec *RHMISpec) { spec.UseClusterStorage = "" },
+			wantErr: true,
+		},
+		{
+			name:   "test cluster storage is not case sensitive",
+			modify: func(spec *RHMISpec) { spec.UseClusterStorage = "False" },
+		},
+		{
+			name:    "test unset type fails",
+			modify:  func(spec *RHMISpec) { spec.Type = "" },
+			wantErr: true,
+		},
+		{
+			name:    "test invalid namespace prefix fails",
+			modify:  func(spec *RHMISpec) { spec.NamespacePrefix = "Redhat_rhmi-" },
+			wantErr: true,
+		},
+		{
+			name:    "test invalid cluster storage fails",
+			modify:  func(spec *RHMISpec) { spec.UseClusterStorage = "yes" },
+			wantErr: true,
+		},
+		{
+			name:    "test invalid secret name fails",
+			modify:  func(spec *RHMISpec) { spec.SMTPSecret = "smtp secret" },
+			wantErr: true,
+		},
+		{
+			name: "test invalid pull secret namespace fails",
+			modify: func(spec *RHMISpec) {
+				spec.PullSecret = PullSecretSpec{Name: "pull-secret", Namespace: "openshift.config"}
+			},
+			wantErr: true,
+		},
+		{
+			name: "test invalid alert receiver secret fails",
+			modify: func(spec *RHMISpec) {
+				spec.Alerting = &AlertingSpec{Receivers: []AlertReceiver{{Name: "slack", Type: AlertReceiverSlack, SecretRef: "Slack"}}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "test catalog sources pass",
+			modify: func(spec *RHMISpec) {
+				spec.CatalogSource = &CatalogSourceSpec{Type: CatalogSourceIndexImage, Image: "registry.example.com/rhmi/index:v2.5", PollInterval: &metav1.Duration{Duration: 30 * time.Minute}}
+				spec.Products = map[ProductName]ProductSpec{
+					Product3Scale: {CatalogSource: &CatalogSourceSpec{Type: CatalogSourceExisting, Name: "redhat-operators", Package: "3scale-operator", Channel: "threescale-2.8"}},
+				}
+			},
+		},
+		{
+			name:    "test unknown catalog source type fails",
+			modify:  func(spec *RHMISpec) { spec.CatalogSource = &CatalogSourceSpec{Type: "Bundle"} },
+			wantErr: true,
+		},
+		{
+			name:    "test index image catalog source without image fails",
+			modify:  func(spec *RHMISpec) { spec.CatalogSource = &CatalogSourceSpec{Type: CatalogSourceIndexImage} },
+			wantErr: true,
+		},
+		{
+			name: "test poll interval of registry image catalog source fails",
+			modify: func(spec *RHMISpec) {
+				spec.CatalogSource = &CatalogSourceSpec{Type: CatalogSourceRegistryImage, Image: "registry.example.com/rhmi/3scale:v2.8", PollInterval: &metav1.Duration{Duration: time.Hour}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "test channel pinned for the installation fails",
+			modify: func(spec *RHMISpec) {
+				spec.CatalogSource = &CatalogSourceSpec{Type: CatalogSourceExisting, Name: "redhat-operators", Channel: "stable"}
+			},
+			wantErr: true,
+		},
+		{
+			name: "test pvc backup backend with an image supporting the local backend passes",
+			modify: func(spec *RHMISpec) {
+				spec.Products = map[ProductName]ProductSpec{"backup": {Overrides: map[string]string{
+					"BACKEND": "pvc", "IMAGE": "registry.example.com/rhmi/backup:local", "LOCAL_BACKEND_SUPPORTED": "true",
+				}}}
+			},
+		},
+		{
+			name: "test pvc backup backend with the default image fails",
+			modify: func(spec *RHMISpec) {
+				spec.Products = map[ProductName]ProductSpec{"backup": {Overrides: map[string]string{"BACKEND": "pvc"}}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "test channel pinned for manifests fails",
+			modify: func(spec *RHMISpec) {
+				spec.Products = map[ProductName]ProductSpec{Product3Scale: {CatalogSource: &CatalogSourceSpec{Channel: "stable"}}}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := validRHMISpec()
+			tt.modify(&spec)
+			err := ValidateRHMISpec(spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRHMISpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRHMI_GetCatalogSource(t *testing.T) {
+	installation := &RHMI{}
+	if catalogSource := installation.GetCatalogSource(Product3Scale); catalogSource.Type != CatalogSourceManifests {
+		t.Fatalf("expected the embedded manifests by default, got %v", catalogSource)
+	}
+
+	installation.Spec.CatalogSource = &CatalogSourceSpec{Type: CatalogSourceIndexImage, Image: "registry.example.com/rhmi/index:v2.5"}
+	installation.Spec.Products = map[ProductName]ProductSpec{
+		Product3Scale: {CatalogSource: &CatalogSourceSpec{Type: CatalogSourceExisting, Name: "redhat-operators"}},
+	}
+	if catalogSource := installation.GetCatalogSource(Product3Scale); catalogSource.Type != CatalogSourceExisting {
+		t.Fatalf("expected the catalog source of the product, got %v", catalogSource)
+	}
+	if catalogSource := installation.GetCatalogSource(ProductRHSSO); catalogSource.Type != CatalogSourceIndexImage {
+		t.Fatalf("expected the catalog source of the installation, got %v", catalogSource)
+	}
+}
+
+func TestRHMI_ValidateUpdate(t *testing.T) {
+	old := &RHMI{Spec: validRHMISpec()}
+
+	tests := []struct {
+		name    string
+		modify  func(installation *RHMI)
+		wantErr bool
+	}{
+		{
+			name:   "test changing mutable fields passes",
+			modify: func(installation *RHMI) { installation.Spec.SMTPSecret = "custom-smtp" },
+		},
+		{
+			name:    "test changing the type fails",
+			modify:  func(installation *RHMI) { installation.Spec.Type = string(InstallationTypeWorkshop) },
+			wantErr: true,
+		},
+		{
+			name:    "test changing the namespace prefix fails",
+			modify:  func(installation *RHMI) { installation.Spec.NamespacePrefix = "rhmi-" },
+			wantErr: true,
+		},
+		{
+			name: "test installation being deleted passes",
+			modify: func(installation *RHMI) {
+				now := metav1.Now()
+				installation.DeletionTimestamp = &now
+				installation.Spec.NamespacePrefix = "rhmi-"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installation := old.DeepCopy()
+			tt.modify(installation)
+			err := installation.ValidateUpdate(old)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAuthentication(t *testing.T) {
+	oidc := IdentityProviderSpec{
+		Alias:     "azure-ad",
+		Type:      IdentityProviderOIDC,
+		SecretRef: "azure-ad",
+		OIDC: &OIDCIdentityProvider{
+			AuthorizationURL: "https://login.microsoftonline.com/tenant/oauth2/v2.0/authorize",
+			TokenURL:         "https://login.microsoftonline.com/tenant/oauth2/v2.0/token",
+			ClientID:         "rhmi",
+		},
+		Mappers: []IdentityProviderMapper{{Name: "department", Attribute: "department", UserAttribute: "department"}},
+	}
+	ldap := IdentityProviderSpec{
+		Alias:     "corp-ad",
+		Type:      IdentityProviderLDAP,
+		SecretRef: "corp-ad",
+		LDAP:      &LDAPIdentityProvider{ConnectionURL: "ldaps://ad.example.com", BindDN: "cn=rhmi", UsersDN: "dc=example,dc=com"},
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(idps []IdentityProviderSpec) []IdentityProviderSpec
+		wantErr bool
+	}{
+		{
+			name:   "test valid providers pass",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec { return idps },
+		},
+		{
+			name: "test saml provider without secret passes",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec {
+				return append(idps, IdentityProviderSpec{Alias: "okta", Type: IdentityProviderSAML, SAML: &SAMLIdentityProvider{SingleSignOnServiceURL: "https://okta.example.com/sso"}})
+			},
+		},
+		{
+			name: "test duplicate aliases fail",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec {
+				idps[1].Alias = idps[0].Alias
+				return idps
+			},
+			wantErr: true,
+		},
+		{
+			name: "test alias of the operator providers fails",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec {
+				idps[0].Alias = "openshift-v4"
+				return idps
+			},
+			wantErr: true,
+		},
+		{
+			name: "test unknown type fails",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec {
+				idps[0].Type = "Kerberos"
+				return idps
+			},
+			wantErr: true,
+		},
+		{
+			name: "test oidc provider without client fails",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec {
+				idps[0].OIDC.ClientID = ""
+				return idps
+			},
+			wantErr: true,
+		},
+		{
+			name: "test ldap provider without secret fails",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec {
+				idps[1].SecretRef = ""
+				return idps
+			},
+			wantErr: true,
+		},
+		{
+			name: "test duplicate mappers fail",
+			modify: func(idps []IdentityProviderSpec) []IdentityProviderSpec {
+				idps[0].Mappers = append(idps[0].Mappers, idps[0].Mappers[0])
+				return idps
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oidcProvider := oidc
+			oidcProvider.OIDC = &OIDCIdentityProvider{}
+			*oidcProvider.OIDC = *oidc.OIDC
+			oidcProvider.Mappers = append([]IdentityProviderMapper{}, oidc.Mappers...)
+			idps := tt.modify([]IdentityProviderSpec{oidcProvider, ldap})
+			err := ValidateAuthentication(&AuthenticationSpec{IdentityProviders: idps})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAuthentication() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type BackupPhase string
+
+var (
+	BackupPhaseInProgress BackupPhase = "InProgress"
+	BackupPhaseCompleted  BackupPhase = "Completed"
+	BackupPhaseFailed     BackupPhase = "Failed"
+	// BackupPhaseUnsupported is the phase of the products whose data can not be backed up, such as the products on
+	// cluster storage
+	BackupPhaseUnsupported BackupPhase = "Unsupported"
+)
+
+// DefaultBackupTimeout is how long the backups of a product can take when the RHMIBackup does not set a timeout
+const DefaultBackupTimeout = "30m"
+
+// RHMIBackupSpec defines the products backed up on demand
+// +k8s:openapi-gen=true
+type RHMIBackupSpec struct {
+	// Products to back up. Every product of the installation with data to back up is backed up when empty
+	Products []ProductName `json:"products,omitempty"`
+
+	// Timeout of the backups of each product, defaults to 30m
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// RHMIBackupStatus records the backups performed
+// +k8s:openapi-gen=true
+type RHMIBackupStatus struct {
+	Phase       BackupPhase  `json:"phase,omitempty"`
+	StartedAt   *metav1.Time `json:"startedAt,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Message describes why the backup failed
+	Message string `json:"message,omitempty"`
+	// Products are the backups performed for each product
+	Products []ProductBackupStatus `json:"products,omitempty"`
+}
+
+type ProductBackupStatus struct {
+	Product ProductName `json:"product"`
+	Phase   BackupPhase `json:"phase"`
+	// Backups are the names of the snapshots and backup jobs created for the product
+	Backups     []string     `json:"backups,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	Message     string       `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RHMIBackup backs up the data of the products of the installation on demand
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=rhmibackups,scope=Namespaced
+type RHMIBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RHMIBackupSpec   `json:"spec,omitempty"`
+	Status RHMIBackupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RHMIBackupList contains a list of RHMIBackup
+type RHMIBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RHMIBackup `json:"items"`
+}
+
+// GetProductBackup returns the status of the backups of the product, or nil
+func (b *RHMIBackup) GetProductBackup(product ProductName) *ProductBackupStatus {
+	for i := range b.Status.Products {
+		if b.Status.Products[i].Product == product {
+			return &b.Status.Products[i]
+		}
+	}
+	return nil
+}
+
+func init() {
+	SchemeBuilder.Register(&RHMIBackup{}, &RHMIBackupList{})
+}
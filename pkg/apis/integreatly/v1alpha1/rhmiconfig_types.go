@@ -23,13 +23,17 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/integr8ly/integreatly-operator/pkg/resources/alerts"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
+	"github.com/prometheus/common/model"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -42,6 +46,10 @@ const (
 
 	// Maximum allowed number of days to schedule an upgrade via `NotBeforeDays`
 	MaxUpgradeDays = 14
+
+	// UpgradeActionUsernameAnnotation records the user that last set spec.upgrade.approveNow or
+	// spec.upgrade.postponeUntil, taken from the admission request
+	UpgradeActionUsernameAnnotation = "upgradeActionUsername"
 )
 
 // RHMIConfigSpec defines the desired state of RHMIConfig
@@ -49,6 +57,14 @@ type RHMIConfigSpec struct {
 	Upgrade     Upgrade     `json:"upgrade,omitempty"`
 	Maintenance Maintenance `json:"maintenance,omitempty"`
 	Backup      Backup      `json:"backup,omitempty"`
+	Alerts      Alerts      `json:"alerts,omitempty"`
+	// rateLimit: limits of the marin3r rate limit service
+	// +optional
+	RateLimit RateLimitPolicy `json:"rateLimit,omitempty"`
+	// users: which OpenShift users are synchronised to the products and which groups map to
+	// the admin and developer roles of the products
+	// +optional
+	Users UserPolicy `json:"users,omitempty"`
 }
 
 // RHMIConfigStatus defines the observed state of RHMIConfig
@@ -64,6 +80,10 @@ type RHMIConfigStatus struct {
 	Maintenance      RHMIConfigStatusMaintenance `json:"maintenance,omitempty"`
 	Upgrade          RHMIConfigStatusUpgrade     `json:"upgrade,omitempty"`
 	UpgradeAvailable *UpgradeAvailable           `json:"upgradeAvailable,omitempty"`
+	// Conditions of the config: Available, Degraded and Upgrading
+	Conditions []Condition `json:"conditions,omitempty"`
+	// UpgradeHistory records the latest upgrades of RHMI, oldest first
+	UpgradeHistory []UpgradeHistoryEntry `json:"upgradeHistory,omitempty"`
 }
 
 type RHMIConfigStatusMaintenance struct {
@@ -79,6 +99,8 @@ type RHMIConfigStatusUpgrade struct {
 type UpgradeSchedule struct {
 	// For is the calculated time when the upgrade is scheduled for, in format "2 Jan 2006 15:04"
 	For string `json:"for,omitempty"`
+	// Duration is the length of the maintenance window the upgrade is scheduled in
+	Duration string `json:"duration,omitempty"`
 }
 
 type UpgradeScheduleCalculation string
@@ -105,18 +127,207 @@ type Upgrade struct {
 	// +optional
 	// +nullable
 	NotBeforeDays *int `json:"notBeforeDays,omitempty"`
+
+	// approve-now: string, target version of the pending upgrade to approve immediately,
+	// regardless of the upgrade schedule. Must match status.upgradeAvailable.targetVersion.
+	// Cleared by the operator once the upgrade is approved
+	// +optional
+	ApproveNow string `json:"approveNow,omitempty"`
+
+	// postpone-until: string, date time the pending upgrade is not approved before, at
+	// most `MaxUpgradeDays` days after the upgrade was made available. Cleared by the
+	// operator once the upgrade is approved. Only service affecting upgrades are pending,
+	// upgrades that do not affect the service are approved as soon as they are available
+	// and can not be postponed
+	// Format: "2 Jan 2006 15:04". UTC time
+	// +optional
+	PostponeUntil string `json:"postponeUntil,omitempty"`
 }
 
 type Maintenance struct {
-	// apply-from: string, day time. Currently this is a 6 hour window.
+	// apply-from: string, day time the main maintenance window starts at. The main
+	// window lasts `DefaultMaintenanceDuration`, the windows in `windows` set their
+	// own duration and time zone
 	// Format: "DDD hh:mm" > "sun 23:00". UTC time
 	ApplyFrom string `json:"applyFrom,omitempty"`
+
+	// windows: additional weekly maintenance windows. Upgrades are scheduled in the
+	// next of any of the maintenance windows
+	// +optional
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+
+	// blackouts: date ranges in which no maintenance is performed, e.g. a year-end
+	// change freeze. Maintenance windows overlapping a blackout are skipped
+	// +optional
+	Blackouts []Blackout `json:"blackouts,omitempty"`
+}
+
+type MaintenanceWindow struct {
+	// apply-from: string, day time.
+	// Format: "DDD hh:mm" > "sun 23:00"
+	ApplyFrom string `json:"applyFrom"`
+
+	// duration: string, length of the window. Defaults to 6 hours
+	// Format: "4h", "2h30m"
+	// +optional
+	Duration string `json:"duration,omitempty"`
+
+	// time-zone: string, IANA time zone of applyFrom. Defaults to UTC
+	// Format: "Europe/Dublin"
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type Blackout struct {
+	// from: string, first day of the blackout.
+	// Format: "YYYY-MM-DD" > "2020-12-18"
+	From string `json:"from"`
+
+	// to: string, last day of the blackout, included in the blackout.
+	// Format: "YYYY-MM-DD" > "2021-01-04"
+	To string `json:"to"`
+
+	// time-zone: string, IANA time zone of the dates. Defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// reason: string, why maintenance is not allowed
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
 type Backup struct {
 	// apply-on: string, day time.
 	// Format: "DDD hh:mm" > "wed 20:00". UTC time
 	ApplyOn string `json:"applyOn,omitempty"`
+
+	// retention: policy of the pre-upgrade snapshots of the cloud resources. When
+	// not set the snapshots are kept
+	// +optional
+	Retention *BackupRetention `json:"retention,omitempty"`
+}
+
+type BackupRetention struct {
+	// keep-last: int, number of pre-upgrade snapshots kept for each resource
+	// +optional
+	// +nullable
+	KeepLast *int `json:"keepLast,omitempty"`
+
+	// older-than: string, pre-upgrade snapshots older than the duration are removed
+	// Format: "720h"
+	// +optional
+	OlderThan string `json:"olderThan,omitempty"`
+}
+
+// RateLimitUnit is the unit of time requests are limited over
+type RateLimitUnit string
+
+var (
+	RateLimitUnitSecond RateLimitUnit = "second"
+	RateLimitUnitMinute RateLimitUnit = "minute"
+	RateLimitUnitHour   RateLimitUnit = "hour"
+	RateLimitUnitDay    RateLimitUnit = "day"
+)
+
+type RateLimitPolicy struct {
+	// domains: rate limit configurations, each one applying to the envoy rate limit filters of
+	// the same domain. When not set a placeholder configuration is deployed
+	// +optional
+	Domains []RateLimitDomain `json:"domains,omitempty"`
+}
+
+type RateLimitDomain struct {
+	// domain: string, unique name of the domain, made of alphanumeric characters, '-', '_' or '.'
+	// Format: "apicast-ratelimit"
+	Domain string `json:"domain"`
+
+	// descriptors: limits of the requests matching the descriptors, such as a tenant or an API
+	// +optional
+	Descriptors []RateLimitDescriptor `json:"descriptors,omitempty"`
+}
+
+type RateLimitDescriptor struct {
+	// key: string, key of the descriptor entry
+	// Format: "tenant", "generic_key"
+	Key string `json:"key"`
+
+	// value: string, value of the descriptor entry. When not set the limit applies to each
+	// value of the key
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// rateLimit: limit of the requests matching the descriptor
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// descriptors: nested descriptors, limiting the requests matching this descriptor further
+	// +optional
+	Descriptors []RateLimitDescriptor `json:"descriptors,omitempty"`
+}
+
+type RateLimit struct {
+	// requestsPerUnit: int, number of requests allowed per unit of time
+	RequestsPerUnit uint32 `json:"requestsPerUnit"`
+
+	// unit: string, one of second, minute, hour or day
+	Unit RateLimitUnit `json:"unit"`
+}
+
+type UserPolicy struct {
+	// exclusionGroups: OpenShift groups whose members are not synchronised to the products
+	// nor added to the developers group. Defaults to layered-cs-sre-admins and osd-sre-admins
+	// +optional
+	ExclusionGroups []string `json:"exclusionGroups,omitempty"`
+
+	// userSelector: label selector of the OpenShift users synchronised to the products. When
+	// not set every user outside the exclusion groups is synchronised
+	// +optional
+	UserSelector *metav1.LabelSelector `json:"userSelector,omitempty"`
+
+	// developersGroup: string, OpenShift group maintained by the operator with the synchronised
+	// users, granting them the developer role in the products. Must be rhmi-developers, the
+	// default, or start with rhmi-developers-. A group of that name that was not created by
+	// the operator is not used
+	// +optional
+	DevelopersGroup string `json:"developersGroup,omitempty"`
+
+	// adminGroups: OpenShift groups whose members are admins of the products, regardless of
+	// the exclusion groups and user selector. Defaults to dedicated-admins
+	// +optional
+	AdminGroups []string `json:"adminGroups,omitempty"`
+
+	// products: admin groups of individual products, replacing adminGroups for the product
+	// +optional
+	Products []ProductUserRoles `json:"products,omitempty"`
+
+	// sync: how the changes to the users of RHSSO and 3scale are applied
+	// +optional
+	Sync UserSyncPolicy `json:"sync,omitempty"`
+}
+
+type UserSyncPolicy struct {
+	// dryRun: bool, when true the changes to the users are planned and reported in the
+	// status of the RHMI CR without being applied
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// maxChanges: int, number of changes applied to the users of a product per reconcile,
+	// the remaining changes are deferred to the next reconcile. Defaults to 100
+	// +optional
+	MaxChanges int `json:"maxChanges,omitempty"`
+
+	// changesPerSecond: int, number of changes applied to the users of a product per second,
+	// limiting the calls made to the API of the product. Defaults to 10
+	// +optional
+	ChangesPerSecond int `json:"changesPerSecond,omitempty"`
+}
+
+type ProductUserRoles struct {
+	// product: string, one of rhssouser, 3scale or codeready-workspaces
+	Product ProductName `json:"product"`
+
+	// adminGroups: OpenShift groups whose members are admins of the product
+	AdminGroups []string `json:"adminGroups"`
 }
 
 type UpgradeAvailable struct {
@@ -159,6 +370,26 @@ func (c *RHMIConfig) ValidateUpdate(old runtime.Object) error {
 		return err
 	}
 
+	if err := ValidateMaintenanceWindows(c.Spec.Maintenance, time.Now()); err != nil {
+		return err
+	}
+
+	if err := ValidateBackupRetention(c.Spec.Backup.Retention); err != nil {
+		return err
+	}
+
+	if err := ValidateAlertOverrides(c.Spec.Alerts.Overrides); err != nil {
+		return err
+	}
+
+	if err := ValidateRateLimitPolicy(c.Spec.RateLimit); err != nil {
+		return err
+	}
+
+	if err := ValidateUserPolicy(c.Spec.Users); err != nil {
+		return err
+	}
+
 	// Validate the NotBeforeDays. Must be an integer n where
 	// n > 0 && n <= MaxUpgradeDays
 	if c.Spec.Upgrade.NotBeforeDays != nil {
@@ -172,6 +403,253 @@ func (c *RHMIConfig) ValidateUpdate(old runtime.Object) error {
 		}
 	}
 
+	oldConfig, ok := old.(*RHMIConfig)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for the previous RHMIConfig", old)
+	}
+	return ValidateUpgradeActions(c.Spec.Upgrade, oldConfig.Spec.Upgrade, oldConfig.Status.UpgradeAvailable, time.Now().UTC())
+}
+
+// ValidateBackupRetention ensures that the retention policy keeps at least one snapshot and that its duration can be
+// parsed
+func ValidateBackupRetention(retention *BackupRetention) error {
+	if retention == nil {
+		return nil
+	}
+	if retention.KeepLast != nil && *retention.KeepLast < 1 {
+		return errors.New("Value of spec.Backup.Retention.KeepLast must be greater than zero")
+	}
+	if retention.OlderThan != "" {
+		olderThan, err := time.ParseDuration(retention.OlderThan)
+		if err != nil {
+			return fmt.Errorf("failed to parse spec.Backup.Retention.OlderThan value : expected a duration such as 720h found: %s", retention.OlderThan)
+		}
+		if olderThan <= 0 {
+			return fmt.Errorf("Value of spec.Backup.Retention.OlderThan must be greater than zero, found: %s", retention.OlderThan)
+		}
+	}
+	return nil
+}
+
+// ValidateAlertOverrides ensures that every override names a built-in alert once and that its values can be used in
+// an alert rule. Thresholds are only overridden for the alerts that have a threshold
+func ValidateAlertOverrides(overrides []AlertOverride) error {
+	seen := map[string]bool{}
+	for _, override := range overrides {
+		if err := ValidateAlertOverride(override); err != nil {
+			return err
+		}
+		if seen[override.Alert] {
+			return fmt.Errorf("spec.Alerts.Overrides contains more than one override for alert %s", override.Alert)
+		}
+		seen[override.Alert] = true
+	}
+	return nil
+}
+
+// ValidateAlertOverride ensures that the override names a built-in alert and that its values can be used in the rule of
+// the alert
+func ValidateAlertOverride(override AlertOverride) error {
+	if override.Alert == "" {
+		return errors.New("Value of spec.Alerts.Overrides.Alert must be set")
+	}
+
+	known, hasThreshold := alerts.Lookup(override.Alert)
+	if !known {
+		return fmt.Errorf("spec.Alerts.Overrides applies to unknown alert %s", override.Alert)
+	}
+	if override.Disabled && (override.Threshold != "" || override.For != "" || override.Severity != "") {
+		return fmt.Errorf("spec.Alerts.Overrides for alert %s can not change a disabled alert", override.Alert)
+	}
+	if override.Threshold != "" {
+		if !alertThresholdValue.MatchString(override.Threshold) {
+			return fmt.Errorf("failed to parse spec.Alerts.Overrides.Threshold value of alert %s : expected a decimal number found: %s", override.Alert, override.Threshold)
+		}
+		if !hasThreshold {
+			return fmt.Errorf("the threshold of alert %s can not be overridden", override.Alert)
+		}
+	}
+	if override.For != "" {
+		if _, err := model.ParseDuration(override.For); err != nil {
+			return fmt.Errorf("failed to parse spec.Alerts.Overrides.For value of alert %s : expected a duration such as 10m found: %s", override.Alert, override.For)
+		}
+	}
+	switch override.Severity {
+	case "", AlertSeverityCritical, AlertSeverityWarning, AlertSeverityInfo:
+	default:
+		return fmt.Errorf("Value of spec.Alerts.Overrides.Severity of alert %s must be one of critical, warning or info, found: %s", override.Alert, override.Severity)
+	}
+	return nil
+}
+
+// ValidateRateLimitPolicy ensures that the domains are unique, that the descriptors of a domain can be told apart and
+// that the limits have a valid unit, as required by the rate limit service. The configuration of each domain is a
+// file of the config map of the rate limit service, named after the domain
+func ValidateRateLimitPolicy(policy RateLimitPolicy) error {
+	domains := map[string]bool{}
+	for _, domain := range policy.Domains {
+		if domain.Domain == "" {
+			return errors.New("Value of spec.RateLimit.Domains.Domain must be set")
+		}
+		if errs := validation.IsConfigMapKey(domain.Domain + ".yaml"); len(errs) > 0 {
+			return fmt.Errorf("Value of spec.RateLimit.Domains.Domain must be usable as a config map key, found: %s: %s", domain.Domain, strings.Join(errs, ", "))
+		}
+		if domains[domain.Domain] {
+			return fmt.Errorf("spec.RateLimit.Domains contains more than one domain %s", domain.Domain)
+		}
+		domains[domain.Domain] = true
+
+		if err := validateRateLimitDescriptors(domain.Domain, domain.Descriptors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRateLimitDescriptors(path string, descriptors []RateLimitDescriptor) error {
+	keys := map[string]bool{}
+	for _, descriptor := range descriptors {
+		if descriptor.Key == "" {
+			return fmt.Errorf("Value of spec.RateLimit.Domains.Descriptors.Key must be set in %s", path)
+		}
+		key := descriptor.Key
+		if descriptor.Value != "" {
+			key += "_" + descriptor.Value
+		}
+		if keys[key] {
+			return fmt.Errorf("spec.RateLimit.Domains.Descriptors contains more than one descriptor %s in %s", key, path)
+		}
+		keys[key] = true
+
+		if descriptor.RateLimit != nil {
+			switch descriptor.RateLimit.Unit {
+			case RateLimitUnitSecond, RateLimitUnitMinute, RateLimitUnitHour, RateLimitUnitDay:
+			default:
+				return fmt.Errorf("Value of spec.RateLimit.Domains.Descriptors.RateLimit.Unit of descriptor %s in %s must be one of second, minute, hour or day, found: %s", key, path, descriptor.RateLimit.Unit)
+			}
+		}
+
+		if err := validateRateLimitDescriptors(path+"."+key, descriptor.Descriptors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DefaultDevelopersGroup is the OpenShift group the operator adds the synchronised users to. A developers group named
+// in the users policy must start with it, so that the operator only maintains groups it owns
+const DefaultDevelopersGroup = "rhmi-developers"
+
+// UserRoleProducts are the products whose admins can be set in spec.users.products
+var UserRoleProducts = []ProductName{ProductRHSSOUser, Product3Scale, ProductCodeReadyWorkspaces}
+
+// ValidateUserPolicy ensures that the groups are named once per list, that the user selector can be parsed and that
+// the developers group, whose members are overwritten by the operator, is named after DefaultDevelopersGroup and is
+// not one of the other groups. The number of changes applied per sync must not be negative
+func ValidateUserPolicy(policy UserPolicy) error {
+	if err := validateGroupNames("spec.Users.ExclusionGroups", policy.ExclusionGroups); err != nil {
+		return err
+	}
+	if err := validateGroupNames("spec.Users.AdminGroups", policy.AdminGroups); err != nil {
+		return err
+	}
+	if policy.UserSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(policy.UserSelector); err != nil {
+			return fmt.Errorf("failed to parse spec.Users.UserSelector value : %v", err)
+		}
+	}
+
+	otherGroups := append(append([]string{}, policy.ExclusionGroups...), policy.AdminGroups...)
+	products := map[ProductName]bool{}
+	for _, product := range policy.Products {
+		if !containsProduct(UserRoleProducts, product.Product) {
+			return fmt.Errorf("Value of spec.Users.Products.Product must be one of %v, found: %s", UserRoleProducts, product.Product)
+		}
+		if products[product.Product] {
+			return fmt.Errorf("spec.Users.Products contains more than one entry for product %s", product.Product)
+		}
+		products[product.Product] = true
+
+		if len(product.AdminGroups) == 0 {
+			return fmt.Errorf("Value of spec.Users.Products.AdminGroups of product %s must be set", product.Product)
+		}
+		if err := validateGroupNames(fmt.Sprintf("spec.Users.Products.AdminGroups of product %s", product.Product), product.AdminGroups); err != nil {
+			return err
+		}
+		otherGroups = append(otherGroups, product.AdminGroups...)
+	}
+
+	if policy.DevelopersGroup != "" && policy.DevelopersGroup != DefaultDevelopersGroup && !strings.HasPrefix(policy.DevelopersGroup, DefaultDevelopersGroup+"-") {
+		return fmt.Errorf("Value of spec.Users.DevelopersGroup must be %s or start with %s-, found: %s", DefaultDevelopersGroup, DefaultDevelopersGroup, policy.DevelopersGroup)
+	}
+	if policy.DevelopersGroup != "" && contains(otherGroups, policy.DevelopersGroup) {
+		return fmt.Errorf("Value of spec.Users.DevelopersGroup must not be an exclusion or admin group, found: %s", policy.DevelopersGroup)
+	}
+	if policy.Sync.MaxChanges < 0 {
+		return fmt.Errorf("Value of spec.Users.Sync.MaxChanges must not be negative, found: %d", policy.Sync.MaxChanges)
+	}
+	if policy.Sync.ChangesPerSecond < 0 {
+		return fmt.Errorf("Value of spec.Users.Sync.ChangesPerSecond must not be negative, found: %d", policy.Sync.ChangesPerSecond)
+	}
+	return nil
+}
+
+func validateGroupNames(field string, groups []string) error {
+	seen := map[string]bool{}
+	for _, group := range groups {
+		if group == "" {
+			return fmt.Errorf("Value of %s must not contain empty group names", field)
+		}
+		if seen[group] {
+			return fmt.Errorf("%s contains more than one group %s", field, group)
+		}
+		seen[group] = true
+	}
+	return nil
+}
+
+func containsProduct(products []ProductName, product ProductName) bool {
+	for _, p := range products {
+		if p == product {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateUpgradeActions ensures that the approveNow and postponeUntil actions apply to the pending upgrade. Actions
+// that did not change are not validated, as they are left until the operator clears them
+func ValidateUpgradeActions(upgrade, oldUpgrade Upgrade, upgradeAvailable *UpgradeAvailable, now time.Time) error {
+	approveNowChanged := upgrade.ApproveNow != "" && upgrade.ApproveNow != oldUpgrade.ApproveNow
+	postponeUntilChanged := upgrade.PostponeUntil != "" && upgrade.PostponeUntil != oldUpgrade.PostponeUntil
+	if !approveNowChanged && !postponeUntilChanged {
+		return nil
+	}
+
+	if upgrade.ApproveNow != "" && upgrade.PostponeUntil != "" {
+		return errors.New("spec.Upgrade.ApproveNow and spec.Upgrade.PostponeUntil can not be set together")
+	}
+	if upgradeAvailable == nil {
+		return errors.New("there is no pending upgrade to approve or postpone")
+	}
+
+	if approveNowChanged && upgrade.ApproveNow != upgradeAvailable.TargetVersion {
+		return fmt.Errorf("Value of spec.Upgrade.ApproveNow must be the version of the pending upgrade %s, found: %s", upgradeAvailable.TargetVersion, upgrade.ApproveNow)
+	}
+
+	if postponeUntilChanged {
+		postponeUntil, err := time.Parse(DateFormat, upgrade.PostponeUntil)
+		if err != nil {
+			return fmt.Errorf("failed to parse spec.Upgrade.PostponeUntil value : expected format %s found: %s", DateFormat, upgrade.PostponeUntil)
+		}
+		if !postponeUntil.After(now) {
+			return fmt.Errorf("Value of spec.Upgrade.PostponeUntil must be in the future, found: %s", upgrade.PostponeUntil)
+		}
+		if latest := upgradeAvailable.AvailableAt.Time.AddDate(0, 0, MaxUpgradeDays); postponeUntil.After(latest) {
+			return fmt.Errorf("Value of spec.Upgrade.PostponeUntil must not be more than %d days after the upgrade was made available, found: %s", MaxUpgradeDays, upgrade.PostponeUntil)
+		}
+	}
+
 	return nil
 }
 
@@ -179,6 +657,49 @@ func (c *RHMIConfig) ValidateDelete() error {
 	return nil
 }
 
+type AlertSeverity string
+
+var (
+	AlertSeverityCritical AlertSeverity = "critical"
+	AlertSeverityWarning  AlertSeverity = "warning"
+	AlertSeverityInfo     AlertSeverity = "info"
+)
+
+// alertThresholdValue matches the thresholds an alert expression can compare with
+var alertThresholdValue = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+type Alerts struct {
+	// overrides: changes to the built-in RHMI alerts, at most one per alert
+	// +optional
+	Overrides []AlertOverride `json:"overrides,omitempty"`
+}
+
+type AlertOverride struct {
+	// alert: string, name of the built-in alert
+	// Format: "RHMIThreeScaleApicastProductionServiceEndpointDown"
+	Alert string `json:"alert"`
+
+	// disabled: bool, the alert is removed from the alert rules
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// threshold: string, number the alert expression is compared with, replacing
+	// the threshold of the alert. Only alerts comparing their expression with a
+	// number can be re-thresholded
+	// Format: "95", "0.8"
+	// +optional
+	Threshold string `json:"threshold,omitempty"`
+
+	// for: string, how long the expression must be true before the alert fires
+	// Format: "10m", "1h"
+	// +optional
+	For string `json:"for,omitempty"`
+
+	// severity: string, one of critical, warning or info
+	// +optional
+	Severity AlertSeverity `json:"severity,omitempty"`
+}
+
 // +k8s:deepcopy-gen=false
 type rhmiConfigMutatingHandler struct {
 	decoder *admission.Decoder
@@ -203,9 +724,17 @@ func (h *rhmiConfigMutatingHandler) Handle(ctx context.Context, request admissio
 		rhmiConfig.Annotations = map[string]string{}
 	}
 
+	oldRhmiConfig := &RHMIConfig{}
+	if err := h.decoder.DecodeRaw(request.OldObject, oldRhmiConfig); err != nil {
+		oldRhmiConfig = &RHMIConfig{}
+	}
+
 	if request.UserInfo.Username != "system:serviceaccount:"+global.NamespacePrefix+"operator:rhmi-operator" {
 		rhmiConfig.Annotations["lastEditUsername"] = request.UserInfo.Username
 		rhmiConfig.Annotations["lastEditTimestamp"] = time.Now().UTC().Format(DateFormat)
+		setUpgradeActionUsername(rhmiConfig, oldRhmiConfig, request.UserInfo.Username)
+	} else {
+		setUpgradeActionUsername(rhmiConfig, oldRhmiConfig, "")
 	}
 
 	if rhmiConfig.Spec.Maintenance.ApplyFrom == "" {
@@ -223,11 +752,7 @@ func (h *rhmiConfigMutatingHandler) Handle(ctx context.Context, request admissio
 		WaitForMaintenance: &defaultWaitForMaintenance,
 	}
 
-	oldUpgradeSpec := &Upgrade{}
-	oldRhmiConfig := &RHMIConfig{}
-	if err := h.decoder.DecodeRaw(request.OldObject, oldRhmiConfig); err == nil {
-		oldUpgradeSpec = &oldRhmiConfig.Spec.Upgrade
-	}
+	oldUpgradeSpec := &oldRhmiConfig.Spec.Upgrade
 
 	rhmiConfig.Spec.Upgrade.WaitForMaintenance = either(
 		rhmiConfig.Spec.Upgrade.WaitForMaintenance,
@@ -249,6 +774,25 @@ func (h *rhmiConfigMutatingHandler) Handle(ctx context.Context, request admissio
 	return admission.PatchResponseFromRaw(request.Object.Raw, marshalled)
 }
 
+// setUpgradeActionUsername records the user of the admission request as the user of the upgrade actions when the
+// request sets a new approveNow or postponeUntil. Otherwise the user recorded for the previous actions is kept, the
+// annotation can not be changed through the object
+func setUpgradeActionUsername(rhmiConfig, oldRhmiConfig *RHMIConfig, username string) {
+	upgrade, oldUpgrade := rhmiConfig.Spec.Upgrade, oldRhmiConfig.Spec.Upgrade
+	approveNowChanged := upgrade.ApproveNow != "" && upgrade.ApproveNow != oldUpgrade.ApproveNow
+	postponeUntilChanged := upgrade.PostponeUntil != "" && upgrade.PostponeUntil != oldUpgrade.PostponeUntil
+	if username != "" && (approveNowChanged || postponeUntilChanged) {
+		rhmiConfig.Annotations[UpgradeActionUsernameAnnotation] = username
+		return
+	}
+
+	if previous, ok := oldRhmiConfig.Annotations[UpgradeActionUsernameAnnotation]; ok {
+		rhmiConfig.Annotations[UpgradeActionUsernameAnnotation] = previous
+	} else {
+		delete(rhmiConfig.Annotations, UpgradeActionUsernameAnnotation)
+	}
+}
+
 func (u *Upgrade) DefaultIfEmpty() {
 	u.NotBeforeDays = either(u.NotBeforeDays, DefaultNotBeforeDays).(*int)
 	u.WaitForMaintenance = either(u.WaitForMaintenance, DefaultWaitForMaintenance).(*bool)
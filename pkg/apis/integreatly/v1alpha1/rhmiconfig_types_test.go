@@ -1,6 +1,11 @@
 package v1alpha1
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 func TestValidateBackupAndMaintenance(t *testing.T) {
 	type args struct {
@@ -65,3 +70,427 @@ func TestValidateBackupAndMaintenance(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUpgradeActions(t *testing.T) {
+	now := time.Date(2020, time.June, 10, 12, 0, 0, 0, time.UTC)
+	upgradeAvailable := &UpgradeAvailable{
+		TargetVersion: "integreatly-operator.v2.5.0",
+		AvailableAt:   metav1.NewTime(now.AddDate(0, 0, -2)),
+	}
+
+	tests := []struct {
+		name             string
+		upgrade          Upgrade
+		oldUpgrade       Upgrade
+		upgradeAvailable *UpgradeAvailable
+		wantErr          bool
+	}{
+		{
+			name:             "test no upgrade actions",
+			upgradeAvailable: upgradeAvailable,
+		},
+		{
+			name:             "test approving the pending upgrade succeeds",
+			upgrade:          Upgrade{ApproveNow: "integreatly-operator.v2.5.0"},
+			upgradeAvailable: upgradeAvailable,
+		},
+		{
+			name:             "test approving another version fails",
+			upgrade:          Upgrade{ApproveNow: "integreatly-operator.v2.6.0"},
+			upgradeAvailable: upgradeAvailable,
+			wantErr:          true,
+		},
+		{
+			name:    "test approving without a pending upgrade fails",
+			upgrade: Upgrade{ApproveNow: "integreatly-operator.v2.5.0"},
+			wantErr: true,
+		},
+		{
+			name:       "test unchanged upgrade actions are not validated",
+			upgrade:    Upgrade{ApproveNow: "integreatly-operator.v2.5.0"},
+			oldUpgrade: Upgrade{ApproveNow: "integreatly-operator.v2.5.0"},
+		},
+		{
+			name:             "test postponing the pending upgrade succeeds",
+			upgrade:          Upgrade{PostponeUntil: "20 Jun 2020 10:00"},
+			upgradeAvailable: upgradeAvailable,
+		},
+		{
+			name:             "test postponing more than the maximum upgrade days fails",
+			upgrade:          Upgrade{PostponeUntil: "23 Jun 2020 10:00"},
+			upgradeAvailable: upgradeAvailable,
+			wantErr:          true,
+		},
+		{
+			name:             "test postponing to the past fails",
+			upgrade:          Upgrade{PostponeUntil: "9 Jun 2020 10:00"},
+			upgradeAvailable: upgradeAvailable,
+			wantErr:          true,
+		},
+		{
+			name:             "test postponing with an invalid date fails",
+			upgrade:          Upgrade{PostponeUntil: "2020-06-20"},
+			upgradeAvailable: upgradeAvailable,
+			wantErr:          true,
+		},
+		{
+			name:             "test approving and postponing together fails",
+			upgrade:          Upgrade{ApproveNow: "integreatly-operator.v2.5.0", PostponeUntil: "20 Jun 2020 10:00"},
+			upgradeAvailable: upgradeAvailable,
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpgradeActions(tt.upgrade, tt.oldUpgrade, tt.upgradeAvailable, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpgradeActions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetUpgradeActionUsername(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		upgrade     Upgrade
+		oldConfig   *RHMIConfig
+		username    string
+		want        string
+	}{
+		{
+			name:     "test the user approving the upgrade is recorded",
+			upgrade:  Upgrade{ApproveNow: "integreatly-operator.v2.5.0"},
+			username: "alice",
+			want:     "alice",
+		},
+		{
+			name:      "test the user postponing the upgrade is recorded",
+			upgrade:   Upgrade{PostponeUntil: "20 Jun 2020 10:00"},
+			oldConfig: &RHMIConfig{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{UpgradeActionUsernameAnnotation: "alice"}}},
+			username:  "bob",
+			want:      "bob",
+		},
+		{
+			name:        "test a later edit keeps the user of the upgrade action",
+			annotations: map[string]string{UpgradeActionUsernameAnnotation: "bob"},
+			upgrade:     Upgrade{ApproveNow: "integreatly-operator.v2.5.0"},
+			oldConfig: &RHMIConfig{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{UpgradeActionUsernameAnnotation: "alice"}},
+				Spec:       RHMIConfigSpec{Upgrade: Upgrade{ApproveNow: "integreatly-operator.v2.5.0"}},
+			},
+			username: "bob",
+			want:     "alice",
+		},
+		{
+			name:        "test the annotation can not be set without an upgrade action",
+			annotations: map[string]string{UpgradeActionUsernameAnnotation: "bob"},
+			username:    "bob",
+		},
+		{
+			name:      "test the operator keeps the user of the upgrade action",
+			oldConfig: &RHMIConfig{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{UpgradeActionUsernameAnnotation: "alice"}}},
+			want:      "alice",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &RHMIConfig{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}, Spec: RHMIConfigSpec{Upgrade: tt.upgrade}}
+			for key, value := range tt.annotations {
+				config.Annotations[key] = value
+			}
+			oldConfig := tt.oldConfig
+			if oldConfig == nil {
+				oldConfig = &RHMIConfig{}
+			}
+
+			setUpgradeActionUsername(config, oldConfig, tt.username)
+			if got := config.Annotations[UpgradeActionUsernameAnnotation]; got != tt.want {
+				t.Errorf("setUpgradeActionUsername() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBackupRetention(t *testing.T) {
+	zero := 0
+	three := 3
+	tests := []struct {
+		name      string
+		retention *BackupRetention
+		wantErr   bool
+	}{
+		{
+			name: "test no retention policy succeeds",
+		},
+		{
+			name:      "test keeping the last snapshots succeeds",
+			retention: &BackupRetention{KeepLast: &three, OlderThan: "720h"},
+		},
+		{
+			name:      "test keeping no snapshot fails",
+			retention: &BackupRetention{KeepLast: &zero},
+			wantErr:   true,
+		},
+		{
+			name:      "test invalid duration fails",
+			retention: &BackupRetention{OlderThan: "30 days"},
+			wantErr:   true,
+		},
+		{
+			name:      "test negative duration fails",
+			retention: &BackupRetention{OlderThan: "-1h"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBackupRetention(tt.retention)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBackupRetention() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAlertOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides []AlertOverride
+		wantErr   bool
+	}{
+		{
+			name: "test no overrides succeeds",
+		},
+		{
+			name: "test overrides succeed",
+			overrides: []AlertOverride{
+				{Alert: "RedisMemoryUsageHigh", Threshold: "95", For: "2h", Severity: AlertSeverityWarning},
+				{Alert: "PostgresCPUHigh", Disabled: true},
+				{Alert: "ThreescaleRedisCacheUnavailable", For: "1d"},
+			},
+		},
+		{
+			name:      "test override of an unknown alert fails",
+			overrides: []AlertOverride{{Alert: "UnknownAlert", Disabled: true}},
+			wantErr:   true,
+		},
+		{
+			name:      "test threshold of an alert without threshold fails",
+			overrides: []AlertOverride{{Alert: "ThreeScaleAdminUIBBT", Threshold: "1"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test override without alert fails",
+			overrides: []AlertOverride{{Threshold: "95"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test two overrides of the same alert fail",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", For: "2h"}, {Alert: "RedisMemoryUsageHigh", Disabled: true}},
+			wantErr:   true,
+		},
+		{
+			name:      "test changing a disabled alert fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", Disabled: true, Severity: AlertSeverityWarning}},
+			wantErr:   true,
+		},
+		{
+			name:      "test invalid threshold fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", Threshold: "95%"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test not a number threshold fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", Threshold: "NaN"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test infinite threshold fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", Threshold: "+Inf"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test hexadecimal threshold fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", Threshold: "0x1p-2"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test invalid duration fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", For: "2 hours"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test duration prometheus can not parse fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", For: "1.5h"}},
+			wantErr:   true,
+		},
+		{
+			name:      "test unknown severity fails",
+			overrides: []AlertOverride{{Alert: "RedisMemoryUsageHigh", Severity: "page"}},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAlertOverrides(tt.overrides)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAlertOverrides() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRateLimitPolicy(t *testing.T) {
+	limit := &RateLimit{RequestsPerUnit: 10, Unit: RateLimitUnitMinute}
+	tests := []struct {
+		name    string
+		policy  RateLimitPolicy
+		wantErr bool
+	}{
+		{
+			name: "test no policy succeeds",
+		},
+		{
+			name: "test policy succeeds",
+			policy: RateLimitPolicy{Domains: []RateLimitDomain{{
+				Domain: "apicast-ratelimit",
+				Descriptors: []RateLimitDescriptor{
+					{Key: "tenant", Value: "a", RateLimit: limit},
+					{Key: "tenant", Value: "b", Descriptors: []RateLimitDescriptor{{Key: "api", RateLimit: limit}}},
+				},
+			}}},
+		},
+		{
+			name:    "test domain without name fails",
+			policy:  RateLimitPolicy{Domains: []RateLimitDomain{{}}},
+			wantErr: true,
+		},
+		{
+			name:    "test domain unusable as a config map key fails",
+			policy:  RateLimitPolicy{Domains: []RateLimitDomain{{Domain: "apicast/ratelimit"}}},
+			wantErr: true,
+		},
+		{
+			name:    "test duplicate domains fail",
+			policy:  RateLimitPolicy{Domains: []RateLimitDomain{{Domain: "a"}, {Domain: "a"}}},
+			wantErr: true,
+		},
+		{
+			name: "test duplicate nested descriptors fail",
+			policy: RateLimitPolicy{Domains: []RateLimitDomain{{
+				Domain: "a",
+				Descriptors: []RateLimitDescriptor{
+					{Key: "tenant", Descriptors: []RateLimitDescriptor{{Key: "api", Value: "orders"}, {Key: "api", Value: "orders"}}},
+				},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "test descriptor without key fails",
+			policy: RateLimitPolicy{Domains: []RateLimitDomain{{
+				Domain:      "a",
+				Descriptors: []RateLimitDescriptor{{Value: "orders"}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "test unknown unit fails",
+			policy: RateLimitPolicy{Domains: []RateLimitDomain{{
+				Domain:      "a",
+				Descriptors: []RateLimitDescriptor{{Key: "tenant", RateLimit: &RateLimit{RequestsPerUnit: 1, Unit: "week"}}},
+			}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRateLimitPolicy(tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRateLimitPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUserPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  UserPolicy
+		wantErr bool
+	}{
+		{
+			name: "test valid policy passes",
+			policy: UserPolicy{
+				ExclusionGroups: []string{"osd-sre-admins"},
+				UserSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"rhmi": "true"}},
+				DevelopersGroup: "rhmi-developers-integration",
+				AdminGroups:     []string{"dedicated-admins"},
+				Products:        []ProductUserRoles{{Product: Product3Scale, AdminGroups: []string{"api-admins"}}},
+			},
+		},
+		{
+			name:   "test empty policy passes",
+			policy: UserPolicy{},
+		},
+		{
+			name:    "test duplicate exclusion group fails",
+			policy:  UserPolicy{ExclusionGroups: []string{"osd-sre-admins", "osd-sre-admins"}},
+			wantErr: true,
+		},
+		{
+			name: "test invalid user selector fails",
+			policy: UserPolicy{UserSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "rhmi", Operator: "Contains"},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "test unsupported product fails",
+			policy:  UserPolicy{Products: []ProductUserRoles{{Product: ProductFuse, AdminGroups: []string{"fuse-admins"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "test product without admin groups fails",
+			policy:  UserPolicy{Products: []ProductUserRoles{{Product: ProductRHSSOUser}}},
+			wantErr: true,
+		},
+		{
+			name: "test developers group of admins fails",
+			policy: UserPolicy{
+				DevelopersGroup: "rhmi-developers-admins",
+				Products:        []ProductUserRoles{{Product: Product3Scale, AdminGroups: []string{"rhmi-developers-admins"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "test developers group not owned by the operator fails",
+			policy:  UserPolicy{DevelopersGroup: "dedicated-admins"},
+			wantErr: true,
+		},
+		{
+			name:    "test developers group sharing the prefix of the default group fails",
+			policy:  UserPolicy{DevelopersGroup: "rhmi-developersadmins"},
+			wantErr: true,
+		},
+		{
+			name:    "test negative max changes fails",
+			policy:  UserPolicy{Sync: UserSyncPolicy{DryRun: true, MaxChanges: -1}},
+			wantErr: true,
+		},
+		{
+			name:    "test negative changes per second fails",
+			policy:  UserPolicy{Sync: UserSyncPolicy{ChangesPerSecond: -1}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUserPolicy(tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUserPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
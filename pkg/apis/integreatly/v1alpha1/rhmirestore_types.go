@@ -0,0 +1,91 @@
+package v1alpha1
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type RestorePhase string
+
+var (
+	// RestorePhaseScalingDown stops the product operator and the workloads of the product
+	RestorePhaseScalingDown RestorePhase = "ScalingDown"
+	// RestorePhaseRestoring runs the restore job
+	RestorePhaseRestoring RestorePhase = "Restoring"
+	// RestorePhaseScalingUp restores the replicas of the workloads scaled down
+	RestorePhaseScalingUp RestorePhase = "ScalingUp"
+	RestorePhaseCompleted RestorePhase = "Completed"
+	RestorePhaseFailed    RestorePhase = "Failed"
+)
+
+// RestoreBackupsEnvVar lists the names of the backups restored, comma separated, in the containers of the restore job
+const RestoreBackupsEnvVar = "RHMI_RESTORE_BACKUPS"
+
+// RHMIRestoreSpec defines the backups of a product restored
+// +k8s:openapi-gen=true
+type RHMIRestoreSpec struct {
+	// Backup is the name of a completed RHMIBackup in the namespace of the RHMIRestore
+	Backup string `json:"backup"`
+
+	// Product restored from the backups of the RHMIBackup
+	Product ProductName `json:"product"`
+
+	// Job restores the backups while the product is scaled down, defaults to the restore job of the product. The names
+	// of the backups are set in the RHMI_RESTORE_BACKUPS environment variable of its containers
+	Job *batchv1.JobSpec `json:"job,omitempty"`
+
+	// Timeout of the restore job, defaults to 30m
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// RHMIRestoreStatus records the progress of the restore
+// +k8s:openapi-gen=true
+type RHMIRestoreStatus struct {
+	Phase       RestorePhase `json:"phase,omitempty"`
+	StartedAt   *metav1.Time `json:"startedAt,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Job is the name of the restore job
+	Job string `json:"job,omitempty"`
+	// ScaledDown are the workloads scaled down for the restore, with the replicas they are scaled back up to
+	ScaledDown []ScaledWorkload `json:"scaledDown,omitempty"`
+	Message    string           `json:"message,omitempty"`
+}
+
+type ScaledWorkload struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Replicas  int32  `json:"replicas"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RHMIRestore restores a product from the backups of a RHMIBackup
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=rhmirestores,scope=Namespaced
+type RHMIRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RHMIRestoreSpec   `json:"spec,omitempty"`
+	Status RHMIRestoreStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RHMIRestoreList contains a list of RHMIRestore
+type RHMIRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RHMIRestore `json:"items"`
+}
+
+// InProgress checks if the product is being restored, the installation does not reconcile it until the restore
+// completes
+func (r *RHMIRestore) InProgress() bool {
+	return r.Status.Phase != RestorePhaseCompleted && r.Status.Phase != RestorePhaseFailed
+}
+
+func init() {
+	SchemeBuilder.Register(&RHMIRestore{}, &RHMIRestoreList{})
+}
@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpgradeHistoryLimit is the number of upgrades kept in the upgrade history of the RHMIConfig, older upgrades are
+// removed from the history
+const UpgradeHistoryLimit = 10
+
+type UpgradeApproval string
+
+var (
+	// UpgradeApprovalMaintenanceWindow is set for upgrades approved automatically in their maintenance window
+	UpgradeApprovalMaintenanceWindow UpgradeApproval = "MaintenanceWindow"
+	// UpgradeApprovalNotServiceAffecting is set for upgrades approved as soon as they are available, as they do
+	// not affect the service
+	UpgradeApprovalNotServiceAffecting UpgradeApproval = "NotServiceAffecting"
+	// UpgradeApprovalManual is set for upgrades approved through spec.upgrade.approveNow
+	UpgradeApprovalManual UpgradeApproval = "Manual"
+	// UpgradeApprovalPostponed is set for upgrades postponed through spec.upgrade.postponeUntil, approved by the
+	// upgrade schedule after the date they were postponed to
+	UpgradeApprovalPostponed UpgradeApproval = "Postponed"
+)
+
+type UpgradeOutcome string
+
+var (
+	UpgradeOutcomeInProgress UpgradeOutcome = "InProgress"
+	UpgradeOutcomeSucceeded  UpgradeOutcome = "Succeeded"
+	UpgradeOutcomeFailed     UpgradeOutcome = "Failed"
+)
+
+// UpgradeHistoryEntry records an upgrade of RHMI approved by the operator
+type UpgradeHistoryEntry struct {
+	// FromVersion is the version of RHMI before the upgrade
+	FromVersion string `json:"fromVersion,omitempty"`
+	// ToVersion is the version of RHMI the upgrade installs
+	ToVersion string `json:"toVersion"`
+	// InstallPlan is the name of the approved install plan
+	InstallPlan string `json:"installPlan,omitempty"`
+	// ScheduledFor is the time the upgrade was scheduled for, in format "2 Jan 2006 15:04"
+	ScheduledFor string `json:"scheduledFor,omitempty"`
+	// ApprovedAt is the time the install plan of the upgrade was approved
+	ApprovedAt metav1.Time `json:"approvedAt"`
+	// Approval is how the upgrade was approved
+	Approval UpgradeApproval `json:"approval"`
+	// ApprovedBy is the user that approved or postponed the upgrade, empty for upgrades approved automatically
+	ApprovedBy string `json:"approvedBy,omitempty"`
+	// Backups are the names of the backups performed before the products were upgraded
+	Backups []string `json:"backups,omitempty"`
+	// Outcome is InProgress until the upgrade either Succeeded or Failed
+	Outcome UpgradeOutcome `json:"outcome"`
+	// CompletedAt is the time the outcome of the upgrade was known
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Message describes why the upgrade failed
+	Message string `json:"message,omitempty"`
+}
+
+// RecordUpgrade adds an upgrade to the upgrade history, removing the oldest upgrades over the UpgradeHistoryLimit.
+// It returns false when the install plan of the upgrade was already recorded
+func (c *RHMIConfig) RecordUpgrade(entry UpgradeHistoryEntry) bool {
+	for _, recorded := range c.Status.UpgradeHistory {
+		if recorded.InstallPlan != "" && recorded.InstallPlan == entry.InstallPlan && recorded.ToVersion == entry.ToVersion {
+			return false
+		}
+	}
+
+	c.Status.UpgradeHistory = append(c.Status.UpgradeHistory, entry)
+	if overLimit := len(c.Status.UpgradeHistory) - UpgradeHistoryLimit; overLimit > 0 {
+		c.Status.UpgradeHistory = c.Status.UpgradeHistory[overLimit:]
+	}
+	return true
+}
+
+// GetUpgradeInProgress returns the latest upgrade of the history that has no outcome yet, or nil
+func (c *RHMIConfig) GetUpgradeInProgress() *UpgradeHistoryEntry {
+	for i := len(c.Status.UpgradeHistory) - 1; i >= 0; i-- {
+		if c.Status.UpgradeHistory[i].Outcome == UpgradeOutcomeInProgress {
+			return &c.Status.UpgradeHistory[i]
+		}
+	}
+	return nil
+}
+
+// Complete records the outcome of the upgrade
+func (e *UpgradeHistoryEntry) Complete(outcome UpgradeOutcome, message string) {
+	now := metav1.Now()
+	e.Outcome = outcome
+	e.Message = message
+	e.CompletedAt = &now
+}
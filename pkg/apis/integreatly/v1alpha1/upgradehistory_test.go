@@ -0,0 +1,47 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRecordUpgrade(t *testing.T) {
+	config := &RHMIConfig{}
+
+	for i := 0; i < UpgradeHistoryLimit+2; i++ {
+		recorded := config.RecordUpgrade(UpgradeHistoryEntry{
+			ToVersion:   fmt.Sprintf("2.%d.0", i),
+			InstallPlan: fmt.Sprintf("install-%d", i),
+			Outcome:     UpgradeOutcomeSucceeded,
+		})
+		if !recorded {
+			t.Fatalf("expected upgrade to 2.%d.0 to be recorded", i)
+		}
+	}
+
+	if len(config.Status.UpgradeHistory) != UpgradeHistoryLimit {
+		t.Fatalf("expected the history to be capped at %d entries, got %d", UpgradeHistoryLimit, len(config.Status.UpgradeHistory))
+	}
+	if config.Status.UpgradeHistory[0].ToVersion != "2.2.0" {
+		t.Fatalf("expected the oldest upgrades to be removed, first upgrade is to %s", config.Status.UpgradeHistory[0].ToVersion)
+	}
+
+	if config.RecordUpgrade(UpgradeHistoryEntry{ToVersion: "2.11.0", InstallPlan: "install-11"}) {
+		t.Fatalf("expected an upgrade already recorded to not be recorded again")
+	}
+	if config.GetUpgradeInProgress() != nil {
+		t.Fatalf("expected no upgrade in progress")
+	}
+
+	config.RecordUpgrade(UpgradeHistoryEntry{ToVersion: "2.12.0", InstallPlan: "install-12", Outcome: UpgradeOutcomeInProgress})
+	upgrade := config.GetUpgradeInProgress()
+	if upgrade == nil || upgrade.ToVersion != "2.12.0" {
+		t.Fatalf("expected the upgrade to 2.12.0 to be in progress, got %v", upgrade)
+	}
+
+	upgrade.Complete(UpgradeOutcomeFailed, "install plan failed")
+	last := config.Status.UpgradeHistory[len(config.Status.UpgradeHistory)-1]
+	if last.Outcome != UpgradeOutcomeFailed || last.CompletedAt == nil {
+		t.Fatalf("expected the outcome of the upgrade to be recorded in the history, got %v", last)
+	}
+}
@@ -21,12 +21,133 @@ limitations under the License.
 package v1alpha1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertOverride) DeepCopyInto(out *AlertOverride) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertOverride.
+func (in *AlertOverride) DeepCopy() *AlertOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertReceiver) DeepCopyInto(out *AlertReceiver) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]ProductName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertReceiver.
+func (in *AlertReceiver) DeepCopy() *AlertReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertingSpec) DeepCopyInto(out *AlertingSpec) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]AlertReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingSpec.
+func (in *AlertingSpec) DeepCopy() *AlertingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Alerts) DeepCopyInto(out *Alerts) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]AlertOverride, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Alerts.
+func (in *Alerts) DeepCopy() *Alerts {
+	if in == nil {
+		return nil
+	}
+	out := new(Alerts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
+	*out = *in
+	if in.IdentityProviders != nil {
+		in, out := &in.IdentityProviders, &out.IdentityProviders
+		*out = make([]IdentityProviderSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
+func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -40,9 +161,167 @@ func (in *Backup) DeepCopy() *Backup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Blackout) DeepCopyInto(out *Blackout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Blackout.
+func (in *Blackout) DeepCopy() *Blackout {
+	if in == nil {
+		return nil
+	}
+	out := new(Blackout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogSourceSpec) DeepCopyInto(out *CatalogSourceSpec) {
+	*out = *in
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogSourceSpec.
+func (in *CatalogSourceSpec) DeepCopy() *CatalogSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderMapper) DeepCopyInto(out *IdentityProviderMapper) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderMapper.
+func (in *IdentityProviderMapper) DeepCopy() *IdentityProviderMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderSpec) DeepCopyInto(out *IdentityProviderSpec) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCIdentityProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(SAMLIdentityProvider)
+		**out = **in
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(LDAPIdentityProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mappers != nil {
+		in, out := &in.Mappers, &out.Mappers
+		*out = make([]IdentityProviderMapper, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderSpec.
+func (in *IdentityProviderSpec) DeepCopy() *IdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPIdentityProvider) DeepCopyInto(out *LDAPIdentityProvider) {
+	*out = *in
+	if in.UserObjectClasses != nil {
+		in, out := &in.UserObjectClasses, &out.UserObjectClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProvider.
+func (in *LDAPIdentityProvider) DeepCopy() *LDAPIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]Blackout, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -57,51 +336,604 @@ func (in *Maintenance) DeepCopy() *Maintenance {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PullSecretSpec) DeepCopyInto(out *PullSecretSpec) {
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullSecretSpec.
-func (in *PullSecretSpec) DeepCopy() *PullSecretSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(PullSecretSpec)
+	out := new(MaintenanceWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMI) DeepCopyInto(out *RHMI) {
+func (in *OIDCIdentityProvider) DeepCopyInto(out *OIDCIdentityProvider) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCIdentityProvider.
+func (in *OIDCIdentityProvider) DeepCopy() *OIDCIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightCheckStatus) DeepCopyInto(out *PreflightCheckStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightCheckStatus.
+func (in *PreflightCheckStatus) DeepCopy() *PreflightCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreflightCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductBackupStatus) DeepCopyInto(out *ProductBackupStatus) {
+	*out = *in
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductBackupStatus.
+func (in *ProductBackupStatus) DeepCopy() *ProductBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductSLO) DeepCopyInto(out *ProductSLO) {
+	*out = *in
+	if in.BlackboxServices != nil {
+		in, out := &in.BlackboxServices, &out.BlackboxServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductSLO.
+func (in *ProductSLO) DeepCopy() *ProductSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductSpec) DeepCopyInto(out *ProductSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(ProductSLO)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CatalogSource != nil {
+		in, out := &in.CatalogSource, &out.CatalogSource
+		*out = new(CatalogSourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductSpec.
+func (in *ProductSpec) DeepCopy() *ProductSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductUpgrade) DeepCopyInto(out *ProductUpgrade) {
+	*out = *in
+	in.ApprovedAt.DeepCopyInto(&out.ApprovedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductUpgrade.
+func (in *ProductUpgrade) DeepCopy() *ProductUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductUpgradePolicy) DeepCopyInto(out *ProductUpgradePolicy) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]ProductName, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]ProductHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductUpgradePolicy.
+func (in *ProductUpgradePolicy) DeepCopy() *ProductUpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductUpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductUpgradesStatus) DeepCopyInto(out *ProductUpgradesStatus) {
+	*out = *in
+	if in.Current != nil {
+		in, out := &in.Current, &out.Current
+		*out = new(ProductUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]ProductName, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductUpgradesStatus.
+func (in *ProductUpgradesStatus) DeepCopy() *ProductUpgradesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductUpgradesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductUserRoles) DeepCopyInto(out *ProductUserRoles) {
+	*out = *in
+	if in.AdminGroups != nil {
+		in, out := &in.AdminGroups, &out.AdminGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductUserRoles.
+func (in *ProductUserRoles) DeepCopy() *ProductUserRoles {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductUserRoles)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullSecretSpec) DeepCopyInto(out *PullSecretSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullSecretSpec.
+func (in *PullSecretSpec) DeepCopy() *PullSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PullSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMI) DeepCopyInto(out *RHMI) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMI.
+func (in *RHMI) DeepCopy() *RHMI {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RHMI) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIBackup) DeepCopyInto(out *RHMIBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIBackup.
+func (in *RHMIBackup) DeepCopy() *RHMIBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RHMIBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIBackupList) DeepCopyInto(out *RHMIBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RHMIBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIBackupList.
+func (in *RHMIBackupList) DeepCopy() *RHMIBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RHMIBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIBackupSpec) DeepCopyInto(out *RHMIBackupSpec) {
+	*out = *in
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]ProductName, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIBackupSpec.
+func (in *RHMIBackupSpec) DeepCopy() *RHMIBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIBackupStatus) DeepCopyInto(out *RHMIBackupStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]ProductBackupStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIBackupStatus.
+func (in *RHMIBackupStatus) DeepCopy() *RHMIBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIConfig) DeepCopyInto(out *RHMIConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfig.
+func (in *RHMIConfig) DeepCopy() *RHMIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RHMIConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIConfigList) DeepCopyInto(out *RHMIConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RHMIConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigList.
+func (in *RHMIConfigList) DeepCopy() *RHMIConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RHMIConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIConfigSpec) DeepCopyInto(out *RHMIConfigSpec) {
+	*out = *in
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
+	in.Maintenance.DeepCopyInto(&out.Maintenance)
+	in.Backup.DeepCopyInto(&out.Backup)
+	in.Alerts.DeepCopyInto(&out.Alerts)
+	in.RateLimit.DeepCopyInto(&out.RateLimit)
+	in.Users.DeepCopyInto(&out.Users)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigSpec.
+func (in *RHMIConfigSpec) DeepCopy() *RHMIConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIConfigStatus) DeepCopyInto(out *RHMIConfigStatus) {
+	*out = *in
+	out.Maintenance = in.Maintenance
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
+	if in.UpgradeAvailable != nil {
+		in, out := &in.UpgradeAvailable, &out.UpgradeAvailable
+		*out = new(UpgradeAvailable)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpgradeHistory != nil {
+		in, out := &in.UpgradeHistory, &out.UpgradeHistory
+		*out = make([]UpgradeHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigStatus.
+func (in *RHMIConfigStatus) DeepCopy() *RHMIConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIConfigStatusMaintenance) DeepCopyInto(out *RHMIConfigStatusMaintenance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigStatusMaintenance.
+func (in *RHMIConfigStatusMaintenance) DeepCopy() *RHMIConfigStatusMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIConfigStatusMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIConfigStatusUpgrade) DeepCopyInto(out *RHMIConfigStatusUpgrade) {
+	*out = *in
+	if in.Scheduled != nil {
+		in, out := &in.Scheduled, &out.Scheduled
+		*out = new(UpgradeSchedule)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigStatusUpgrade.
+func (in *RHMIConfigStatusUpgrade) DeepCopy() *RHMIConfigStatusUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIConfigStatusUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIList) DeepCopyInto(out *RHMIList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RHMI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIList.
+func (in *RHMIList) DeepCopy() *RHMIList {
+	if in == nil {
+		return nil
+	}
+	out := new(RHMIList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RHMIList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHMIProductStatus) DeepCopyInto(out *RHMIProductStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMI.
-func (in *RHMI) DeepCopy() *RHMI {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIProductStatus.
+func (in *RHMIProductStatus) DeepCopy() *RHMIProductStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMI)
+	out := new(RHMIProductStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RHMI) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIConfig) DeepCopyInto(out *RHMIConfig) {
+func (in *RHMIRestore) DeepCopyInto(out *RHMIRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -110,18 +942,18 @@ func (in *RHMIConfig) DeepCopyInto(out *RHMIConfig) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfig.
-func (in *RHMIConfig) DeepCopy() *RHMIConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIRestore.
+func (in *RHMIRestore) DeepCopy() *RHMIRestore {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIConfig)
+	out := new(RHMIRestore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RHMIConfig) DeepCopyObject() runtime.Object {
+func (in *RHMIRestore) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -129,13 +961,13 @@ func (in *RHMIConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIConfigList) DeepCopyInto(out *RHMIConfigList) {
+func (in *RHMIRestoreList) DeepCopyInto(out *RHMIRestoreList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RHMIConfig, len(*in))
+		*out = make([]RHMIRestore, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -143,18 +975,18 @@ func (in *RHMIConfigList) DeepCopyInto(out *RHMIConfigList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigList.
-func (in *RHMIConfigList) DeepCopy() *RHMIConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIRestoreList.
+func (in *RHMIRestoreList) DeepCopy() *RHMIRestoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIConfigList)
+	out := new(RHMIRestoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RHMIConfigList) DeepCopyObject() runtime.Object {
+func (in *RHMIRestoreList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -162,92 +994,152 @@ func (in *RHMIConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIConfigSpec) DeepCopyInto(out *RHMIConfigSpec) {
+func (in *RHMIRestoreSpec) DeepCopyInto(out *RHMIRestoreSpec) {
 	*out = *in
-	in.Upgrade.DeepCopyInto(&out.Upgrade)
-	out.Maintenance = in.Maintenance
-	out.Backup = in.Backup
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(batchv1.JobSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigSpec.
-func (in *RHMIConfigSpec) DeepCopy() *RHMIConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIRestoreSpec.
+func (in *RHMIRestoreSpec) DeepCopy() *RHMIRestoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIConfigSpec)
+	out := new(RHMIRestoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIConfigStatus) DeepCopyInto(out *RHMIConfigStatus) {
+func (in *RHMIRestoreStatus) DeepCopyInto(out *RHMIRestoreStatus) {
 	*out = *in
-	out.Maintenance = in.Maintenance
-	in.Upgrade.DeepCopyInto(&out.Upgrade)
-	if in.UpgradeAvailable != nil {
-		in, out := &in.UpgradeAvailable, &out.UpgradeAvailable
-		*out = new(UpgradeAvailable)
-		(*in).DeepCopyInto(*out)
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ScaledDown != nil {
+		in, out := &in.ScaledDown, &out.ScaledDown
+		*out = make([]ScaledWorkload, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigStatus.
-func (in *RHMIConfigStatus) DeepCopy() *RHMIConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIRestoreStatus.
+func (in *RHMIRestoreStatus) DeepCopy() *RHMIRestoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIConfigStatus)
+	out := new(RHMIRestoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIConfigStatusMaintenance) DeepCopyInto(out *RHMIConfigStatusMaintenance) {
+func (in *RHMISpec) DeepCopyInto(out *RHMISpec) {
 	*out = *in
+	out.PullSecret = in.PullSecret
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make(map[ProductName]ProductSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ProductUpgrades != nil {
+		in, out := &in.ProductUpgrades, &out.ProductUpgrades
+		*out = new(ProductUpgradePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(AlertingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CatalogSource != nil {
+		in, out := &in.CatalogSource, &out.CatalogSource
+		*out = new(CatalogSourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigStatusMaintenance.
-func (in *RHMIConfigStatusMaintenance) DeepCopy() *RHMIConfigStatusMaintenance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMISpec.
+func (in *RHMISpec) DeepCopy() *RHMISpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIConfigStatusMaintenance)
+	out := new(RHMISpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIConfigStatusUpgrade) DeepCopyInto(out *RHMIConfigStatusUpgrade) {
+func (in *RHMIStageStatus) DeepCopyInto(out *RHMIStageStatus) {
 	*out = *in
-	if in.Scheduled != nil {
-		in, out := &in.Scheduled, &out.Scheduled
-		*out = new(UpgradeSchedule)
-		**out = **in
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make(map[ProductName]RHMIProductStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIConfigStatusUpgrade.
-func (in *RHMIConfigStatusUpgrade) DeepCopy() *RHMIConfigStatusUpgrade {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIStageStatus.
+func (in *RHMIStageStatus) DeepCopy() *RHMIStageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIConfigStatusUpgrade)
+	out := new(RHMIStageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIList) DeepCopyInto(out *RHMIList) {
+func (in *RHMIStatus) DeepCopyInto(out *RHMIStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]RHMI, len(*in))
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make(map[StageName]RHMIStageStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = make([]PreflightCheckStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProductUpgrades != nil {
+		in, out := &in.ProductUpgrades, &out.ProductUpgrades
+		*out = new(ProductUpgradesStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSync != nil {
+		in, out := &in.UserSync, &out.UserSync
+		*out = make([]UserSyncStatus, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -255,99 +1147,134 @@ func (in *RHMIList) DeepCopyInto(out *RHMIList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIList.
-func (in *RHMIList) DeepCopy() *RHMIList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIStatus.
+func (in *RHMIStatus) DeepCopy() *RHMIStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIList)
+	out := new(RHMIStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RHMIList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIProductStatus) DeepCopyInto(out *RHMIProductStatus) {
+func (in *RateLimitDescriptor) DeepCopyInto(out *RateLimitDescriptor) {
 	*out = *in
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]RateLimitDescriptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIProductStatus.
-func (in *RHMIProductStatus) DeepCopy() *RHMIProductStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptor.
+func (in *RateLimitDescriptor) DeepCopy() *RateLimitDescriptor {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIProductStatus)
+	out := new(RateLimitDescriptor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMISpec) DeepCopyInto(out *RHMISpec) {
+func (in *RateLimitDomain) DeepCopyInto(out *RateLimitDomain) {
 	*out = *in
-	out.PullSecret = in.PullSecret
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]RateLimitDescriptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMISpec.
-func (in *RHMISpec) DeepCopy() *RHMISpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDomain.
+func (in *RateLimitDomain) DeepCopy() *RateLimitDomain {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMISpec)
+	out := new(RateLimitDomain)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIStageStatus) DeepCopyInto(out *RHMIStageStatus) {
+func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
 	*out = *in
-	if in.Products != nil {
-		in, out := &in.Products, &out.Products
-		*out = make(map[ProductName]RHMIProductStatus, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]RateLimitDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIStageStatus.
-func (in *RHMIStageStatus) DeepCopy() *RHMIStageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
+func (in *RateLimitPolicy) DeepCopy() *RateLimitPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIStageStatus)
+	out := new(RateLimitPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHMIStatus) DeepCopyInto(out *RHMIStatus) {
+func (in *SAMLIdentityProvider) DeepCopyInto(out *SAMLIdentityProvider) {
 	*out = *in
-	if in.Stages != nil {
-		in, out := &in.Stages, &out.Stages
-		*out = make(map[StageName]RHMIStageStatus, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLIdentityProvider.
+func (in *SAMLIdentityProvider) DeepCopy() *SAMLIdentityProvider {
+	if in == nil {
+		return nil
 	}
+	out := new(SAMLIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledWorkload) DeepCopyInto(out *ScaledWorkload) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHMIStatus.
-func (in *RHMIStatus) DeepCopy() *RHMIStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledWorkload.
+func (in *ScaledWorkload) DeepCopy() *ScaledWorkload {
 	if in == nil {
 		return nil
 	}
-	out := new(RHMIStatus)
+	out := new(ScaledWorkload)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -395,6 +1322,32 @@ func (in *UpgradeAvailable) DeepCopy() *UpgradeAvailable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeHistoryEntry) DeepCopyInto(out *UpgradeHistoryEntry) {
+	*out = *in
+	in.ApprovedAt.DeepCopyInto(&out.ApprovedAt)
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeHistoryEntry.
+func (in *UpgradeHistoryEntry) DeepCopy() *UpgradeHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradeSchedule) DeepCopyInto(out *UpgradeSchedule) {
 	*out = *in
@@ -410,3 +1363,96 @@ func (in *UpgradeSchedule) DeepCopy() *UpgradeSchedule {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPolicy) DeepCopyInto(out *UserPolicy) {
+	*out = *in
+	if in.ExclusionGroups != nil {
+		in, out := &in.ExclusionGroups, &out.ExclusionGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdminGroups != nil {
+		in, out := &in.AdminGroups, &out.AdminGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]ProductUserRoles, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Sync = in.Sync
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicy.
+func (in *UserPolicy) DeepCopy() *UserPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSyncChange) DeepCopyInto(out *UserSyncChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSyncChange.
+func (in *UserSyncChange) DeepCopy() *UserSyncChange {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSyncChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSyncPolicy) DeepCopyInto(out *UserSyncPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSyncPolicy.
+func (in *UserSyncPolicy) DeepCopy() *UserSyncPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSyncPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSyncStatus) DeepCopyInto(out *UserSyncStatus) {
+	*out = *in
+	in.LastSync.DeepCopyInto(&out.LastSync)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]UserSyncChange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSyncStatus.
+func (in *UserSyncStatus) DeepCopy() *UserSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
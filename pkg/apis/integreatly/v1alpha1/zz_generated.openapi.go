@@ -29,9 +29,13 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"./pkg/apis/integreatly/v1alpha1/.RHMI":       schema_apis_integreatly_v1alpha1__RHMI(ref),
-		"./pkg/apis/integreatly/v1alpha1/.RHMISpec":   schema_apis_integreatly_v1alpha1__RHMISpec(ref),
-		"./pkg/apis/integreatly/v1alpha1/.RHMIStatus": schema_apis_integreatly_v1alpha1__RHMIStatus(ref),
+		"./pkg/apis/integreatly/v1alpha1/.RHMI":              schema_apis_integreatly_v1alpha1__RHMI(ref),
+		"./pkg/apis/integreatly/v1alpha1/.RHMIBackupSpec":    schema_apis_integreatly_v1alpha1__RHMIBackupSpec(ref),
+		"./pkg/apis/integreatly/v1alpha1/.RHMIBackupStatus":  schema_apis_integreatly_v1alpha1__RHMIBackupStatus(ref),
+		"./pkg/apis/integreatly/v1alpha1/.RHMIRestoreSpec":   schema_apis_integreatly_v1alpha1__RHMIRestoreSpec(ref),
+		"./pkg/apis/integreatly/v1alpha1/.RHMIRestoreStatus": schema_apis_integreatly_v1alpha1__RHMIRestoreStatus(ref),
+		"./pkg/apis/integreatly/v1alpha1/.RHMISpec":          schema_apis_integreatly_v1alpha1__RHMISpec(ref),
+		"./pkg/apis/integreatly/v1alpha1/.RHMIStatus":        schema_apis_integreatly_v1alpha1__RHMIStatus(ref),
 	}
 }
 
@@ -79,6 +83,191 @@ func schema_apis_integreatly_v1alpha1__RHMI(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_apis_integreatly_v1alpha1__RHMIBackupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RHMIBackupSpec defines the products backed up on demand",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"products": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Products to back up. Every product of the installation with data to back up is backed up when empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of the backups of each product, defaults to 30m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_integreatly_v1alpha1__RHMIBackupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RHMIBackupStatus records the backups performed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the backup failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"products": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Products are the backups performed for each product",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/integreatly/v1alpha1/.ProductBackupStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/integreatly/v1alpha1/.ProductBackupStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_apis_integreatly_v1alpha1__RHMIRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RHMIRestoreSpec defines the backups of a product restored",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup is the name of a completed RHMIBackup in the namespace of the RHMIRestore",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"product": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Product restored from the backups of the RHMIBackup",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job restores the backups while the product is scaled down, defaults to the restore job of the product. The names of the backups are set in the RHMI_RESTORE_BACKUPS environment variable of its containers",
+							Ref:         ref("k8s.io/api/batch/v1.JobSpec"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of the restore job, defaults to 30m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"backup", "product"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.JobSpec"},
+	}
+}
+
+func schema_apis_integreatly_v1alpha1__RHMIRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RHMIRestoreStatus records the progress of the restore",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job is the name of the restore job",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scaledDown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaledDown are the workloads scaled down for the restore, with the replicas they are scaled back up to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/integreatly/v1alpha1/.ScaledWorkload"),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/integreatly/v1alpha1/.ScaledWorkload", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_apis_integreatly_v1alpha1__RHMISpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -162,12 +351,50 @@ func schema_apis_integreatly_v1alpha1__RHMISpec(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"products": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Products allows the products listed by the installation type to be disabled or customised, keyed by product name. Products without an entry are installed with the defaults of the installation type",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/integreatly/v1alpha1/.ProductSpec"),
+									},
+								},
+							},
+						},
+					},
+					"productUpgrades": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProductUpgrades sets how the install plans upgrading the product operators are approved. By default they are approved as soon as they are created",
+							Ref:         ref("./pkg/apis/integreatly/v1alpha1/.ProductUpgradePolicy"),
+						},
+					},
+					"alerting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alerting configures where the alerts of the installation are sent in addition to the SMTP, PagerDuty and Dead Mans Snitch receivers",
+							Ref:         ref("./pkg/apis/integreatly/v1alpha1/.AlertingSpec"),
+						},
+					},
+					"authentication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Authentication configures the identity providers federated into the master realm of the user SSO",
+							Ref:         ref("./pkg/apis/integreatly/v1alpha1/.AuthenticationSpec"),
+						},
+					},
+					"catalogSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogSource is the catalog source the product operators are installed from, unless a product sets its own. Defaults to the manifests embedded in the operator",
+							Ref:         ref("./pkg/apis/integreatly/v1alpha1/.CatalogSourceSpec"),
+						},
+					},
 				},
 				Required: []string{"type", "namespacePrefix"},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/integreatly/v1alpha1/.PullSecretSpec"},
+			"./pkg/apis/integreatly/v1alpha1/.AlertingSpec", "./pkg/apis/integreatly/v1alpha1/.AuthenticationSpec", "./pkg/apis/integreatly/v1alpha1/.CatalogSourceSpec", "./pkg/apis/integreatly/v1alpha1/.ProductSpec", "./pkg/apis/integreatly/v1alpha1/.ProductUpgradePolicy", "./pkg/apis/integreatly/v1alpha1/.PullSecretSpec"},
 	}
 }
 
@@ -240,11 +467,56 @@ func schema_apis_integreatly_v1alpha1__RHMIStatus(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of the installation: Available, Progressing, Degraded, Upgrading and PreflightPassed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/integreatly/v1alpha1/.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"preflightChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreflightChecks are the results of the checks run before the installation, the installation starts once none of them failed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/integreatly/v1alpha1/.PreflightCheckStatus"),
+									},
+								},
+							},
+						},
+					},
+					"productUpgrades": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProductUpgrades tracks the staged upgrades of the product operators",
+							Ref:         ref("./pkg/apis/integreatly/v1alpha1/.ProductUpgradesStatus"),
+						},
+					},
+					"userSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserSync is the last synchronisation of the OpenShift users to each product holding users",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/integreatly/v1alpha1/.UserSyncStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"stages", "stage", "lastError"},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/integreatly/v1alpha1/.RHMIStageStatus"},
+			"./pkg/apis/integreatly/v1alpha1/.Condition", "./pkg/apis/integreatly/v1alpha1/.PreflightCheckStatus", "./pkg/apis/integreatly/v1alpha1/.ProductUpgradesStatus", "./pkg/apis/integreatly/v1alpha1/.RHMIStageStatus", "./pkg/apis/integreatly/v1alpha1/.UserSyncStatus"},
 	}
 }
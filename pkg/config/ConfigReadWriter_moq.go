@@ -42,6 +42,9 @@ var _ ConfigReadWriter = &ConfigReadWriterMock{}
 //             ReadApicuritoFunc: func() (*Apicurito, error) {
 // 	               panic("mock out the ReadApicurito method")
 //             },
+//             ReadBackupFunc: func() (*Backup, error) {
+// 	               panic("mock out the ReadBackup method")
+//             },
 //             ReadCloudResourcesFunc: func() (*CloudResources, error) {
 // 	               panic("mock out the ReadCloudResources method")
 //             },
@@ -124,6 +127,9 @@ type ConfigReadWriterMock struct {
 	// ReadApicuritoFunc mocks the ReadApicurito method.
 	ReadApicuritoFunc func() (*Apicurito, error)
 
+	// ReadBackupFunc mocks the ReadBackup method.
+	ReadBackupFunc func() (*Backup, error)
+
 	// ReadCloudResourcesFunc mocks the ReadCloudResources method.
 	ReadCloudResourcesFunc func() (*CloudResources, error)
 
@@ -201,6 +207,9 @@ type ConfigReadWriterMock struct {
 		// ReadApicurito holds details about calls to the ReadApicurito method.
 		ReadApicurito []struct {
 		}
+		// ReadBackup holds details about calls to the ReadBackup method.
+		ReadBackup []struct {
+		}
 		// ReadCloudResources holds details about calls to the ReadCloudResources method.
 		ReadCloudResources []struct {
 		}
@@ -267,6 +276,7 @@ type ConfigReadWriterMock struct {
 	lockReadAMQStreams              sync.RWMutex
 	lockReadApicurioRegistry        sync.RWMutex
 	lockReadApicurito               sync.RWMutex
+	lockReadBackup                  sync.RWMutex
 	lockReadCloudResources          sync.RWMutex
 	lockReadCodeReady               sync.RWMutex
 	lockReadDataSync                sync.RWMutex
@@ -494,6 +504,32 @@ func (mock *ConfigReadWriterMock) ReadApicuritoCalls() []struct {
 	return calls
 }
 
+// ReadBackup calls ReadBackupFunc.
+func (mock *ConfigReadWriterMock) ReadBackup() (*Backup, error) {
+	if mock.ReadBackupFunc == nil {
+		panic("ConfigReadWriterMock.ReadBackupFunc: method is nil but ConfigReadWriter.ReadBackup was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockReadBackup.Lock()
+	mock.calls.ReadBackup = append(mock.calls.ReadBackup, callInfo)
+	mock.lockReadBackup.Unlock()
+	return mock.ReadBackupFunc()
+}
+
+// ReadBackupCalls gets all the calls that were made to ReadBackup.
+// Check the length with:
+//     len(mockedConfigReadWriter.ReadBackupCalls())
+func (mock *ConfigReadWriterMock) ReadBackupCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockReadBackup.RLock()
+	calls = mock.calls.ReadBackup
+	mock.lockReadBackup.RUnlock()
+	return calls
+}
+
 // ReadCloudResources calls ReadCloudResourcesFunc.
 func (mock *ConfigReadWriterMock) ReadCloudResources() (*CloudResources, error) {
 	if mock.ReadCloudResourcesFunc == nil {
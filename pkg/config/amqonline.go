@@ -113,6 +113,12 @@ func (a *AMQOnline) GetBackupSchedule() string {
 	return "30 2 * * *"
 }
 
+// GetBackupVerificationSchedule returns the schedule of the jobs verifying the backups can be restored, the backups are
+// not verified when it is empty
+func (a *AMQOnline) GetBackupVerificationSchedule() string {
+	return a.config["BACKUP_VERIFICATION_SCHEDULE"]
+}
+
 func (a *AMQOnline) Validate() error {
 	if a.GetNamespace() == "" {
 		return errors.New("config namespace is not defined")
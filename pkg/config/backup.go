@@ -0,0 +1,99 @@
+package config
+
+import (
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+)
+
+// backupConfigName is the key of the backup configuration in the installation config map. Its values can be overridden
+// through spec.products.backup.overrides of the RHMI CR
+const backupConfigName integreatlyv1alpha1.ProductName = "backup"
+
+const (
+	DefaultBackupImage = "quay.io/integreatly/backup-container:1.0.15"
+	// DefaultBackupVerificationDatabaseImage runs the scratch database the postgres backups are restored into to
+	// verify them
+	DefaultBackupVerificationDatabaseImage = "registry.redhat.io/rhscl/postgresql-10-rhel7:1"
+
+	// BackupBackendS3 stores the backups in an AWS S3 bucket, or a bucket of an S3 compatible endpoint
+	BackupBackendS3 = "s3"
+	// BackupBackendPVC stores the backups in a persistent volume claim of the namespace of the backups
+	BackupBackendPVC = "pvc"
+
+	defaultBackupPVCSize = "10Gi"
+)
+
+// Backup configures the storage and the image of the backup CronJobs of the products
+type Backup struct {
+	Config ProductConfig
+}
+
+func NewBackup(config ProductConfig) *Backup {
+	return &Backup{Config: config}
+}
+
+func (b *Backup) Read() ProductConfig {
+	return b.Config
+}
+
+func (b *Backup) GetImage() string {
+	if b.Config["IMAGE"] == "" {
+		return DefaultBackupImage
+	}
+	return b.Config["IMAGE"]
+}
+
+func (b *Backup) GetVerificationDatabaseImage() string {
+	if b.Config["VERIFICATION_DATABASE_IMAGE"] == "" {
+		return DefaultBackupVerificationDatabaseImage
+	}
+	return b.Config["VERIFICATION_DATABASE_IMAGE"]
+}
+
+// SupportsRestore returns true when the backup image can restore the backups it takes, through the restore mode of
+// its entrypoint. The default image only takes backups
+func (b *Backup) SupportsRestore() bool {
+	return b.Config["RESTORE_SUPPORTED"] == "true"
+}
+
+// SupportsLocalBackend returns true when the backup image can store the backups in a directory, through the local
+// backend of its entrypoint, as required by the pvc backend. The default image only stores the backups in S3
+func (b *Backup) SupportsLocalBackend() bool {
+	return b.Config["LOCAL_BACKEND_SUPPORTED"] == "true"
+}
+
+// GetBackend returns the storage backend of the backups, s3 or pvc. Defaults to s3
+func (b *Backup) GetBackend() string {
+	if b.Config["BACKEND"] == "" {
+		return BackupBackendS3
+	}
+	return b.Config["BACKEND"]
+}
+
+// GetBackendSecretName returns the secret of the operator namespace with the credentials of the S3 bucket, empty to use
+// the bucket provisioned by the cloud resource operator
+func (b *Backup) GetBackendSecretName() string {
+	return b.Config["BACKEND_SECRET_NAME"]
+}
+
+// GetS3Endpoint returns the endpoint of an S3 compatible storage such as MinIO, empty for AWS S3
+func (b *Backup) GetS3Endpoint() string {
+	return b.Config["S3_ENDPOINT"]
+}
+
+func (b *Backup) GetPVCSize() string {
+	if b.Config["PVC_SIZE"] == "" {
+		return defaultBackupPVCSize
+	}
+	return b.Config["PVC_SIZE"]
+}
+
+// GetPVCStorageClass returns the storage class of the backup claims, empty for the default storage class
+func (b *Backup) GetPVCStorageClass() string {
+	return b.Config["PVC_STORAGE_CLASS"]
+}
+
+// GetEncryptionSecretName returns the secret of the operator namespace with the GPG key the backups are encrypted
+// with, the backups are not encrypted when it is empty
+func (b *Backup) GetEncryptionSecretName() string {
+	return b.Config["ENCRYPTION_SECRET_NAME"]
+}
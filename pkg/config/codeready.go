@@ -80,3 +80,9 @@ func (c *CodeReady) GetPostgresBackupSecretName() string {
 func (c *CodeReady) GetBackupSchedule() string {
 	return "30 2 * * *"
 }
+
+// GetBackupVerificationSchedule returns the schedule of the jobs verifying the backups can be restored, the backups are
+// not verified when it is empty
+func (c *CodeReady) GetBackupVerificationSchedule() string {
+	return c.Config["BACKUP_VERIFICATION_SCHEDULE"]
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -39,6 +40,7 @@ type ConfigReadWriter interface {
 	GetOauthClientsSecretName() string
 	GetGHOauthClientsSecretName() string
 	GetBackupsSecretName() string
+	ReadBackup() (*Backup, error)
 	WriteConfig(config ConfigReadable) error
 	ReadAMQStreams() (*AMQStreams, error)
 	ReadRHSSO() (*RHSSO, error)
@@ -94,49 +96,22 @@ type Manager struct {
 	cfgmap       *corev1.ConfigMap
 	context      context.Context
 	installation *integreatlyv1alpha1.RHMI
+	// cfgmapLock guards cfgmap, as the products of a stage read and write their config concurrently
+	cfgmapLock sync.RWMutex
 }
 
+// ReadProduct reads the config of any product registered with RegisterProductConfig
 func (m *Manager) ReadProduct(product integreatlyv1alpha1.ProductName) (ConfigReadable, error) {
-	switch product {
-	case integreatlyv1alpha1.Product3Scale:
-		return m.ReadThreeScale()
-	case integreatlyv1alpha1.ProductAMQOnline:
-		return m.ReadAMQOnline()
-	case integreatlyv1alpha1.ProductRHSSO:
-		return m.ReadRHSSO()
-	case integreatlyv1alpha1.ProductRHSSOUser:
-		return m.ReadRHSSOUser()
-	case integreatlyv1alpha1.ProductAMQStreams:
-		return m.ReadAMQStreams()
-	case integreatlyv1alpha1.ProductCodeReadyWorkspaces:
-		return m.ReadCodeReady()
-	case integreatlyv1alpha1.ProductFuse:
-		return m.ReadFuse()
-	case integreatlyv1alpha1.ProductFuseOnOpenshift:
-		return m.ReadFuseOnOpenshift()
-	case integreatlyv1alpha1.ProductSolutionExplorer:
-		return m.ReadSolutionExplorer()
-	case integreatlyv1alpha1.ProductUps:
-		return m.ReadUps()
-	case integreatlyv1alpha1.ProductApicurioRegistry:
-		return m.ReadApicurioRegistry()
-	case integreatlyv1alpha1.ProductApicurito:
-		return m.ReadApicurito()
-	case integreatlyv1alpha1.ProductCloudResources:
-		return m.ReadCloudResources()
-	case integreatlyv1alpha1.ProductMonitoring:
-		return m.ReadMonitoring()
-	case integreatlyv1alpha1.ProductDataSync:
-		return m.ReadDataSync()
-	case integreatlyv1alpha1.ProductMonitoringSpec:
-		return m.ReadMonitoringSpec()
-	case integreatlyv1alpha1.ProductMarin3r:
-		return m.ReadMarin3r()
-	case integreatlyv1alpha1.ProductGrafana:
-		return m.ReadGrafana()
-	}
-
-	return nil, fmt.Errorf("no config found for product %v", product)
+	factory, ok := getProductConfigFactory(product)
+	if !ok {
+		return nil, fmt.Errorf("no config found for product %v", product)
+	}
+
+	config, err := m.readConfigForProduct(product)
+	if err != nil {
+		return nil, err
+	}
+	return factory(config), nil
 }
 
 func (m *Manager) ReadSolutionExplorer() (*SolutionExplorer, error) {
@@ -159,6 +134,14 @@ func (m *Manager) GetBackupsSecretName() string {
 	return "backups-s3-credentials"
 }
 
+func (m *Manager) ReadBackup() (*Backup, error) {
+	config, err := m.readConfigForProduct(backupConfigName)
+	if err != nil {
+		return nil, err
+	}
+	return NewBackup(config), nil
+}
+
 func (m *Manager) GetGHOauthClientsSecretName() string {
 	return "github-oauth-secret"
 }
@@ -303,27 +286,85 @@ func (m *Manager) ReadMarin3r() (*Marin3r, error) {
 }
 
 func (m *Manager) WriteConfig(config ConfigReadable) error {
-	stringConfig, err := yaml.Marshal(config.Read())
-	err = m.Client.Get(m.context, k8sclient.ObjectKey{Name: m.cfgmap.Name, Namespace: m.Namespace}, m.cfgmap)
-	if errors.IsNotFound(err) {
-		m.cfgmap.Data = map[string]string{string(config.GetProductName()): string(stringConfig)}
+	m.cfgmapLock.Lock()
+	defer m.cfgmapLock.Unlock()
+
+	product := config.GetProductName()
+	getErr := m.Client.Get(m.context, k8sclient.ObjectKey{Name: m.cfgmap.Name, Namespace: m.Namespace}, m.cfgmap)
+	if getErr != nil && !errors.IsNotFound(getErr) {
+		return getErr
+	}
+	storedConfig := ProductConfig{}
+	if getErr == nil {
+		var err error
+		if storedConfig, err = decodeProductConfig(product, m.cfgmap.Data[string(product)]); err != nil {
+			return err
+		}
+	}
+	stringConfig, err := yaml.Marshal(m.withoutOverrides(product, config.Read(), storedConfig))
+	if err != nil {
+		return fmt.Errorf("failed to encode product config for %v: %w", product, err)
+	}
+
+	if errors.IsNotFound(getErr) {
+		m.cfgmap.Data = map[string]string{string(product): string(stringConfig)}
 		return m.Client.Create(m.context, m.cfgmap)
 	}
 	if m.cfgmap.Data == nil {
 		m.cfgmap.Data = map[string]string{}
 	}
-	m.cfgmap.Data[string(config.GetProductName())] = string(stringConfig)
+	m.cfgmap.Data[string(product)] = string(stringConfig)
 	return m.Client.Update(m.context, m.cfgmap)
 }
 
 func (m *Manager) readConfigForProduct(product integreatlyv1alpha1.ProductName) (ProductConfig, error) {
+	m.cfgmapLock.RLock()
 	config := m.cfgmap.Data[string(product)]
-	decoder := yaml.NewDecoder(strings.NewReader(config))
+	m.cfgmapLock.RUnlock()
+
+	retConfig, err := decodeProductConfig(product, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// the overrides of the product in the installation spec take precedence over the stored config,
+	// they are only applied to the config that is read and never stored, see WriteConfig
+	for key, value := range m.getOverrides(product) {
+		retConfig[key] = value
+	}
+	return retConfig, nil
+}
+
+func (m *Manager) getOverrides(product integreatlyv1alpha1.ProductName) map[string]string {
+	if m.installation == nil {
+		return nil
+	}
+	return m.installation.GetProductSpec(product).Overrides
+}
+
+// withoutOverrides returns a copy of the config to store, where the overridden keys keep the value that was
+// stored before the override, so that removing an override restores the previous value
+func (m *Manager) withoutOverrides(product integreatlyv1alpha1.ProductName, config, storedConfig ProductConfig) ProductConfig {
+	retConfig := ProductConfig{}
+	for key, value := range config {
+		retConfig[key] = value
+	}
+	for key := range m.getOverrides(product) {
+		if value, ok := storedConfig[key]; ok {
+			retConfig[key] = value
+		} else {
+			delete(retConfig, key)
+		}
+	}
+	return retConfig
+}
+
+func decodeProductConfig(product integreatlyv1alpha1.ProductName, config string) (ProductConfig, error) {
 	retConfig := ProductConfig{}
 	if config == "" {
 		return retConfig, nil
 	}
-	if err := decoder.Decode(retConfig); err != nil {
+	if err := yaml.NewDecoder(strings.NewReader(config)).Decode(retConfig); err != nil {
 		return nil, fmt.Errorf("failed to decode product config for %v: %w", product, err)
 	}
 	return retConfig, nil
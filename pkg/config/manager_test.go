@@ -112,6 +112,7 @@ func TestReadConfigForProduct(t *testing.T) {
 
 	tests := []struct {
 		productName       string
+		installation      *integreatlyv1alpha1.RHMI
 		existingResources []runtime.Object
 		expected          ProductConfig
 	}{
@@ -133,11 +134,35 @@ func TestReadConfigForProduct(t *testing.T) {
 			existingResources: []runtime.Object{},
 			expected:          map[string]string{},
 		},
+		{
+			productName: mockProductName,
+			installation: &integreatlyv1alpha1.RHMI{
+				Spec: integreatlyv1alpha1.RHMISpec{
+					Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+						mockProductName: {Overrides: map[string]string{"testKey": "overridden", "newKey": "newVal"}},
+					},
+				},
+			},
+			existingResources: []runtime.Object{&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      mockConfigMapName,
+					Namespace: mockNamespaceName,
+				},
+				Data: map[string]string{
+					"mock": "testKey: testVal\notherKey: otherVal",
+				},
+			}},
+			expected: ProductConfig{"testKey": "overridden", "newKey": "newVal", "otherKey": "otherVal"},
+		},
 	}
 
 	for _, test := range tests {
+		inst := fakeInst
+		if test.installation != nil {
+			inst = test.installation
+		}
 		fakeClient := fake.NewFakeClient(test.existingResources...)
-		mgr, err := NewManager(context.TODO(), fakeClient, mockNamespaceName, mockConfigMapName, fakeInst)
+		mgr, err := NewManager(context.TODO(), fakeClient, mockNamespaceName, mockConfigMapName, inst)
 		if err != nil {
 			t.Fatalf("could not create manager %v", err)
 		}
@@ -153,3 +178,66 @@ func TestReadConfigForProduct(t *testing.T) {
 	}
 
 }
+
+func TestOverridesAreNotWritten(t *testing.T) {
+	inst := &integreatlyv1alpha1.RHMI{
+		Spec: integreatlyv1alpha1.RHMISpec{
+			Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+				mockProductName: {Overrides: map[string]string{"testKey": "overridden", "newKey": "newVal"}},
+			},
+		},
+	}
+	fakeClient := fake.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mockConfigMapName,
+			Namespace: mockNamespaceName,
+		},
+		Data: map[string]string{
+			"mock": "testKey: testVal",
+		},
+	})
+	mgr, err := NewManager(context.TODO(), fakeClient, mockNamespaceName, mockConfigMapName, inst)
+	if err != nil {
+		t.Fatalf("could not create manager %v", err)
+	}
+
+	config, err := mgr.readConfigForProduct(mockProductName)
+	if err != nil {
+		t.Fatalf("could not read config %v", err)
+	}
+	if config["testKey"] != "overridden" || config["newKey"] != "newVal" {
+		t.Fatalf("expected the overrides to be read, got %v", config)
+	}
+
+	config["otherKey"] = "otherVal"
+	err = mgr.WriteConfig(&ConfigReadableMock{
+		GetProductNameFunc: func() integreatlyv1alpha1.ProductName {
+			return mockProductName
+		},
+		ReadFunc: func() ProductConfig {
+			return config
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not write config %v", err)
+	}
+	if config["testKey"] != "overridden" {
+		t.Fatalf("expected the config that was read to keep the overrides, got %v", config)
+	}
+
+	// removing the overrides restores the stored config
+	inst.Spec.Products = nil
+	config, err = mgr.readConfigForProduct(mockProductName)
+	if err != nil {
+		t.Fatalf("could not read config %v", err)
+	}
+	expected := ProductConfig{"testKey": "testVal", "otherKey": "otherVal"}
+	if len(config) != len(expected) {
+		t.Fatalf("expected %v but got %v", expected, config)
+	}
+	for key, value := range expected {
+		if config[key] != value {
+			t.Fatalf("expected %s but got %s for key %s", value, config[key], key)
+		}
+	}
+}
@@ -132,6 +132,7 @@ func (m *Monitoring) GetDashboards() []string {
 		"resources-by-pod",
 		"cluster-resources",
 		"critical-slo-alerts",
+		"slo-error-budgets",
 	}
 	return templateList
 }
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"sync"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+)
+
+// ProductConfigFactory builds the config of a product from the values stored in the installation config map
+type ProductConfigFactory func(config ProductConfig) ConfigReadable
+
+var (
+	productConfigsLock sync.RWMutex
+	productConfigs     = map[integreatlyv1alpha1.ProductName]ProductConfigFactory{}
+)
+
+// RegisterProductConfig makes the config of a product readable through ReadProduct. Products are registered
+// through products.Register, which registers their config along with their reconciler
+func RegisterProductConfig(product integreatlyv1alpha1.ProductName, factory ProductConfigFactory) {
+	productConfigsLock.Lock()
+	defer productConfigsLock.Unlock()
+	productConfigs[product] = factory
+}
+
+// UnregisterProductConfig removes the config of a product registered with RegisterProductConfig
+func UnregisterProductConfig(product integreatlyv1alpha1.ProductName) {
+	productConfigsLock.Lock()
+	defer productConfigsLock.Unlock()
+	delete(productConfigs, product)
+}
+
+func getProductConfigFactory(product integreatlyv1alpha1.ProductName) (ProductConfigFactory, bool) {
+	productConfigsLock.RLock()
+	defer productConfigsLock.RUnlock()
+	factory, ok := productConfigs[product]
+	return factory, ok
+}
@@ -2,6 +2,8 @@ package config
 
 import (
 	"errors"
+	"strings"
+
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -91,6 +93,32 @@ func (r *RHSSOCommon) SetHost(newHost string) {
 	r.Config["HOST"] = newHost
 }
 
+// GetIdentityProviders returns the aliases of the identity providers federated into the realm from the installation
+func (r *RHSSOCommon) GetIdentityProviders() []string {
+	return splitList(r.Config["IDENTITY_PROVIDERS"])
+}
+
+func (r *RHSSOCommon) SetIdentityProviders(aliases []string) {
+	r.Config["IDENTITY_PROVIDERS"] = strings.Join(aliases, ",")
+}
+
+// GetLDAPMappers returns the mappers created for the LDAP providers of the installation, as alias/name. They are
+// tracked as they can not be told apart from the mappers Keycloak creates with the provider
+func (r *RHSSOCommon) GetLDAPMappers() []string {
+	return splitList(r.Config["LDAP_MAPPERS"])
+}
+
+func (r *RHSSOCommon) SetLDAPMappers(mappers []string) {
+	r.Config["LDAP_MAPPERS"] = strings.Join(mappers, ",")
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
+
 func (r *RHSSOCommon) Read() ProductConfig {
 	return r.Config
 }
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
@@ -26,6 +28,7 @@ import (
 	"github.com/integr8ly/integreatly-operator/pkg/products"
 	"github.com/integr8ly/integreatly-operator/pkg/resources"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/preflight"
 
 	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
 
@@ -34,7 +37,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,6 +59,14 @@ const (
 	DefaultCloudResourceConfigName   = "cloud-resource-config"
 	alertingEmailAddressEnvName      = "ALERTING_EMAIL_ADDRESS"
 	installTypeEnvName               = "INSTALLATION_TYPE"
+	productReconcileWorkersEnvName   = "PRODUCT_RECONCILE_WORKERS"
+	productReconcileTimeoutEnvName   = "PRODUCT_RECONCILE_TIMEOUT"
+
+	// defaultProductReconcileWorkers is the maximum number of products in a stage that are reconciled concurrently
+	defaultProductReconcileWorkers = 4
+	// defaultProductReconcileTimeout is the maximum time a single product reconcile is given before its context is
+	// cancelled
+	defaultProductReconcileTimeout = 5 * time.Minute
 )
 
 var (
@@ -234,14 +247,11 @@ func (r *ReconcileInstallation) Reconcile(request reconcile.Request) (reconcile.
 		RequeueAfter: 10 * time.Second,
 	}
 
-	installType, err := TypeFactory(installation.Spec.Type)
+	installType, err := TypeFactory(context.TODO(), r.client, request.NamespacedName.Namespace, installation.Spec.Type)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	installationCfgMap := os.Getenv("INSTALLATION_CONFIG_MAP")
-	if installationCfgMap == "" {
-		installationCfgMap = installation.Spec.NamespacePrefix + DefaultInstallationConfigMapName
-	}
+	installationCfgMap := GetInstallationConfigMapName(installation)
 
 	alertingEmailAddress := os.Getenv(alertingEmailAddressEnvName)
 	if installation.Spec.AlertingEmailAddress == "" && alertingEmailAddress != "" {
@@ -318,13 +328,26 @@ func (r *ReconcileInstallation) Reconcile(request reconcile.Request) (reconcile.
 		return reconcile.Result{}, err
 	}
 
+	// stage the upgrades of the product operators when the policy of the installation requires it
+	stageCtx := context.TODO()
+	upgradeGate, err := newStagedUpgradeGate(installation, installType, configManager)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if upgradeGate != nil {
+		if err := upgradeGate.verify(stageCtx, r.client); err != nil {
+			return retryRequeue, err
+		}
+		stageCtx = resources.WithUpgradeGate(stageCtx, upgradeGate)
+	}
+
 	for _, stage := range installType.GetInstallStages() {
 		var err error
 		var stagePhase integreatlyv1alpha1.StatusPhase
 		if stage.Name == integreatlyv1alpha1.BootstrapStage {
 			stagePhase, err = r.bootstrapStage(installation, configManager)
 		} else {
-			stagePhase, err = r.processStage(installation, &stage, configManager)
+			stagePhase, err = r.processStage(stageCtx, installation, &stage, configManager)
 		}
 
 		if installation.Status.Stages == nil {
@@ -368,7 +391,15 @@ func (r *ReconcileInstallation) Reconcile(request reconcile.Request) (reconcile.
 		installation.Status.ToVersion = ""
 		metrics.SetRhmiVersions(string(installation.Status.Stage), installation.Status.Version, installation.Status.ToVersion, installation.CreationTimestamp.Unix())
 	}
+	if upgradeGate != nil {
+		upgradeGate.apply(installation)
+	} else {
+		installation.Status.ProductUpgrades = nil
+	}
+	setInstallationConditions(installation, installInProgress)
 	metrics.SetRHMIStatus(installation)
+	metrics.SetRHMIConditions(installation)
+	metrics.SetProductVersionDrift(installation)
 
 	err = r.updateStatusAndObject(originalInstallation, installation)
 	if err != nil {
@@ -386,6 +417,46 @@ func (r *ReconcileInstallation) Reconcile(request reconcile.Request) (reconcile.
 	return retryRequeue, nil
 }
 
+// GetInstallationConfigMapName returns the name of the config map the config of the products is stored in
+func GetInstallationConfigMapName(installation *integreatlyv1alpha1.RHMI) string {
+	if name := os.Getenv("INSTALLATION_CONFIG_MAP"); name != "" {
+		return name
+	}
+	return installation.Spec.NamespacePrefix + DefaultInstallationConfigMapName
+}
+
+// setInstallationConditions sets the Available, Progressing, Upgrading and Degraded conditions of the installation
+// from the outcome of processing its install stages. They are recomputed on every reconcile, so their reasons and
+// messages describe the current state of the installation
+func setInstallationConditions(installation *integreatlyv1alpha1.RHMI, installInProgress bool) {
+	if installInProgress {
+		message := fmt.Sprintf("stage %s is in progress", installation.Status.Stage)
+		installation.SetCondition(integreatlyv1alpha1.ConditionAvailable, false, "StageInProgress", message)
+		installation.SetCondition(integreatlyv1alpha1.ConditionProgressing, true, "StageInProgress", message)
+	} else {
+		installation.SetCondition(integreatlyv1alpha1.ConditionAvailable, true, "InstallationComplete", "all stages have completed")
+		installation.SetCondition(integreatlyv1alpha1.ConditionProgressing, false, "InstallationComplete", "all stages have completed")
+	}
+
+	if upgradeInProgress(installation) && installation.Status.Version != "" {
+		installation.SetCondition(integreatlyv1alpha1.ConditionUpgrading, true, "UpgradeInProgress",
+			fmt.Sprintf("upgrading from %s to %s", installation.Status.Version, installation.Status.ToVersion))
+	} else {
+		installation.SetCondition(integreatlyv1alpha1.ConditionUpgrading, false, "NoUpgradeInProgress", "")
+	}
+
+	// a failed staged product upgrade halts the upgrades of the other products, it's reported over the error of the
+	// last reconcile
+	if setProductUpgradeCondition(installation) {
+		return
+	}
+	if installation.Status.LastError != "" {
+		installation.SetCondition(integreatlyv1alpha1.ConditionDegraded, true, "ReconcileFailed", installation.Status.LastError)
+	} else {
+		installation.SetCondition(integreatlyv1alpha1.ConditionDegraded, false, "NoErrors", "")
+	}
+}
+
 func (r *ReconcileInstallation) updateStatusAndObject(original, installation *integreatlyv1alpha1.RHMI) error {
 	if !reflect.DeepEqual(original.Status, installation.Status) {
 		logrus.Info("updating status")
@@ -410,10 +481,7 @@ func (r *ReconcileInstallation) handleUninstall(installation *integreatlyv1alpha
 		Requeue:      true,
 		RequeueAfter: 10 * time.Second,
 	}
-	installationCfgMap := os.Getenv("INSTALLATION_CONFIG_MAP")
-	if installationCfgMap == "" {
-		installationCfgMap = installation.Spec.NamespacePrefix + DefaultInstallationConfigMapName
-	}
+	installationCfgMap := GetInstallationConfigMapName(installation)
 	configManager, err := config.NewManager(context.TODO(), r.client, installation.Namespace, installationCfgMap, installation)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -463,7 +531,7 @@ func (r *ReconcileInstallation) handleUninstall(installation *integreatlyv1alpha
 	}
 	for _, stage := range installationType.UninstallStages {
 		pendingUninstalls := false
-		for product, _ := range stage.Products {
+		for _, product := range stage.GetProductOrder() {
 			productName := string(product)
 			logrus.Infof("Uninstalling %s in stage %s", productName, stage.Name)
 			productStatus := installation.GetProductStatusObject(product)
@@ -571,6 +639,37 @@ func upgradeInProgress(installation *integreatlyv1alpha1.RHMI) bool {
 	return false
 }
 
+// recordPreflightEvents emits an event for every failed check and for every check whose result changed since the
+// previous run, the preflight checks are rerun on every reconcile until they pass so unchanged results are not repeated.
+// It returns the messages of the failed checks and of the checks that passed with a warning
+func recordPreflightEvents(eventRecorder record.EventRecorder, installation *integreatlyv1alpha1.RHMI, previous, checks []integreatlyv1alpha1.PreflightCheckStatus) (failed, warnings []string) {
+	previousResults := map[string]integreatlyv1alpha1.PreflightCheckResult{}
+	for _, check := range previous {
+		previousResults[check.Name] = check.Result
+	}
+
+	failed, warnings = []string{}, []string{}
+	for _, check := range checks {
+		message := fmt.Sprintf("%s: %s", check.Name, check.Message)
+		changed := previousResults[check.Name] != check.Result
+		switch check.Result {
+		case integreatlyv1alpha1.PreflightCheckFailed:
+			failed = append(failed, message)
+			eventRecorder.Event(installation, "Warning", integreatlyv1alpha1.EventProcessingError, message)
+		case integreatlyv1alpha1.PreflightCheckWarning:
+			warnings = append(warnings, message)
+			if changed {
+				eventRecorder.Event(installation, "Warning", integreatlyv1alpha1.EventPreflightCheckWarning, message)
+			}
+		default:
+			if changed {
+				eventRecorder.Event(installation, "Normal", integreatlyv1alpha1.EventPreflightCheckPassed, message)
+			}
+		}
+	}
+	return failed, warnings
+}
+
 func (r *ReconcileInstallation) preflightChecks(installation *integreatlyv1alpha1.RHMI, installationType *Type, configManager *config.Manager) (reconcile.Result, error) {
 	logrus.Info("Running preflight checks..")
 	installation.Status.Stage = integreatlyv1alpha1.StageName("Preflight Checks")
@@ -581,71 +680,52 @@ func (r *ReconcileInstallation) preflightChecks(installation *integreatlyv1alpha
 
 	eventRecorder := r.mgr.GetEventRecorderFor("Preflight Checks")
 
-	if strings.ToLower(installation.Spec.UseClusterStorage) != "true" && strings.ToLower(installation.Spec.UseClusterStorage) != "false" {
-		installation.Status.PreflightStatus = integreatlyv1alpha1.PreflightFail
-		installation.Status.PreflightMessage = "Spec.useClusterStorage must be set to either 'true' or 'false' to continue"
-		_ = r.client.Status().Update(context.TODO(), installation)
-		logrus.Infof("preflight checks failed on useClusterStorage value")
-		return result, nil
+	// new client to avoid caching issues
+	serverClient, err := k8sclient.New(r.restConfig, k8sclient.Options{})
+	if err != nil {
+		return result, fmt.Errorf("could not create server client: %w", err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(r.restConfig)
+	if err != nil {
+		return result, fmt.Errorf("could not create discovery client: %w", err)
 	}
 
-	if installation.Spec.Type == string(integreatlyv1alpha1.InstallationTypeManaged) || installation.Spec.Type == string(integreatlyv1alpha1.InstallationTypeManagedApi) {
-		requiredSecrets := []string{installation.Spec.PagerDutySecret, installation.Spec.DeadMansSnitchSecret}
-
-		for _, secretName := range requiredSecrets {
-			secret := &corev1.Secret{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      secretName,
-					Namespace: installation.Namespace,
-				},
+	productDetectors := map[integreatlyv1alpha1.ProductName]preflight.ProductDetector{}
+	for _, stage := range installationType.InstallStages {
+		for _, product := range stage.Products {
+			if !installation.IsProductEnabled(product.Name) {
+				continue
 			}
-			if exists, err := resources.Exists(context.TODO(), r.client, secret); err != nil {
-				return reconcile.Result{}, err
-			} else if !exists {
-				preflightMessage := fmt.Sprintf("Could not find %s secret in %s namespace", secret.Name, installation.Namespace)
-				logrus.Info(preflightMessage)
-				eventRecorder.Event(installation, "Warning", integreatlyv1alpha1.EventProcessingError, preflightMessage)
-
-				installation.Status.PreflightStatus = integreatlyv1alpha1.PreflightFail
-				installation.Status.PreflightMessage = preflightMessage
-				_ = r.client.Status().Update(context.TODO(), installation)
-
-				return reconcile.Result{}, err
+			detector, err := products.GetPreflightObjectFunc(product.Name)
+			if err != nil {
+				return result, err
 			}
-			logrus.Infof("found required secret: %s", secretName)
-			eventRecorder.Eventf(installation, "Normal", integreatlyv1alpha1.EventPreflightCheckPassed,
-				"found required secret: %s", secretName)
+			productDetectors[product.Name] = detector
 		}
 	}
 
-	logrus.Infof("getting namespaces")
-	namespaces := &corev1.NamespaceList{}
-	err := r.client.List(context.TODO(), namespaces)
-	if err != nil {
-		// could not list namespaces, keep trying
-		logrus.Infof("error listing namespaces, will retry")
-		return result, err
-	}
+	checks := preflight.RunChecks(context.TODO(), preflight.Dependencies{
+		Client:       serverClient,
+		Discovery:    discoveryClient,
+		Installation: installation,
+		Products:     productDetectors,
+	})
+	previous := installation.Status.PreflightChecks
+	installation.Status.PreflightChecks = checks
+	failed, warnings := recordPreflightEvents(eventRecorder, installation, previous, checks)
 
-	for _, ns := range namespaces.Items {
-		products, err := r.checkNamespaceForProducts(ns, installation, installationType, configManager)
-		if err != nil {
-			// error searching for existing products, keep trying
-			logrus.Infof("error looking for existing deployments, will retry")
-			return result, err
-		}
-		if len(products) != 0 {
-			//found one or more conflicting products
-			installation.Status.PreflightStatus = integreatlyv1alpha1.PreflightFail
-			installation.Status.PreflightMessage = "found conflicting packages: " + strings.Join(products, ", ") + ", in namespace: " + ns.GetName()
-			logrus.Infof("found conflicting packages: " + strings.Join(products, ", ") + ", in namespace: " + ns.GetName())
-			_ = r.client.Status().Update(context.TODO(), installation)
-			return result, err
-		}
+	if preflight.Blocked(checks) {
+		logrus.Infof("preflight checks failed: %s", strings.Join(failed, "; "))
+		setPreflightStatus(installation, integreatlyv1alpha1.PreflightFail, "preflight checks failed: "+strings.Join(failed, "; "))
+		_ = r.client.Status().Update(context.TODO(), installation)
+		return result, nil
 	}
 
-	installation.Status.PreflightStatus = integreatlyv1alpha1.PreflightSuccess
-	installation.Status.PreflightMessage = "preflight checks passed"
+	message := "preflight checks passed"
+	if len(warnings) > 0 {
+		message = "preflight checks passed with warnings: " + strings.Join(warnings, "; ")
+	}
+	setPreflightStatus(installation, integreatlyv1alpha1.PreflightSuccess, message)
 	err = r.client.Status().Update(context.TODO(), installation)
 	if err != nil {
 		logrus.Infof("error updating status: %s", err.Error())
@@ -653,36 +733,17 @@ func (r *ReconcileInstallation) preflightChecks(installation *integreatlyv1alpha
 	return result, nil
 }
 
-func (r *ReconcileInstallation) checkNamespaceForProducts(ns corev1.Namespace, installation *integreatlyv1alpha1.RHMI, installationType *Type, configManager *config.Manager) ([]string, error) {
-	foundProducts := []string{}
-	if strings.HasPrefix(ns.Name, "openshift-") {
-		return foundProducts, nil
-	}
-	if strings.HasPrefix(ns.Name, "kube-") {
-		return foundProducts, nil
-	}
-	// new client to avoid caching issues
-	serverClient, _ := k8sclient.New(r.restConfig, k8sclient.Options{})
-	for _, stage := range installationType.InstallStages {
-		for _, product := range stage.Products {
-			reconciler, err := products.NewReconciler(product.Name, r.restConfig, configManager, installation, r.mgr)
-			if err != nil {
-				return foundProducts, err
-			}
-			search := reconciler.GetPreflightObject(ns.Name)
-			if search == nil {
-				continue
-			}
-			exists, err := resources.Exists(context.TODO(), serverClient, search)
-			if err != nil {
-				return foundProducts, err
-			} else if exists {
-				logrus.Infof("found conflicting product: %s", product.Name)
-				foundProducts = append(foundProducts, string(product.Name))
-			}
-		}
+// setPreflightStatus records the outcome of the preflight checks in the status and PreflightPassed condition
+func setPreflightStatus(installation *integreatlyv1alpha1.RHMI, status integreatlyv1alpha1.PreflightStatus, message string) {
+	installation.Status.PreflightStatus = status
+	installation.Status.PreflightMessage = message
+
+	passed := status == integreatlyv1alpha1.PreflightSuccess
+	reason := "PreflightChecksFailed"
+	if passed {
+		reason = "PreflightChecksPassed"
 	}
-	return foundProducts, nil
+	installation.SetCondition(integreatlyv1alpha1.ConditionPreflightPassed, passed, reason, message)
 }
 
 func (r *ReconcileInstallation) bootstrapStage(installation *integreatlyv1alpha1.RHMI, configManager config.ConfigReadWriter) (integreatlyv1alpha1.StatusPhase, error) {
@@ -705,7 +766,36 @@ func (r *ReconcileInstallation) bootstrapStage(installation *integreatlyv1alpha1
 	return phase, nil
 }
 
-func (r *ReconcileInstallation) processStage(installation *integreatlyv1alpha1.RHMI, stage *Stage, configManager config.ConfigReadWriter) (integreatlyv1alpha1.StatusPhase, error) {
+// productReconcileResult is the outcome of reconciling a single product of a stage. Each product is reconciled
+// against its own copy of the installation, which is merged back into the installation once the stage is processed
+type productReconcileResult struct {
+	product         integreatlyv1alpha1.RHMIProductStatus
+	installation    *integreatlyv1alpha1.RHMI
+	versionMismatch bool
+	// uninstalled is set when the product is disabled and nothing of it is left installed
+	uninstalled bool
+	// err is an error returned by the product reconciler, these are aggregated and do not fail the stage
+	err error
+	// setupErr is an error that prevented the product from being reconciled, this fails the stage
+	setupErr error
+}
+
+// getRestoringProducts returns the products with a RHMIRestore in progress in the namespace of the installation
+func getRestoringProducts(ctx context.Context, client k8sclient.Client, namespace string) (map[integreatlyv1alpha1.ProductName]bool, error) {
+	restores := &integreatlyv1alpha1.RHMIRestoreList{}
+	if err := client.List(ctx, restores, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list restores: %w", err)
+	}
+	restoring := map[integreatlyv1alpha1.ProductName]bool{}
+	for _, restore := range restores.Items {
+		if restore.InProgress() {
+			restoring[restore.Spec.Product] = true
+		}
+	}
+	return restoring, nil
+}
+
+func (r *ReconcileInstallation) processStage(ctx context.Context, installation *integreatlyv1alpha1.RHMI, stage *Stage, configManager config.ConfigReadWriter) (integreatlyv1alpha1.StatusPhase, error) {
 	incompleteStage := false
 	productVersionMismatchFound = false
 
@@ -713,35 +803,54 @@ func (r *ReconcileInstallation) processStage(installation *integreatlyv1alpha1.R
 	productsAux := make(map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.RHMIProductStatus)
 	installation.Status.Stage = stage.Name
 
-	for _, product := range stage.Products {
-		reconciler, err := products.NewReconciler(product.Name, r.restConfig, configManager, installation, r.mgr)
-		if err != nil {
-			return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to build a reconciler for %s: %w", product.Name, err)
-		}
+	serverClient, err := k8sclient.New(r.restConfig, k8sclient.Options{})
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("could not create server client: %w", err)
+	}
 
-		if !reconciler.VerifyVersion(installation) {
-			productVersionMismatchFound = true
-		}
+	// products being restored are left scaled down until the restore completes
+	restoring, err := getRestoringProducts(ctx, serverClient, installation.Namespace)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
 
-		serverClient, err := k8sclient.New(r.restConfig, k8sclient.Options{})
-		if err != nil {
-			return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("could not create server client: %w", err)
-		}
-		product.Status, err = reconciler.Reconcile(context.TODO(), installation, &product, serverClient)
+	results, err := r.reconcileStageProducts(ctx, installation, stage, configManager, serverClient, restoring)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
 
-		if err != nil {
+	for _, result := range results {
+		product := result.product
+
+		if result.setupErr != nil {
+			return integreatlyv1alpha1.PhaseFailed, result.setupErr
+		}
+		// disabled products are left out of the stage status once they are uninstalled
+		if result.uninstalled {
+			continue
+		}
+		setProductConditions(&product, installation.GetGeneration(), result.err)
+		if result.err != nil {
 			if mErr == nil {
 				mErr = &multiErr{}
 			}
-			mErr.(*multiErr).Add(fmt.Errorf("failed installation of %s: %w", product.Name, err))
+			mErr.(*multiErr).Add(fmt.Errorf("failed installation of %s: %w", product.Name, result.err))
 		}
 
-		// Verify that watches for this product CRDs have been created
 		config, err := configManager.ReadProduct(product.Name)
 		if err != nil {
 			return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("Failed to read product config for %s: %v", string(product.Name), err)
 		}
 
+		if err := setVersionDrift(installation, &product); err != nil {
+			return integreatlyv1alpha1.PhaseFailed, err
+		}
+		if result.versionMismatch || product.VersionDrift {
+			productVersionMismatchFound = true
+		}
+
+		// Verify that watches for this product CRDs have been created
+
 		if product.Status == integreatlyv1alpha1.PhaseCompleted {
 			for _, crd := range config.GetWatchableCRDs() {
 				namespace := config.GetNamespace()
@@ -765,8 +874,8 @@ func (r *ReconcileInstallation) processStage(installation *integreatlyv1alpha1.R
 			incompleteStage = true
 		}
 		productsAux[product.Name] = product
-		*stage = Stage{Name: stage.Name, Products: productsAux}
 	}
+	*stage = Stage{Name: stage.Name, Products: productsAux, ProductOrder: stage.ProductOrder}
 
 	//some products in this stage have not installed successfully yet
 	if incompleteStage {
@@ -775,6 +884,288 @@ func (r *ReconcileInstallation) processStage(installation *integreatlyv1alpha1.R
 	return integreatlyv1alpha1.PhaseCompleted, mErr
 }
 
+// reconcileStageProducts reconciles the products of the stage concurrently, bounded by getProductReconcileWorkers,
+// each on its own copy of the installation. The products can not update the installation, as their copies share a
+// resource version: their changes are merged back into the installation once they are all reconciled, and the
+// finalizers they added are persisted with a single update, before the status of the stage is reported
+func (r *ReconcileInstallation) reconcileStageProducts(ctx context.Context, installation *integreatlyv1alpha1.RHMI, stage *Stage, configManager config.ConfigReadWriter, serverClient k8sclient.Client, restoring map[integreatlyv1alpha1.ProductName]bool) ([]productReconcileResult, error) {
+	productOrder := stage.GetProductOrder()
+	results := make([]productReconcileResult, len(productOrder))
+	snapshot := installation.DeepCopy()
+	productClient := &productClient{Client: serverClient, snapshot: snapshot}
+
+	workers := make(chan struct{}, getProductReconcileWorkers())
+	wg := sync.WaitGroup{}
+	for i, productName := range productOrder {
+		// carry over the conditions of the product so their transition times are kept
+		product := stage.Products[productName]
+		product.Conditions = append([]integreatlyv1alpha1.Condition{}, snapshot.GetProductStatusObject(productName).Conditions...)
+
+		if restoring[productName] {
+			logrus.Infof("Not reconciling %s while it is being restored", productName)
+			product.Status = integreatlyv1alpha1.PhaseInProgress
+			results[i] = productReconcileResult{product: product, installation: snapshot.DeepCopy()}
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, product integreatlyv1alpha1.RHMIProductStatus) {
+			defer wg.Done()
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			results[i] = r.reconcileProduct(ctx, snapshot.DeepCopy(), stage.Name, product, configManager, productClient)
+		}(i, product)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		mergeProductInstallation(installation, snapshot, result.installation)
+	}
+
+	// finalizers removed by the products are persisted with the status, once the installation is reconciled
+	for _, finalizer := range installation.GetFinalizers() {
+		if resources.Contains(snapshot.GetFinalizers(), finalizer) {
+			continue
+		}
+		status := installation.Status.DeepCopy()
+		if err := r.client.Update(ctx, installation); err != nil {
+			return nil, fmt.Errorf("failed to add the finalizers of the products to the installation: %w", err)
+		}
+		installation.Status = *status
+		break
+	}
+	return results, nil
+}
+
+// getProductReconcileWorkers returns the number of products reconciled concurrently, from the
+// PRODUCT_RECONCILE_WORKERS environment variable when it is set to a positive number
+func getProductReconcileWorkers() int {
+	value := os.Getenv(productReconcileWorkersEnvName)
+	if value == "" {
+		return defaultProductReconcileWorkers
+	}
+	workers, err := strconv.Atoi(value)
+	if err != nil || workers < 1 {
+		logrus.Warnf("Ignoring invalid %s %q, reconciling %d products concurrently", productReconcileWorkersEnvName, value, defaultProductReconcileWorkers)
+		return defaultProductReconcileWorkers
+	}
+	return workers
+}
+
+// getProductReconcileTimeout returns the time a product reconcile is given, from the PRODUCT_RECONCILE_TIMEOUT
+// environment variable when it is set to a positive duration
+func getProductReconcileTimeout() time.Duration {
+	value := os.Getenv(productReconcileTimeoutEnvName)
+	if value == "" {
+		return defaultProductReconcileTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		logrus.Warnf("Ignoring invalid %s %q, using a timeout of %s", productReconcileTimeoutEnvName, value, defaultProductReconcileTimeout)
+		return defaultProductReconcileTimeout
+	}
+	return timeout
+}
+
+// productClient is the client of the product reconcilers. Updates of the installation are only applied to the copy
+// of the installation of the product, they are merged and persisted by reconcileStageProducts. As only the finalizers
+// and the status are merged, updates changing any other field of the installation fail
+type productClient struct {
+	k8sclient.Client
+	snapshot *integreatlyv1alpha1.RHMI
+}
+
+func (c *productClient) Update(ctx context.Context, obj runtime.Object, opts ...k8sclient.UpdateOption) error {
+	if installation, ok := obj.(*integreatlyv1alpha1.RHMI); ok {
+		return checkUnmergedInstallationChanges(c.snapshot, installation)
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *productClient) Patch(ctx context.Context, obj runtime.Object, patch k8sclient.Patch, opts ...k8sclient.PatchOption) error {
+	if installation, ok := obj.(*integreatlyv1alpha1.RHMI); ok {
+		return checkUnmergedInstallationChanges(c.snapshot, installation)
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+// checkUnmergedInstallationChanges returns an error when the copy of the installation of a product was changed
+// outside the fields merged by mergeProductInstallation, as those changes would be lost
+func checkUnmergedInstallationChanges(snapshot, installation *integreatlyv1alpha1.RHMI) error {
+	changed := installation.DeepCopy()
+	changed.SetFinalizers(snapshot.GetFinalizers())
+	changed.SetDeletionTimestamp(snapshot.GetDeletionTimestamp())
+	changed.Status = snapshot.Status
+	if !reflect.DeepEqual(changed, snapshot) {
+		return fmt.Errorf("products can only update the finalizers and the status of the installation %s", installation.Name)
+	}
+	return nil
+}
+
+func (c *productClient) Status() k8sclient.StatusWriter {
+	return &productStatusWriter{StatusWriter: c.Client.Status()}
+}
+
+type productStatusWriter struct {
+	k8sclient.StatusWriter
+}
+
+func (w *productStatusWriter) Update(ctx context.Context, obj runtime.Object, opts ...k8sclient.UpdateOption) error {
+	if _, ok := obj.(*integreatlyv1alpha1.RHMI); ok {
+		return nil
+	}
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+func (w *productStatusWriter) Patch(ctx context.Context, obj runtime.Object, patch k8sclient.Patch, opts ...k8sclient.PatchOption) error {
+	if _, ok := obj.(*integreatlyv1alpha1.RHMI); ok {
+		return nil
+	}
+	return w.StatusWriter.Patch(ctx, obj, patch, opts...)
+}
+
+// setProductConditions sets the Available, Progressing and Degraded conditions of a product from the outcome of
+// its reconcile
+func setProductConditions(product *integreatlyv1alpha1.RHMIProductStatus, generation int64, err error) {
+	phaseMessage := fmt.Sprintf("product is in phase %q", product.Status)
+
+	available := product.Status == integreatlyv1alpha1.PhaseCompleted
+	if available {
+		product.SetCondition(integreatlyv1alpha1.ConditionAvailable, true, generation, "ProductReconciled", phaseMessage)
+	} else {
+		product.SetCondition(integreatlyv1alpha1.ConditionAvailable, false, generation, "ProductNotReconciled", phaseMessage)
+	}
+
+	failed := err != nil || product.Status == integreatlyv1alpha1.PhaseFailed
+	progressing := !available && !failed
+	if progressing {
+		product.SetCondition(integreatlyv1alpha1.ConditionProgressing, true, generation, "ProductReconciling", phaseMessage)
+	} else {
+		product.SetCondition(integreatlyv1alpha1.ConditionProgressing, false, generation, "ProductNotReconciling", phaseMessage)
+	}
+
+	if failed {
+		message := phaseMessage
+		if err != nil {
+			message = err.Error()
+		}
+		product.SetCondition(integreatlyv1alpha1.ConditionDegraded, true, generation, "ReconcileFailed", message)
+	} else {
+		product.SetCondition(integreatlyv1alpha1.ConditionDegraded, false, generation, "NoErrors", "")
+	}
+}
+
+// setVersionDrift flags a product when the versions observed on the cluster do not match the versions the product
+// registered with the product registry, or the operator version the product is pinned to in the installation spec.
+// Versions a reconciler does not observe are not compared
+func setVersionDrift(installation *integreatlyv1alpha1.RHMI, product *integreatlyv1alpha1.RHMIProductStatus) error {
+	expectedVersion, expectedOperatorVersion, err := products.GetVersions(product.Name)
+	if err != nil {
+		return err
+	}
+	if pinned := installation.GetProductSpec(product.Name).OperatorVersion; pinned != "" {
+		expectedOperatorVersion = pinned
+	}
+
+	product.VersionDrift = false
+	if product.ObservedOperatorVersion != "" && !version.Matches(string(expectedOperatorVersion), string(product.ObservedOperatorVersion)) {
+		logrus.Warnf("Version drift found for %s: expected operator version %s, observed %s", product.Name, expectedOperatorVersion, product.ObservedOperatorVersion)
+		product.VersionDrift = true
+	}
+	if product.ObservedVersion != "" && !version.Matches(string(expectedVersion), string(product.ObservedVersion)) {
+		logrus.Warnf("Version drift found for %s: expected version %s, observed %s", product.Name, expectedVersion, product.ObservedVersion)
+		product.VersionDrift = true
+	}
+	return nil
+}
+
+// reconcileProduct builds the reconciler for a product and reconciles it within getProductReconcileTimeout.
+// Products disabled in the installation spec are uninstalled through the finalizer of their reconciler instead,
+// by marking the copy of the installation the reconciler is given as deleted
+func (r *ReconcileInstallation) reconcileProduct(ctx context.Context, installation *integreatlyv1alpha1.RHMI, stageName integreatlyv1alpha1.StageName, product integreatlyv1alpha1.RHMIProductStatus, configManager config.ConfigReadWriter, serverClient k8sclient.Client) productReconcileResult {
+	result := productReconcileResult{product: product, installation: installation}
+
+	enabled := installation.IsProductEnabled(product.Name)
+	finalizer := resources.GetProductFinalizer(string(product.Name))
+	if !enabled {
+		if !resources.Contains(installation.GetFinalizers(), finalizer) {
+			result.uninstalled = true
+			return result
+		}
+		logrus.Infof("Uninstalling disabled product %s in stage %s", product.Name, stageName)
+		now := metav1.Now()
+		installation.SetDeletionTimestamp(&now)
+	}
+
+	reconciler, err := products.NewReconciler(product.Name, r.restConfig, configManager, installation, r.mgr)
+	if err != nil {
+		result.setupErr = fmt.Errorf("failed to build a reconciler for %s: %w", product.Name, err)
+		return result
+	}
+	if enabled {
+		result.versionMismatch = !reconciler.VerifyVersion(installation)
+	}
+
+	productCtx, cancel := context.WithTimeout(ctx, getProductReconcileTimeout())
+	defer cancel()
+
+	start := time.Now()
+	result.product.Status, result.err = reconciler.Reconcile(productCtx, installation, &result.product, serverClient)
+	metrics.ObserveProductReconcileDuration(string(stageName), string(product.Name), time.Since(start))
+
+	if !enabled {
+		installation.SetDeletionTimestamp(nil)
+		result.uninstalled = result.err == nil && !resources.Contains(installation.GetFinalizers(), finalizer)
+	}
+	return result
+}
+
+// mergeProductInstallation copies the changes a product reconciler made to its copy of the installation back into
+// the installation: its finalizers and every field of the status it changed. The user sync status is merged per
+// product, as several products sync users
+func mergeProductInstallation(installation, snapshot, productInstallation *integreatlyv1alpha1.RHMI) {
+	if productInstallation == nil {
+		return
+	}
+	for _, finalizer := range productInstallation.GetFinalizers() {
+		if !resources.Contains(snapshot.GetFinalizers(), finalizer) && !resources.Contains(installation.GetFinalizers(), finalizer) {
+			installation.SetFinalizers(append(installation.GetFinalizers(), finalizer))
+		}
+	}
+	for _, finalizer := range snapshot.GetFinalizers() {
+		if !resources.Contains(productInstallation.GetFinalizers(), finalizer) {
+			installation.SetFinalizers(resources.Remove(installation.GetFinalizers(), finalizer))
+		}
+	}
+	for _, userSync := range productInstallation.Status.UserSync {
+		if !containsUserSyncStatus(snapshot.Status.UserSync, userSync) {
+			installation.SetUserSyncStatus(userSync)
+		}
+	}
+
+	status := reflect.ValueOf(&installation.Status).Elem()
+	snapshotStatus := reflect.ValueOf(snapshot.Status)
+	productStatus := reflect.ValueOf(*productInstallation.Status.DeepCopy())
+	for i := 0; i < status.NumField(); i++ {
+		if status.Type().Field(i).Name == "UserSync" {
+			continue
+		}
+		if !reflect.DeepEqual(productStatus.Field(i).Interface(), snapshotStatus.Field(i).Interface()) {
+			status.Field(i).Set(productStatus.Field(i))
+		}
+	}
+}
+
+func containsUserSyncStatus(statuses []integreatlyv1alpha1.UserSyncStatus, status integreatlyv1alpha1.UserSyncStatus) bool {
+	for _, s := range statuses {
+		if reflect.DeepEqual(s, status) {
+			return true
+		}
+	}
+	return false
+}
+
 // handle the deletion of CRO config map
 func (r *ReconcileInstallation) handleCROConfigDeletion(rhmi integreatlyv1alpha1.RHMI) error {
 	// get cloud resource config map
@@ -6,16 +6,25 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/all"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
 	olmv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
 	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 func buildScheme() *runtime.Scheme {
@@ -23,6 +32,7 @@ func buildScheme() *runtime.Scheme {
 
 	integreatlyv1alpha1.SchemeBuilder.AddToScheme(scheme)
 	olmv1alpha1.SchemeBuilder.AddToScheme(scheme)
+	corev1.SchemeBuilder.AddToScheme(scheme)
 
 	return scheme
 }
@@ -261,3 +271,364 @@ func getInstallationCR(ctx context.Context, serverClient k8sclient.Client) (*int
 
 	return &installationList.Items[0], nil
 }
+
+func TestMergeProductInstallation(t *testing.T) {
+	snapshot := &integreatlyv1alpha1.RHMI{}
+	snapshot.SetResourceVersion("1")
+	snapshot.SetFinalizers([]string{deletionFinalizer, "finalizer.fuse.integreatly.org"})
+
+	installation := snapshot.DeepCopy()
+
+	// 3scale adds its finalizer and synchronises its users
+	threescaleInstallation := snapshot.DeepCopy()
+	threescaleInstallation.SetFinalizers(append(threescaleInstallation.GetFinalizers(), "finalizer.3scale.integreatly.org"))
+	threescaleInstallation.SetUserSyncStatus(integreatlyv1alpha1.UserSyncStatus{Target: integreatlyv1alpha1.Product3Scale, DryRun: true, Planned: 2})
+
+	// fuse removes its finalizer without updating the CR
+	fuseInstallation := snapshot.DeepCopy()
+	fuseInstallation.SetFinalizers([]string{deletionFinalizer})
+
+	// rhsso enables GitHub OAuth
+	rhssoInstallation := snapshot.DeepCopy()
+	rhssoInstallation.Status.GitHubOAuthEnabled = true
+
+	// amq online enables SMTP, any field of the status is merged
+	amqOnlineInstallation := snapshot.DeepCopy()
+	amqOnlineInstallation.Status.SMTPEnabled = true
+
+	for _, productInstallation := range []*integreatlyv1alpha1.RHMI{threescaleInstallation, fuseInstallation, rhssoInstallation, amqOnlineInstallation} {
+		mergeProductInstallation(installation, snapshot, productInstallation)
+	}
+
+	expectedFinalizers := []string{deletionFinalizer, "finalizer.3scale.integreatly.org"}
+	if strings.Join(installation.GetFinalizers(), ",") != strings.Join(expectedFinalizers, ",") {
+		t.Fatalf("expected finalizers %v, got %v", expectedFinalizers, installation.GetFinalizers())
+	}
+	if len(installation.Status.UserSync) != 1 || installation.Status.UserSync[0].Planned != 2 {
+		t.Fatalf("expected the user sync status of 3scale, got %v", installation.Status.UserSync)
+	}
+	if !installation.Status.GitHubOAuthEnabled {
+		t.Fatalf("expected GitHub OAuth to be enabled")
+	}
+	if !installation.Status.SMTPEnabled {
+		t.Fatalf("expected SMTP to be enabled")
+	}
+}
+
+func TestProductClientUpdate(t *testing.T) {
+	snapshot := &integreatlyv1alpha1.RHMI{ObjectMeta: metav1.ObjectMeta{Name: "rhmi", Namespace: defaultNamespace}}
+	client := &productClient{Client: fake.NewFakeClientWithScheme(buildScheme(), snapshot.DeepCopy()), snapshot: snapshot}
+
+	installation := snapshot.DeepCopy()
+	installation.SetFinalizers([]string{"finalizer.3scale.integreatly.org"})
+	installation.Status.GitHubOAuthEnabled = true
+	if err := client.Update(context.TODO(), installation); err != nil {
+		t.Fatalf("expected the finalizers and status to be updated, got %v", err)
+	}
+
+	installation.Spec.UseClusterStorage = "false"
+	if err := client.Update(context.TODO(), installation); err == nil {
+		t.Fatal("expected an error updating the spec of the installation")
+	}
+}
+
+func TestProductReconcileSettings(t *testing.T) {
+	os.Setenv(productReconcileWorkersEnvName, "2")
+	os.Setenv(productReconcileTimeoutEnvName, "90s")
+	if getProductReconcileWorkers() != 2 || getProductReconcileTimeout() != 90*time.Second {
+		t.Fatalf("expected the settings of the environment, got %d workers and %s", getProductReconcileWorkers(), getProductReconcileTimeout())
+	}
+
+	os.Setenv(productReconcileWorkersEnvName, "0")
+	os.Setenv(productReconcileTimeoutEnvName, "soon")
+	if getProductReconcileWorkers() != defaultProductReconcileWorkers || getProductReconcileTimeout() != defaultProductReconcileTimeout {
+		t.Fatalf("expected the defaults for invalid settings, got %d workers and %s", getProductReconcileWorkers(), getProductReconcileTimeout())
+	}
+
+	os.Unsetenv(productReconcileWorkersEnvName)
+	os.Unsetenv(productReconcileTimeoutEnvName)
+}
+
+type fakeManager struct {
+	manager.Manager
+}
+
+func (m *fakeManager) GetEventRecorderFor(name string) record.EventRecorder {
+	return setupRecorder()
+}
+
+func TestReconcileStageProducts(t *testing.T) {
+	stageProducts := []integreatlyv1alpha1.ProductName{"fake-product-a", "fake-product-b"}
+	for _, productName := range stageProducts {
+		productName := productName
+		reconciler := &products.InterfaceMock{
+			ReconcileFunc: func(ctx context.Context, installation *integreatlyv1alpha1.RHMI, product *integreatlyv1alpha1.RHMIProductStatus, serverClient k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
+				if err := resources.AddFinalizer(ctx, installation, serverClient, resources.GetProductFinalizer(string(productName))); err != nil {
+					return integreatlyv1alpha1.PhaseFailed, err
+				}
+				installation.SetUserSyncStatus(integreatlyv1alpha1.UserSyncStatus{Target: productName, DryRun: true, Planned: 1})
+				return integreatlyv1alpha1.PhaseCompleted, nil
+			},
+			VerifyVersionFunc: func(installation *integreatlyv1alpha1.RHMI) bool {
+				return true
+			},
+		}
+		products.Register(productName, products.Registration{
+			Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+				return reconciler, nil
+			},
+			Config: func(cfg config.ProductConfig) config.ConfigReadable {
+				return config.NewUps(cfg)
+			},
+		})
+		defer products.Unregister(productName)
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-installation",
+			Namespace:  defaultNamespace,
+			Finalizers: []string{deletionFinalizer},
+		},
+	}
+	client := fake.NewFakeClientWithScheme(buildScheme(), installation)
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: installation.Name, Namespace: installation.Namespace}, installation); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := &ReconcileInstallation{client: client, restConfig: &rest.Config{}, mgr: &fakeManager{}}
+	stage := &Stage{
+		Name: integreatlyv1alpha1.ProductsStage,
+		Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.RHMIProductStatus{
+			stageProducts[0]: {Name: stageProducts[0]},
+			stageProducts[1]: {Name: stageProducts[1]},
+		},
+	}
+	results, err := r.reconcileStageProducts(context.TODO(), installation, stage, nil, client, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, result := range results {
+		if result.err != nil || result.product.Status != integreatlyv1alpha1.PhaseCompleted {
+			t.Fatalf("expected %s to be reconciled, got %s: %v", result.product.Name, result.product.Status, result.err)
+		}
+	}
+
+	if len(installation.Status.UserSync) != 2 {
+		t.Fatalf("expected the user sync status of both products to be merged, got %v", installation.Status.UserSync)
+	}
+	persisted := &integreatlyv1alpha1.RHMI{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: installation.Name, Namespace: installation.Namespace}, persisted); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedFinalizers := []string{deletionFinalizer, "finalizer.fake-product-a.integreatly.org", "finalizer.fake-product-b.integreatly.org"}
+	if strings.Join(persisted.GetFinalizers(), ",") != strings.Join(expectedFinalizers, ",") {
+		t.Fatalf("expected the finalizers of both products to be persisted, got %v", persisted.GetFinalizers())
+	}
+	if persisted.GetResourceVersion() != installation.GetResourceVersion() {
+		t.Fatalf("expected the installation to have the resource version of its update %s, got %s", persisted.GetResourceVersion(), installation.GetResourceVersion())
+	}
+}
+
+func TestReconcileProductSkipsUninstalledDisabledProduct(t *testing.T) {
+	disabled := false
+	installation := &integreatlyv1alpha1.RHMI{
+		Spec: integreatlyv1alpha1.RHMISpec{
+			Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+				integreatlyv1alpha1.ProductUps: {Enabled: &disabled},
+			},
+		},
+	}
+	if installation.IsProductEnabled(integreatlyv1alpha1.ProductUps) {
+		t.Fatalf("expected %s to be disabled", integreatlyv1alpha1.ProductUps)
+	}
+	if !installation.IsProductEnabled(integreatlyv1alpha1.ProductDataSync) {
+		t.Fatalf("expected %s to be enabled by default", integreatlyv1alpha1.ProductDataSync)
+	}
+
+	r := &ReconcileInstallation{}
+	result := r.reconcileProduct(context.TODO(), installation, integreatlyv1alpha1.ProductsStage, integreatlyv1alpha1.RHMIProductStatus{Name: integreatlyv1alpha1.ProductUps}, nil, nil)
+	if !result.uninstalled {
+		t.Fatalf("expected disabled product without a finalizer to be reported as uninstalled")
+	}
+	if result.setupErr != nil || result.err != nil {
+		t.Fatalf("expected no errors but got setupErr=%v err=%v", result.setupErr, result.err)
+	}
+}
+
+func TestSetProductConditions(t *testing.T) {
+	cases := []struct {
+		Name                string
+		Status              integreatlyv1alpha1.StatusPhase
+		Err                 error
+		ExpectedAvailable   bool
+		ExpectedProgressing bool
+		ExpectedDegraded    bool
+	}{
+		{
+			Name:              "completed product is available",
+			Status:            integreatlyv1alpha1.PhaseCompleted,
+			ExpectedAvailable: true,
+		},
+		{
+			Name:                "product in progress is progressing",
+			Status:              integreatlyv1alpha1.PhaseAwaitingOperator,
+			ExpectedProgressing: true,
+		},
+		{
+			Name:             "product with an error is degraded",
+			Status:           integreatlyv1alpha1.PhaseInProgress,
+			Err:              fmt.Errorf("failed to reconcile subscription"),
+			ExpectedDegraded: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			product := &integreatlyv1alpha1.RHMIProductStatus{Name: integreatlyv1alpha1.ProductUps, Status: tc.Status}
+			setProductConditions(product, 1, tc.Err)
+
+			expected := map[integreatlyv1alpha1.ConditionType]bool{
+				integreatlyv1alpha1.ConditionAvailable:   tc.ExpectedAvailable,
+				integreatlyv1alpha1.ConditionProgressing: tc.ExpectedProgressing,
+				integreatlyv1alpha1.ConditionDegraded:    tc.ExpectedDegraded,
+			}
+			for conditionType, status := range expected {
+				if integreatlyv1alpha1.IsConditionTrue(product.Conditions, conditionType) != status {
+					t.Fatalf("expected %s to be %v, got conditions %v", conditionType, status, product.Conditions)
+				}
+			}
+		})
+	}
+}
+
+func TestSetInstallationConditionsDegraded(t *testing.T) {
+	installation := &integreatlyv1alpha1.RHMI{}
+	installation.Status.LastError = "failed installation of 3scale"
+	setInstallationConditions(installation, true)
+
+	// the next reconcile fails with a different error
+	installation.Status.LastError = "failed installation of rhsso"
+	setInstallationConditions(installation, true)
+	degraded := integreatlyv1alpha1.FindCondition(installation.Status.Conditions, integreatlyv1alpha1.ConditionDegraded)
+	if degraded == nil || degraded.Status != corev1.ConditionTrue || degraded.Message != "failed installation of rhsso" {
+		t.Fatalf("expected the degraded condition to report the latest error, got %v", degraded)
+	}
+
+	installation.Status.LastError = ""
+	setInstallationConditions(installation, false)
+	if integreatlyv1alpha1.IsConditionTrue(installation.Status.Conditions, integreatlyv1alpha1.ConditionDegraded) {
+		t.Fatalf("expected the installation not to be degraded once the errors are resolved, got %v", installation.Status.Conditions)
+	}
+	if integreatlyv1alpha1.IsConditionTrue(installation.Status.Conditions, integreatlyv1alpha1.ConditionUpgrading) {
+		t.Fatalf("expected the installation not to be upgrading, got %v", installation.Status.Conditions)
+	}
+}
+
+func TestSetVersionDrift(t *testing.T) {
+	cases := []struct {
+		Name            string
+		OperatorVersion integreatlyv1alpha1.OperatorVersion
+		Product         integreatlyv1alpha1.RHMIProductStatus
+		ExpectedDrift   bool
+	}{
+		{
+			Name:    "product without observed versions has no drift",
+			Product: integreatlyv1alpha1.RHMIProductStatus{Name: integreatlyv1alpha1.ProductUps},
+		},
+		{
+			Name: "product with the expected versions has no drift",
+			Product: integreatlyv1alpha1.RHMIProductStatus{
+				Name:                    integreatlyv1alpha1.ProductUps,
+				ObservedOperatorVersion: integreatlyv1alpha1.OperatorVersionUPS,
+				ObservedVersion:         integreatlyv1alpha1.VersionUps,
+			},
+		},
+		{
+			Name: "product left on an older operator version has drift",
+			Product: integreatlyv1alpha1.RHMIProductStatus{
+				Name:                    integreatlyv1alpha1.ProductUps,
+				ObservedOperatorVersion: "0.4.0",
+				VersionDrift:            false,
+			},
+			ExpectedDrift: true,
+		},
+		{
+			Name:            "product on the operator version pinned in the spec has no drift",
+			OperatorVersion: "0.4.0",
+			Product: integreatlyv1alpha1.RHMIProductStatus{
+				Name:                    integreatlyv1alpha1.ProductUps,
+				ObservedOperatorVersion: "0.4.0",
+				VersionDrift:            true,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			installation := &integreatlyv1alpha1.RHMI{
+				Spec: integreatlyv1alpha1.RHMISpec{
+					Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+						integreatlyv1alpha1.ProductUps: {OperatorVersion: tc.OperatorVersion},
+					},
+				},
+			}
+			product := tc.Product
+			if err := setVersionDrift(installation, &product); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if product.VersionDrift != tc.ExpectedDrift {
+				t.Fatalf("expected version drift to be %v", tc.ExpectedDrift)
+			}
+		})
+	}
+}
+
+func TestRecordPreflightEvents(t *testing.T) {
+	passed := integreatlyv1alpha1.PreflightCheckStatus{Name: "namespaces", Result: integreatlyv1alpha1.PreflightCheckPassed, Message: "ok"}
+	warning := integreatlyv1alpha1.PreflightCheckStatus{Name: "storage", Result: integreatlyv1alpha1.PreflightCheckWarning, Message: "low"}
+	failed := integreatlyv1alpha1.PreflightCheckStatus{Name: "products", Result: integreatlyv1alpha1.PreflightCheckFailed, Message: "found"}
+
+	cases := []struct {
+		Name           string
+		Previous       []integreatlyv1alpha1.PreflightCheckStatus
+		Checks         []integreatlyv1alpha1.PreflightCheckStatus
+		ExpectedEvents int
+	}{
+		{
+			Name:           "first run emits an event for every check",
+			Checks:         []integreatlyv1alpha1.PreflightCheckStatus{passed, warning, failed},
+			ExpectedEvents: 3,
+		},
+		{
+			Name:           "unchanged checks only emit the failures",
+			Previous:       []integreatlyv1alpha1.PreflightCheckStatus{passed, warning, failed},
+			Checks:         []integreatlyv1alpha1.PreflightCheckStatus{passed, warning, failed},
+			ExpectedEvents: 1,
+		},
+		{
+			Name:           "check that changed result emits an event",
+			Previous:       []integreatlyv1alpha1.PreflightCheckStatus{{Name: "namespaces", Result: integreatlyv1alpha1.PreflightCheckFailed}},
+			Checks:         []integreatlyv1alpha1.PreflightCheckStatus{passed},
+			ExpectedEvents: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(50)
+			failedChecks, warnings := recordPreflightEvents(recorder, &integreatlyv1alpha1.RHMI{}, tc.Previous, tc.Checks)
+			if len(recorder.Events) != tc.ExpectedEvents {
+				t.Fatalf("expected %d events but got %d", tc.ExpectedEvents, len(recorder.Events))
+			}
+			for _, check := range tc.Checks {
+				if check.Result == integreatlyv1alpha1.PreflightCheckFailed && len(failedChecks) != 1 {
+					t.Fatalf("expected the failed check to be returned, got %v", failedChecks)
+				}
+				if check.Result == integreatlyv1alpha1.PreflightCheckWarning && len(warnings) != 1 {
+					t.Fatalf("expected the warning to be returned, got %v", warnings)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,475 @@
+package installation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	applicationmonitoringv1alpha1 "github.com/integr8ly/application-monitoring-operator/pkg/apis/applicationmonitoring/v1alpha1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	routev1 "github.com/openshift/api/route/v1"
+	operatorsv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	prometheusapi "github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultProductHealthTimeout = 30 * time.Minute
+
+// productHealthTarget is the product a health check verifies
+type productHealthTarget struct {
+	namespaces          []string
+	monitoringNamespace string
+	prometheusAddress   string
+}
+
+// productHealthCheck verifies the health of a product after its operator was upgraded. The message describes why
+// the product is not healthy
+type productHealthCheck func(ctx context.Context, client k8sclient.Client, target productHealthTarget) (bool, string, error)
+
+var productHealthChecks = map[integreatlyv1alpha1.ProductHealthCheck]productHealthCheck{
+	integreatlyv1alpha1.HealthCheckDeploymentsReady:  deploymentsReady,
+	integreatlyv1alpha1.HealthCheckNoCriticalAlerts:  noCriticalAlerts,
+	integreatlyv1alpha1.HealthCheckBlackboxTargetsUp: blackboxTargetsUp,
+}
+
+// newPrometheusAPI builds the client of the Prometheus API the firing alerts are read from
+var newPrometheusAPI = func(address string) (prometheusv1.API, error) {
+	client, err := prometheusapi.NewClient(prometheusapi.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return prometheusv1.NewAPI(client), nil
+}
+
+type operatorNamespaceReader interface {
+	GetOperatorNamespace() string
+}
+
+// stagedUpgradeGate approves the install plans upgrading the product operators one product at a time, in the
+// order of the policy. The upgrade of a product is approved once the previous upgraded product is healthy and no
+// product earlier in the order is waiting for its upgrade to be approved. It becomes the current upgrade once its
+// install plan is approved, after the pre-upgrade backups
+type stagedUpgradeGate struct {
+	lock sync.Mutex
+
+	policy              *integreatlyv1alpha1.ProductUpgradePolicy
+	timeout             time.Duration
+	monitoringNamespace string
+	prometheusAddress   string
+	rank                map[integreatlyv1alpha1.ProductName]int
+	products            map[string]integreatlyv1alpha1.ProductName
+	namespaces          map[integreatlyv1alpha1.ProductName][]string
+	installed           map[integreatlyv1alpha1.ProductName]bool
+
+	current   *integreatlyv1alpha1.ProductUpgrade
+	approving integreatlyv1alpha1.ProductName
+	pending   []integreatlyv1alpha1.ProductName
+	requested map[integreatlyv1alpha1.ProductName]bool
+}
+
+var _ resources.UpgradeGate = &stagedUpgradeGate{}
+
+// newStagedUpgradeGate builds the gate for the staged upgrades of the installation, or returns nil when the product
+// upgrades are not staged. The products are ranked by the order of the policy, then by the order of the stages
+func newStagedUpgradeGate(installation *integreatlyv1alpha1.RHMI, installType *Type, configManager config.ConfigReadWriter) (*stagedUpgradeGate, error) {
+	policy := installation.Spec.ProductUpgrades
+	if policy == nil || !policy.Staged {
+		return nil, nil
+	}
+
+	timeout := defaultProductHealthTimeout
+	if policy.HealthTimeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(policy.HealthTimeout); err != nil {
+			return nil, fmt.Errorf("failed to parse the product upgrades health timeout %s: %w", policy.HealthTimeout, err)
+		}
+	}
+	for _, check := range policy.HealthChecks {
+		if _, ok := productHealthChecks[check]; !ok {
+			return nil, fmt.Errorf("unknown product upgrades health check %s", check)
+		}
+	}
+
+	monitoringConfig, err := configManager.ReadMonitoring()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the monitoring config: %w", err)
+	}
+
+	gate := &stagedUpgradeGate{
+		policy:              policy,
+		timeout:             timeout,
+		monitoringNamespace: monitoringConfig.GetOperatorNamespace(),
+		prometheusAddress:   fmt.Sprintf("http://prometheus-operated.%s.svc:9090", monitoringConfig.GetOperatorNamespace()),
+		rank:                map[integreatlyv1alpha1.ProductName]int{},
+		products:            map[string]integreatlyv1alpha1.ProductName{},
+		namespaces:          map[integreatlyv1alpha1.ProductName][]string{},
+		installed:           map[integreatlyv1alpha1.ProductName]bool{},
+		requested:           map[integreatlyv1alpha1.ProductName]bool{},
+	}
+	if status := installation.Status.ProductUpgrades; status != nil {
+		gate.current = status.Current.DeepCopy()
+		gate.pending = status.Pending
+	}
+
+	order := append([]integreatlyv1alpha1.ProductName{}, policy.Order...)
+	for _, stage := range installType.GetInstallStages() {
+		order = append(order, stage.GetProductOrder()...)
+	}
+	for _, product := range order {
+		if _, ok := gate.rank[product]; ok {
+			continue
+		}
+		gate.rank[product] = len(gate.rank)
+
+		productConfig, err := configManager.ReadProduct(product)
+		if err != nil {
+			continue
+		}
+		// products without an operator namespace, such as the OpenShift templates, have no install plans
+		operatorConfig, ok := productConfig.(operatorNamespaceReader)
+		if !ok || operatorConfig.GetOperatorNamespace() == "" {
+			continue
+		}
+		gate.products[operatorConfig.GetOperatorNamespace()] = product
+		gate.namespaces[product] = append(gate.namespaces[product], operatorConfig.GetOperatorNamespace())
+		if namespace := productConfig.GetNamespace(); namespace != "" && namespace != operatorConfig.GetOperatorNamespace() {
+			gate.namespaces[product] = append(gate.namespaces[product], namespace)
+		}
+
+		// products without an installed operator are being installed, not upgraded
+		status := installation.GetProductStatusObject(product)
+		gate.installed[product] = status.ObservedOperatorVersion != "" || status.OperatorVersion != ""
+	}
+
+	return gate, nil
+}
+
+// ApproveUpgrade approves the upgrade of the product whose operator is in the namespace when no other product upgrade
+// is being approved or verified and no product earlier in the order is waiting for its upgrade
+func (g *stagedUpgradeGate) ApproveUpgrade(namespace string, ip *operatorsv1alpha1.InstallPlan) bool {
+	product, ok := g.products[namespace]
+	if !ok || !g.installed[product] {
+		return true
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.current != nil {
+		if g.current.Product == product && g.current.InstallPlan == ip.Name {
+			return true
+		}
+		g.requested[product] = true
+		return false
+	}
+
+	g.requested[product] = true
+	if g.approving != "" {
+		logrus.Infof("Upgrade of %s waits for the approval of the upgrade of %s", product, g.approving)
+		return false
+	}
+	for _, other := range g.getPending() {
+		if g.rank[other] < g.rank[product] {
+			logrus.Infof("Upgrade of %s waits for the upgrade of %s", product, other)
+			return false
+		}
+	}
+
+	logrus.Infof("Approving the staged upgrade of %s with install plan %s", product, ip.Name)
+	g.approving = product
+	return true
+}
+
+// FinishUpgradeApproval makes the upgrade allowed by ApproveUpgrade the current upgrade once its install plan is
+// approved. When the approval failed, the product stays pending and the next reconcile retries it first
+func (g *stagedUpgradeGate) FinishUpgradeApproval(namespace string, ip *operatorsv1alpha1.InstallPlan, approved bool) {
+	product, ok := g.products[namespace]
+	if !ok || !g.installed[product] {
+		return
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.approving != product {
+		return
+	}
+	g.approving = ""
+	if !approved {
+		logrus.Warnf("Staged upgrade of %s with install plan %s was not approved", product, ip.Name)
+		return
+	}
+
+	delete(g.requested, product)
+	g.current = &integreatlyv1alpha1.ProductUpgrade{
+		Product:     product,
+		InstallPlan: ip.Name,
+		Namespace:   namespace,
+		ApprovedAt:  metav1.Now(),
+		Phase:       integreatlyv1alpha1.ProductUpgradeVerifying,
+	}
+}
+
+// getPending returns the products waiting for their upgrade in the previous and the current reconcile
+func (g *stagedUpgradeGate) getPending() []integreatlyv1alpha1.ProductName {
+	pending := append([]integreatlyv1alpha1.ProductName{}, g.pending...)
+	for product := range g.requested {
+		pending = append(pending, product)
+	}
+	return pending
+}
+
+// verify checks the health of the product upgraded last. The next product can be upgraded once it's healthy. If it
+// does not become healthy within the timeout of the policy, the upgrade is marked as failed
+func (g *stagedUpgradeGate) verify(ctx context.Context, client k8sclient.Client) error {
+	if g.current == nil {
+		return nil
+	}
+	current := g.current
+
+	installPlan := &operatorsv1alpha1.InstallPlan{}
+	err := client.Get(ctx, k8sclient.ObjectKey{Name: current.InstallPlan, Namespace: current.Namespace}, installPlan)
+	if k8serr.IsNotFound(err) {
+		logrus.Infof("Install plan %s of the upgrade of %s was removed", current.InstallPlan, current.Product)
+		g.current = nil
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get install plan %s of the upgrade of %s: %w", current.InstallPlan, current.Product, err)
+	}
+
+	healthy, message := false, fmt.Sprintf("install plan %s is %s", installPlan.Name, installPlan.Status.Phase)
+	if installPlan.Status.Phase == operatorsv1alpha1.InstallPlanPhaseComplete {
+		healthy, message, err = g.checkHealth(ctx, client, current.Product)
+		if err != nil {
+			return err
+		}
+	}
+
+	if healthy {
+		logrus.Infof("Upgrade of %s is healthy", current.Product)
+		g.current = nil
+		return nil
+	}
+
+	current.Message = message
+	if time.Since(current.ApprovedAt.Time) > g.timeout {
+		current.Phase = integreatlyv1alpha1.ProductUpgradeFailed
+	}
+	return nil
+}
+
+func (g *stagedUpgradeGate) checkHealth(ctx context.Context, client k8sclient.Client, product integreatlyv1alpha1.ProductName) (bool, string, error) {
+	checks := g.policy.HealthChecks
+	if len(checks) == 0 {
+		checks = []integreatlyv1alpha1.ProductHealthCheck{integreatlyv1alpha1.HealthCheckDeploymentsReady}
+	}
+
+	for _, check := range checks {
+		target := productHealthTarget{
+			namespaces:          g.namespaces[product],
+			monitoringNamespace: g.monitoringNamespace,
+			prometheusAddress:   g.prometheusAddress,
+		}
+		healthy, message, err := productHealthChecks[check](ctx, client, target)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to check the health of %s: %w", product, err)
+		}
+		if !healthy {
+			return false, message, nil
+		}
+	}
+	return true, "", nil
+}
+
+// apply stores the state of the staged upgrades in the installation status
+func (g *stagedUpgradeGate) apply(installation *integreatlyv1alpha1.RHMI) {
+	pending := []integreatlyv1alpha1.ProductName{}
+	for product := range g.requested {
+		pending = append(pending, product)
+	}
+	sort.Slice(pending, func(i, j int) bool { return g.rank[pending[i]] < g.rank[pending[j]] })
+
+	if g.current == nil && len(pending) == 0 {
+		installation.Status.ProductUpgrades = nil
+		return
+	}
+	installation.Status.ProductUpgrades = &integreatlyv1alpha1.ProductUpgradesStatus{
+		Current: g.current,
+		Pending: pending,
+	}
+}
+
+// setProductUpgradeCondition marks the installation as degraded while a staged product upgrade has failed. It returns
+// true when the condition was set
+func setProductUpgradeCondition(installation *integreatlyv1alpha1.RHMI) bool {
+	status := installation.Status.ProductUpgrades
+	if status == nil || status.Current == nil || status.Current.Phase != integreatlyv1alpha1.ProductUpgradeFailed {
+		return false
+	}
+	installation.SetCondition(integreatlyv1alpha1.ConditionDegraded, true, "ProductUpgradeFailed",
+		fmt.Sprintf("upgrade of %s is not healthy, product upgrades are halted: %s", status.Current.Product, status.Current.Message))
+	return true
+}
+
+// deploymentsReady checks that the deployments and stateful sets in the namespaces have all their replicas ready
+func deploymentsReady(ctx context.Context, client k8sclient.Client, target productHealthTarget) (bool, string, error) {
+	for _, namespace := range target.namespaces {
+		deployments := &appsv1.DeploymentList{}
+		if err := client.List(ctx, deployments, k8sclient.InNamespace(namespace)); err != nil {
+			return false, "", err
+		}
+		for _, deployment := range deployments.Items {
+			replicas := int32(1)
+			if deployment.Spec.Replicas != nil {
+				replicas = *deployment.Spec.Replicas
+			}
+			if deployment.Status.UpdatedReplicas < replicas || deployment.Status.ReadyReplicas < replicas {
+				return false, fmt.Sprintf("deployment %s in namespace %s has %d of %d replicas ready", deployment.Name, namespace, deployment.Status.ReadyReplicas, replicas), nil
+			}
+		}
+
+		statefulSets := &appsv1.StatefulSetList{}
+		if err := client.List(ctx, statefulSets, k8sclient.InNamespace(namespace)); err != nil {
+			return false, "", err
+		}
+		for _, statefulSet := range statefulSets.Items {
+			replicas := int32(1)
+			if statefulSet.Spec.Replicas != nil {
+				replicas = *statefulSet.Spec.Replicas
+			}
+			if statefulSet.Status.ReadyReplicas < replicas {
+				return false, fmt.Sprintf("stateful set %s in namespace %s has %d of %d replicas ready", statefulSet.Name, namespace, statefulSet.Status.ReadyReplicas, replicas), nil
+			}
+		}
+	}
+	return true, "", nil
+}
+
+// noCriticalAlerts checks that none of the critical alerts reconciled by the product in the namespaces are firing
+// in the Prometheus of the monitoring stack
+func noCriticalAlerts(ctx context.Context, client k8sclient.Client, target productHealthTarget) (bool, string, error) {
+	criticalAlerts := map[string]bool{}
+	for _, namespace := range target.namespaces {
+		rules := &monitoringv1.PrometheusRuleList{}
+		if err := client.List(ctx, rules, k8sclient.InNamespace(namespace), k8sclient.MatchingLabels{"integreatly": "yes"}); err != nil {
+			return false, "", err
+		}
+		for _, rule := range rules.Items {
+			for _, group := range rule.Spec.Groups {
+				for _, alert := range group.Rules {
+					if alert.Alert != "" && alert.Labels["severity"] == "critical" {
+						criticalAlerts[alert.Alert] = true
+					}
+				}
+			}
+		}
+	}
+	if len(criticalAlerts) == 0 {
+		return true, "", nil
+	}
+
+	prometheus, err := newPrometheusAPI(target.prometheusAddress)
+	if err != nil {
+		return false, "", err
+	}
+	alerts, err := prometheus.Alerts(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get the firing alerts: %w", err)
+	}
+
+	firing := []string{}
+	for _, alert := range alerts.Alerts {
+		name := string(alert.Labels["alertname"])
+		if alert.State == prometheusv1.AlertStateFiring && criticalAlerts[name] {
+			firing = append(firing, name)
+		}
+	}
+	if len(firing) > 0 {
+		sort.Strings(firing)
+		return false, fmt.Sprintf("critical alerts are firing: %s", strings.Join(firing, ", ")), nil
+	}
+	return true, "", nil
+}
+
+// blackboxTargetsUp checks that the last probes of the blackbox targets of the routes in the namespaces succeeded.
+// The blackbox targets are created in the monitoring namespace, they are matched to the product by the host of their
+// URL
+func blackboxTargetsUp(ctx context.Context, client k8sclient.Client, target productHealthTarget) (bool, string, error) {
+	hosts := map[string]bool{}
+	for _, namespace := range target.namespaces {
+		routes := &routev1.RouteList{}
+		if err := client.List(ctx, routes, k8sclient.InNamespace(namespace)); err != nil {
+			return false, "", err
+		}
+		for _, route := range routes.Items {
+			hosts[route.Spec.Host] = true
+		}
+	}
+
+	blackboxTargets := &applicationmonitoringv1alpha1.BlackboxTargetList{}
+	if err := client.List(ctx, blackboxTargets, k8sclient.InNamespace(target.monitoringNamespace)); err != nil {
+		return false, "", err
+	}
+	services := []string{}
+	for _, blackboxTarget := range blackboxTargets.Items {
+		for _, data := range blackboxTarget.Spec.BlackboxTargets {
+			targetURL, err := url.Parse(data.Url)
+			if err == nil && hosts[targetURL.Hostname()] {
+				services = append(services, data.Service)
+			}
+		}
+	}
+	if len(services) == 0 {
+		return true, "", nil
+	}
+	sort.Strings(services)
+
+	prometheus, err := newPrometheusAPI(target.prometheusAddress)
+	if err != nil {
+		return false, "", err
+	}
+	query := fmt.Sprintf(`probe_success{service=~"%s"}`, strings.Join(services, "|"))
+	result, _, err := prometheus.Query(ctx, query, time.Now())
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get the blackbox probes: %w", err)
+	}
+	samples, ok := result.(model.Vector)
+	if !ok {
+		return false, "", fmt.Errorf("unexpected result type %s of the blackbox probes query", result.Type())
+	}
+
+	probed := map[string]bool{}
+	down := []string{}
+	for _, sample := range samples {
+		service := string(sample.Metric["service"])
+		probed[service] = true
+		if sample.Value == 0 {
+			down = append(down, service)
+		}
+	}
+	for _, service := range services {
+		if !probed[service] {
+			down = append(down, service)
+		}
+	}
+	if len(down) > 0 {
+		sort.Strings(down)
+		return false, fmt.Sprintf("blackbox targets are down: %s", strings.Join(down, ", ")), nil
+	}
+	return true, "", nil
+}
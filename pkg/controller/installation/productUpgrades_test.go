@@ -0,0 +1,292 @@
+package installation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	applicationmonitoringv1alpha1 "github.com/integr8ly/application-monitoring-operator/pkg/apis/applicationmonitoring/v1alpha1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	routev1 "github.com/openshift/api/route/v1"
+	olmv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func buildStagedUpgradeGate() *stagedUpgradeGate {
+	return &stagedUpgradeGate{
+		policy:  &integreatlyv1alpha1.ProductUpgradePolicy{Staged: true},
+		timeout: defaultProductHealthTimeout,
+		rank: map[integreatlyv1alpha1.ProductName]int{
+			integreatlyv1alpha1.ProductRHSSO:               0,
+			integreatlyv1alpha1.Product3Scale:              1,
+			integreatlyv1alpha1.ProductApicurito:           2,
+			integreatlyv1alpha1.ProductCodeReadyWorkspaces: 3,
+		},
+		products: map[string]integreatlyv1alpha1.ProductName{
+			"rhsso-operator":     integreatlyv1alpha1.ProductRHSSO,
+			"3scale-operator":    integreatlyv1alpha1.Product3Scale,
+			"apicurito-operator": integreatlyv1alpha1.ProductApicurito,
+			"codeready-operator": integreatlyv1alpha1.ProductCodeReadyWorkspaces,
+		},
+		namespaces: map[integreatlyv1alpha1.ProductName][]string{
+			integreatlyv1alpha1.Product3Scale: {"3scale-operator", "3scale"},
+		},
+		installed: map[integreatlyv1alpha1.ProductName]bool{
+			integreatlyv1alpha1.ProductRHSSO:     true,
+			integreatlyv1alpha1.Product3Scale:    true,
+			integreatlyv1alpha1.ProductApicurito: true,
+		},
+		requested: map[integreatlyv1alpha1.ProductName]bool{},
+	}
+}
+
+func installPlan(name, namespace string) *olmv1alpha1.InstallPlan {
+	return &olmv1alpha1.InstallPlan{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+}
+
+func TestStagedUpgradeGateApproveUpgrade(t *testing.T) {
+	gate := buildStagedUpgradeGate()
+
+	if !gate.ApproveUpgrade("codeready-operator", installPlan("install-codeready", "codeready-operator")) {
+		t.Fatalf("expected the install of a product not installed yet to be approved")
+	}
+	if !gate.ApproveUpgrade("unknown-operator", installPlan("install-unknown", "unknown-operator")) {
+		t.Fatalf("expected the install plan of a namespace not owned by a product to be approved")
+	}
+
+	// the products of a stage are reconciled concurrently, the later product can request its upgrade first
+	if !gate.ApproveUpgrade("apicurito-operator", installPlan("install-apicurito", "apicurito-operator")) {
+		t.Fatalf("expected the upgrade of apicurito to be approved when no other upgrade is pending")
+	}
+	if gate.ApproveUpgrade("3scale-operator", installPlan("install-3scale", "3scale-operator")) {
+		t.Fatalf("expected the upgrade of 3scale to wait for the upgrade of apicurito to be approved")
+	}
+	if gate.current != nil {
+		t.Fatalf("expected no current upgrade before the install plan is approved, got %v", gate.current)
+	}
+	gate.FinishUpgradeApproval("apicurito-operator", installPlan("install-apicurito", "apicurito-operator"), true)
+	if gate.ApproveUpgrade("3scale-operator", installPlan("install-3scale", "3scale-operator")) {
+		t.Fatalf("expected the upgrade of 3scale to wait for the upgrade of apicurito to be verified")
+	}
+	if !gate.ApproveUpgrade("apicurito-operator", installPlan("install-apicurito", "apicurito-operator")) {
+		t.Fatalf("expected the upgrade in progress to stay approved")
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{}
+	gate.apply(installation)
+	status := installation.Status.ProductUpgrades
+	if status == nil || status.Current == nil || status.Current.Product != integreatlyv1alpha1.ProductApicurito {
+		t.Fatalf("expected the upgrade of apicurito to be the current upgrade, got %v", status)
+	}
+	if len(status.Pending) != 1 || status.Pending[0] != integreatlyv1alpha1.Product3Scale {
+		t.Fatalf("expected 3scale to be pending, got %v", status.Pending)
+	}
+
+	// next reconcile, the upgrade of apicurito was verified
+	installation.Status.ProductUpgrades.Current = nil
+	gate = buildStagedUpgradeGate()
+	gate.pending = installation.Status.ProductUpgrades.Pending
+
+	if gate.ApproveUpgrade("apicurito-operator", installPlan("install-apicurito-2", "apicurito-operator")) {
+		t.Fatalf("expected the upgrade of apicurito to wait for the pending upgrade of 3scale")
+	}
+	if !gate.ApproveUpgrade("3scale-operator", installPlan("install-3scale", "3scale-operator")) {
+		t.Fatalf("expected the pending upgrade of 3scale to be approved first")
+	}
+}
+
+func TestStagedUpgradeGateFailedApproval(t *testing.T) {
+	gate := buildStagedUpgradeGate()
+
+	if !gate.ApproveUpgrade("apicurito-operator", installPlan("install-apicurito", "apicurito-operator")) {
+		t.Fatalf("expected the upgrade of apicurito to be approved when no other upgrade is pending")
+	}
+	// the pre-upgrade backups of apicurito failed, the install plan was not approved
+	gate.FinishUpgradeApproval("apicurito-operator", installPlan("install-apicurito", "apicurito-operator"), false)
+	if gate.current != nil {
+		t.Fatalf("expected no current upgrade when the approval failed, got %v", gate.current)
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{}
+	gate.apply(installation)
+	status := installation.Status.ProductUpgrades
+	if status == nil || status.Current != nil || len(status.Pending) != 1 || status.Pending[0] != integreatlyv1alpha1.ProductApicurito {
+		t.Fatalf("expected apicurito to be pending, got %v", status)
+	}
+
+	// next reconcile, the upgrade of apicurito is retried
+	gate = buildStagedUpgradeGate()
+	gate.pending = status.Pending
+	if !gate.ApproveUpgrade("apicurito-operator", installPlan("install-apicurito", "apicurito-operator")) {
+		t.Fatalf("expected the upgrade of apicurito to be retried")
+	}
+}
+
+func TestStagedUpgradeGateVerify(t *testing.T) {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "apicast", Namespace: "3scale"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{UpdatedReplicas: 2, ReadyReplicas: 1},
+	}
+	completedPlan := &olmv1alpha1.InstallPlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "install-3scale", Namespace: "3scale-operator"},
+		Status:     olmv1alpha1.InstallPlanStatus{Phase: olmv1alpha1.InstallPlanPhaseComplete},
+	}
+
+	scheme := buildScheme()
+	_ = appsv1.AddToScheme(scheme)
+
+	cases := []struct {
+		Name           string
+		ApprovedAt     time.Time
+		Ready          int32
+		ExpectCurrent  bool
+		ExpectPhase    integreatlyv1alpha1.ProductUpgradePhase
+		ExpectDegraded bool
+	}{
+		{
+			Name:          "test upgrade is verified while the product is not healthy",
+			ApprovedAt:    time.Now().Add(-time.Minute),
+			Ready:         1,
+			ExpectCurrent: true,
+			ExpectPhase:   integreatlyv1alpha1.ProductUpgradeVerifying,
+		},
+		{
+			Name:           "test upgrade fails when the product is not healthy after the timeout",
+			ApprovedAt:     time.Now().Add(-time.Hour),
+			Ready:          1,
+			ExpectCurrent:  true,
+			ExpectPhase:    integreatlyv1alpha1.ProductUpgradeFailed,
+			ExpectDegraded: true,
+		},
+		{
+			Name:       "test next upgrade can be approved when the product is healthy",
+			ApprovedAt: time.Now().Add(-time.Hour),
+			Ready:      2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := deployment.DeepCopy()
+			d.Status.ReadyReplicas = tc.Ready
+			client := fake.NewFakeClientWithScheme(scheme, d, completedPlan.DeepCopy())
+
+			gate := buildStagedUpgradeGate()
+			gate.current = &integreatlyv1alpha1.ProductUpgrade{
+				Product:     integreatlyv1alpha1.Product3Scale,
+				InstallPlan: "install-3scale",
+				Namespace:   "3scale-operator",
+				ApprovedAt:  metav1.NewTime(tc.ApprovedAt),
+				Phase:       integreatlyv1alpha1.ProductUpgradeVerifying,
+			}
+
+			if err := gate.verify(context.TODO(), client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.ExpectCurrent != (gate.current != nil) {
+				t.Fatalf("expected current upgrade: %v, got %v", tc.ExpectCurrent, gate.current)
+			}
+			if gate.current != nil && gate.current.Phase != tc.ExpectPhase {
+				t.Fatalf("expected phase %s, got %s", tc.ExpectPhase, gate.current.Phase)
+			}
+
+			installation := &integreatlyv1alpha1.RHMI{}
+			gate.apply(installation)
+			setInstallationConditions(installation, false)
+			degraded := integreatlyv1alpha1.IsConditionTrue(installation.Status.Conditions, integreatlyv1alpha1.ConditionDegraded)
+			if degraded != tc.ExpectDegraded {
+				t.Fatalf("expected degraded: %v, got %v", tc.ExpectDegraded, degraded)
+			}
+		})
+	}
+}
+
+// fakePrometheusAPI returns the samples of the probes of the blackbox targets
+type fakePrometheusAPI struct {
+	prometheusv1.API
+	probes map[string]float64
+}
+
+func (a fakePrometheusAPI) Query(ctx context.Context, query string, ts time.Time) (model.Value, prometheusv1.Warnings, error) {
+	vector := model.Vector{}
+	for service, value := range a.probes {
+		vector = append(vector, &model.Sample{
+			Metric: model.Metric{"service": model.LabelValue(service)},
+			Value:  model.SampleValue(value),
+		})
+	}
+	return vector, nil, nil
+}
+
+func TestBlackboxTargetsUp(t *testing.T) {
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "3scale"},
+		Spec:       routev1.RouteSpec{Host: "3scale-admin.apps.example.com"},
+	}
+	blackboxTarget := &applicationmonitoringv1alpha1.BlackboxTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "integreatly-3scale-admin-ui", Namespace: "monitoring"},
+		Spec: applicationmonitoringv1alpha1.BlackboxTargetSpec{
+			BlackboxTargets: []applicationmonitoringv1alpha1.BlackboxtargetData{
+				{Service: "3scale-admin-ui", Url: "https://3scale-admin.apps.example.com/p/login"},
+			},
+		},
+	}
+	otherBlackboxTarget := &applicationmonitoringv1alpha1.BlackboxTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "integreatly-rhsso", Namespace: "monitoring"},
+		Spec: applicationmonitoringv1alpha1.BlackboxTargetSpec{
+			BlackboxTargets: []applicationmonitoringv1alpha1.BlackboxtargetData{
+				{Service: "rhsso-ui", Url: "https://keycloak.apps.example.com/auth"},
+			},
+		},
+	}
+
+	scheme := buildScheme()
+	_ = routev1.AddToScheme(scheme)
+	_ = applicationmonitoringv1alpha1.SchemeBuilder.AddToScheme(scheme)
+
+	defer func(original func(string) (prometheusv1.API, error)) { newPrometheusAPI = original }(newPrometheusAPI)
+
+	cases := []struct {
+		Name          string
+		Probes        map[string]float64
+		ExpectHealthy bool
+	}{
+		{
+			Name:          "test product is healthy when its blackbox targets are up",
+			Probes:        map[string]float64{"3scale-admin-ui": 1},
+			ExpectHealthy: true,
+		},
+		{
+			Name:   "test product is not healthy when one of its blackbox targets is down",
+			Probes: map[string]float64{"3scale-admin-ui": 0},
+		},
+		{
+			Name: "test product is not healthy while its blackbox targets are not probed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			newPrometheusAPI = func(address string) (prometheusv1.API, error) {
+				return fakePrometheusAPI{probes: tc.Probes}, nil
+			}
+			client := fake.NewFakeClientWithScheme(scheme, route.DeepCopy(), blackboxTarget.DeepCopy(), otherBlackboxTarget.DeepCopy())
+			target := productHealthTarget{namespaces: []string{"3scale-operator", "3scale"}, monitoringNamespace: "monitoring"}
+
+			healthy, message, err := blackboxTargetsUp(context.TODO(), client, target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if healthy != tc.ExpectHealthy {
+				t.Fatalf("expected healthy: %v, got %v: %s", tc.ExpectHealthy, healthy, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package installation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DefaultInstallationProfilesConfigMapName = "installation-profiles"
+	installationProfilesEnvName              = "INSTALLATION_PROFILES_CONFIG_MAP"
+
+	// InstallationProfileVersion is the only schema version of the installation profiles currently supported
+	InstallationProfileVersion = "v1"
+)
+
+// InstallationProfile declares the install and uninstall stages of an installation type. Profiles are stored
+// as yaml in the installation profiles ConfigMap, keyed by the installation type they define:
+//
+//	data:
+//	  custom-managed: |
+//	    version: v1
+//	    installStages:
+//	    - name: bootstrap
+//	    - name: cloud-resources
+//	      products:
+//	      - name: cloud-resources
+//	    - name: products
+//	      products:
+//	      - name: 3scale
+//	        order: 1
+//	      - name: rhssouser
+//	        order: 2
+//	    uninstallStages:
+//	    - name: uninstall - products
+//	      products:
+//	      - name: 3scale
+//	      - name: rhssouser
+type InstallationProfile struct {
+	Version         string         `yaml:"version"`
+	InstallStages   []ProfileStage `yaml:"installStages"`
+	UninstallStages []ProfileStage `yaml:"uninstallStages"`
+}
+
+type ProfileStage struct {
+	Name     integreatlyv1alpha1.StageName `yaml:"name"`
+	Products []ProfileProduct              `yaml:"products,omitempty"`
+}
+
+type ProfileProduct struct {
+	Name integreatlyv1alpha1.ProductName `yaml:"name"`
+
+	// Order is the position of the product within its stage. Products with the same order
+	// are reconciled in the order they are declared in
+	Order int `yaml:"order,omitempty"`
+}
+
+func getInstallationProfilesConfigMapName() string {
+	if name := os.Getenv(installationProfilesEnvName); name != "" {
+		return name
+	}
+	return DefaultInstallationProfilesConfigMapName
+}
+
+// getInstallationProfile reads the profile for the installation type from the installation profiles ConfigMap.
+// nil is returned when either the ConfigMap or the profile for the type does not exist
+func getInstallationProfile(ctx context.Context, client k8sclient.Client, namespace, installationType string) (*InstallationProfile, error) {
+	cfgMap := &corev1.ConfigMap{}
+	err := client.Get(ctx, k8sclient.ObjectKey{Name: getInstallationProfilesConfigMapName(), Namespace: namespace}, cfgMap)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get installation profiles config map: %w", err)
+	}
+
+	rawProfile, ok := cfgMap.Data[installationType]
+	if !ok {
+		return nil, nil
+	}
+
+	return ParseInstallationProfile(rawProfile)
+}
+
+// ParseInstallationProfile decodes and validates a yaml encoded installation profile
+func ParseInstallationProfile(rawProfile string) (*InstallationProfile, error) {
+	profile := &InstallationProfile{}
+	if err := yaml.UnmarshalStrict([]byte(rawProfile), profile); err != nil {
+		return nil, fmt.Errorf("failed to decode installation profile: %w", err)
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid installation profile: %w", err)
+	}
+	return profile, nil
+}
+
+// Validate checks that the profile has a supported version, at least one install stage,
+// uniquely named stages and only products registered with the products package
+func (p *InstallationProfile) Validate() error {
+	if p.Version != InstallationProfileVersion {
+		return fmt.Errorf("unsupported version %q, expected %q", p.Version, InstallationProfileVersion)
+	}
+	if len(p.InstallStages) == 0 {
+		return fmt.Errorf("at least one install stage must be declared")
+	}
+	for i, stage := range p.InstallStages {
+		if stage.Name == integreatlyv1alpha1.BootstrapStage && i != 0 {
+			return fmt.Errorf("the %s stage must be the first install stage", integreatlyv1alpha1.BootstrapStage)
+		}
+		if stage.Name == integreatlyv1alpha1.BootstrapStage && len(stage.Products) != 0 {
+			return fmt.Errorf("the %s stage can not contain products", integreatlyv1alpha1.BootstrapStage)
+		}
+	}
+	if err := validateProfileStages(p.InstallStages); err != nil {
+		return fmt.Errorf("install stages: %w", err)
+	}
+	if err := validateProfileStages(p.UninstallStages); err != nil {
+		return fmt.Errorf("uninstall stages: %w", err)
+	}
+	return nil
+}
+
+func validateProfileStages(stages []ProfileStage) error {
+	stageNames := map[integreatlyv1alpha1.StageName]bool{}
+	for _, stage := range stages {
+		if stage.Name == "" {
+			return fmt.Errorf("stage name can not be empty")
+		}
+		if stageNames[stage.Name] {
+			return fmt.Errorf("stage %s is declared more than once", stage.Name)
+		}
+		stageNames[stage.Name] = true
+
+		productNames := map[integreatlyv1alpha1.ProductName]bool{}
+		for _, product := range stage.Products {
+			if !products.IsRegistered(product.Name) {
+				return fmt.Errorf("unknown product %q in stage %s", product.Name, stage.Name)
+			}
+			if productNames[product.Name] {
+				return fmt.Errorf("product %s is declared more than once in stage %s", product.Name, stage.Name)
+			}
+			productNames[product.Name] = true
+		}
+	}
+	return nil
+}
+
+func (p *InstallationProfile) toType() *Type {
+	return &Type{
+		InstallStages:   profileStagesToStages(p.InstallStages),
+		UninstallStages: profileStagesToStages(p.UninstallStages),
+	}
+}
+
+func profileStagesToStages(profileStages []ProfileStage) []Stage {
+	stages := make([]Stage, 0, len(profileStages))
+	for _, profileStage := range profileStages {
+		stage := Stage{Name: profileStage.Name}
+
+		if len(profileStage.Products) > 0 {
+			ordered := make([]ProfileProduct, len(profileStage.Products))
+			copy(ordered, profileStage.Products)
+			sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Order < ordered[j].Order })
+
+			stage.Products = map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.RHMIProductStatus{}
+			for _, product := range ordered {
+				stage.Products[product.Name] = integreatlyv1alpha1.RHMIProductStatus{Name: product.Name}
+				stage.ProductOrder = append(stage.ProductOrder, product.Name)
+			}
+		}
+		stages = append(stages, stage)
+	}
+	return stages
+}
@@ -0,0 +1,144 @@
+package installation
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const customProfile = `
+version: v1
+installStages:
+- name: bootstrap
+- name: cloud-resources
+  products:
+  - name: cloud-resources
+- name: products
+  products:
+  - name: rhssouser
+    order: 2
+  - name: 3scale
+    order: 1
+  - name: grafana
+    order: 2
+uninstallStages:
+- name: uninstall - products
+  products:
+  - name: 3scale
+  - name: rhssouser
+  - name: grafana
+`
+
+func TestParseInstallationProfile(t *testing.T) {
+	scenarios := []struct {
+		Name          string
+		Profile       string
+		ExpectedError string
+	}{
+		{
+			Name:    "valid profile",
+			Profile: customProfile,
+		},
+		{
+			Name:          "unsupported version",
+			Profile:       "version: v2\ninstallStages:\n- name: bootstrap\n",
+			ExpectedError: "unsupported version",
+		},
+		{
+			Name:          "no install stages",
+			Profile:       "version: v1\n",
+			ExpectedError: "at least one install stage",
+		},
+		{
+			Name:          "bootstrap not first",
+			Profile:       "version: v1\ninstallStages:\n- name: products\n- name: bootstrap\n",
+			ExpectedError: "must be the first install stage",
+		},
+		{
+			Name:          "duplicate stage",
+			Profile:       "version: v1\ninstallStages:\n- name: products\n- name: products\n",
+			ExpectedError: "declared more than once",
+		},
+		{
+			Name:          "unknown product",
+			Profile:       "version: v1\ninstallStages:\n- name: products\n  products:\n  - name: not-a-product\n",
+			ExpectedError: "unknown product",
+		},
+		{
+			Name:          "unknown field",
+			Profile:       "version: v1\ninstallStages:\n- name: products\n  prodcts: []\n",
+			ExpectedError: "failed to decode",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			_, err := ParseInstallationProfile(scenario.Profile)
+			if scenario.ExpectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if scenario.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), scenario.ExpectedError)) {
+				t.Fatalf("expected error containing %q, got %v", scenario.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestTypeFactory(t *testing.T) {
+	profiles := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultInstallationProfilesConfigMapName,
+			Namespace: defaultNamespace,
+		},
+		Data: map[string]string{
+			"custom": customProfile,
+		},
+	}
+
+	t.Run("built-in type without profiles config map", func(t *testing.T) {
+		installType, err := TypeFactory(context.TODO(), fake.NewFakeClientWithScheme(buildScheme()), defaultNamespace, string(integreatlyv1alpha1.InstallationTypeManaged))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if installType != allManagedStages {
+			t.Fatalf("expected the built-in managed type")
+		}
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := TypeFactory(context.TODO(), fake.NewFakeClientWithScheme(buildScheme(), profiles), defaultNamespace, "unknown")
+		if err == nil {
+			t.Fatalf("expected error for unknown installation type")
+		}
+	})
+
+	t.Run("type declared in profiles config map", func(t *testing.T) {
+		installType, err := TypeFactory(context.TODO(), fake.NewFakeClientWithScheme(buildScheme(), profiles), defaultNamespace, "custom")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(installType.GetInstallStages()) != 3 || len(installType.GetUninstallStages()) != 1 {
+			t.Fatalf("unexpected stages: %v", installType)
+		}
+		if !installType.HasProduct(string(integreatlyv1alpha1.Product3Scale)) {
+			t.Fatalf("expected custom type to contain 3scale")
+		}
+
+		productsStage := installType.GetInstallStages()[2]
+		expectedOrder := []integreatlyv1alpha1.ProductName{
+			integreatlyv1alpha1.Product3Scale,
+			integreatlyv1alpha1.ProductRHSSOUser,
+			integreatlyv1alpha1.ProductGrafana,
+		}
+		if !reflect.DeepEqual(productsStage.GetProductOrder(), expectedOrder) {
+			t.Fatalf("expected product order %v, got %v", expectedOrder, productsStage.GetProductOrder())
+		}
+	})
+}
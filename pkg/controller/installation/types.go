@@ -1,16 +1,22 @@
 package installation
 
 import (
+	"context"
 	"errors"
+	"sort"
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Stage struct {
-	Products map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.RHMIProductStatus
-	Name     integreatlyv1alpha1.StageName
+	Products     map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.RHMIProductStatus
+	ProductOrder []integreatlyv1alpha1.ProductName
+	Name         integreatlyv1alpha1.StageName
 }
 
+// The built-in installation types. These are used when the installation profiles ConfigMap
+// does not declare a profile for the requested type
 var (
 	allManagedApiStages = &Type{
 		[]Stage{
@@ -306,6 +312,11 @@ type Type struct {
 }
 
 func (t *Type) HasProduct(product string) bool {
+	for _, stage := range t.InstallStages {
+		if _, ok := stage.Products[integreatlyv1alpha1.ProductName(product)]; ok {
+			return true
+		}
+	}
 	return false
 }
 
@@ -319,8 +330,35 @@ func (t *Type) GetUninstallStages() []Stage {
 	return t.UninstallStages
 }
 
-func TypeFactory(installationType string) (*Type, error) {
-	//TODO: export this logic to a configmap for each installation type
+// GetProductOrder returns the names of the products in the stage in the order they should be reconciled.
+// Stages loaded from an installation profile keep the order declared in the profile, the built-in stages
+// are sorted by product name so that the order is stable between reconciles
+func (s *Stage) GetProductOrder() []integreatlyv1alpha1.ProductName {
+	if len(s.ProductOrder) == len(s.Products) {
+		return s.ProductOrder
+	}
+	order := make([]integreatlyv1alpha1.ProductName, 0, len(s.Products))
+	for name := range s.Products {
+		order = append(order, name)
+	}
+	sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+	return order
+}
+
+// TypeFactory resolves the installation type against the installation profiles ConfigMap in the given namespace.
+// If the ConfigMap does not declare a profile for the type, one of the built-in types is returned
+func TypeFactory(ctx context.Context, client k8sclient.Client, namespace string, installationType string) (*Type, error) {
+	profile, err := getInstallationProfile(ctx, client, namespace, installationType)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return profile.toType(), nil
+	}
+	return builtInTypeFactory(installationType)
+}
+
+func builtInTypeFactory(installationType string) (*Type, error) {
 	switch installationType {
 	case string(integreatlyv1alpha1.InstallationTypeWorkshop):
 		return newWorkshopType(), nil
@@ -0,0 +1,62 @@
+package installation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// rhmiValidatingHandler validates the RHMI CRs being created against the cluster, which the validation implemented
+// by the RHMI type can not do: only one RHMI CR can exist in a namespace, and its type must be either a built-in
+// type or a type declared in the installation profiles ConfigMap
+type rhmiValidatingHandler struct {
+	client  k8sclient.Client
+	decoder *admission.Decoder
+}
+
+func NewRHMIValidatingHandler() admission.Handler {
+	return &rhmiValidatingHandler{}
+}
+
+func (h *rhmiValidatingHandler) InjectClient(c k8sclient.Client) error {
+	h.client = c
+	return nil
+}
+
+func (h *rhmiValidatingHandler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+func (h *rhmiValidatingHandler) Handle(ctx context.Context, request admission.Request) admission.Response {
+	if request.Operation != admissionv1beta1.Create {
+		return admission.Allowed("")
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{}
+	if err := h.decoder.Decode(request, installation); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	installationList := &integreatlyv1alpha1.RHMIList{}
+	if err := h.client.List(ctx, installationList, k8sclient.InNamespace(request.Namespace)); err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("could not get a list of rhmi CR: %w", err))
+	}
+	for _, existing := range installationList.Items {
+		if existing.Name != installation.Name {
+			return admission.Denied(fmt.Sprintf("rhmi CR %s already exists in %s namespace, only one rhmi CR is allowed per namespace", existing.Name, request.Namespace))
+		}
+	}
+
+	if _, err := TypeFactory(ctx, h.client, request.Namespace, installation.Spec.Type); err != nil {
+		return admission.Denied(fmt.Sprintf("invalid value of spec.Type: %v", err))
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,109 @@
+package installation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestRHMIValidatingHandler(t *testing.T) {
+	scheme := buildScheme()
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	profiles := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultInstallationProfilesConfigMapName,
+			Namespace: defaultNamespace,
+		},
+		Data: map[string]string{
+			"custom": customProfile,
+		},
+	}
+	existing := &integreatlyv1alpha1.RHMI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing",
+			Namespace: defaultNamespace,
+		},
+	}
+
+	scenarios := []struct {
+		Name         string
+		Operation    admissionv1beta1.Operation
+		Installation *integreatlyv1alpha1.RHMI
+		InitObjs     []runtime.Object
+		Allowed      bool
+	}{
+		{
+			Name:         "built-in type is allowed",
+			Operation:    admissionv1beta1.Create,
+			Installation: &integreatlyv1alpha1.RHMI{Spec: integreatlyv1alpha1.RHMISpec{Type: string(integreatlyv1alpha1.InstallationTypeManaged)}},
+			Allowed:      true,
+		},
+		{
+			Name:         "type declared in profiles config map is allowed",
+			Operation:    admissionv1beta1.Create,
+			Installation: &integreatlyv1alpha1.RHMI{Spec: integreatlyv1alpha1.RHMISpec{Type: "custom"}},
+			InitObjs:     []runtime.Object{profiles},
+			Allowed:      true,
+		},
+		{
+			Name:         "unknown type is denied",
+			Operation:    admissionv1beta1.Create,
+			Installation: &integreatlyv1alpha1.RHMI{Spec: integreatlyv1alpha1.RHMISpec{Type: "unknown"}},
+			InitObjs:     []runtime.Object{profiles},
+		},
+		{
+			Name:         "second rhmi CR in the namespace is denied",
+			Operation:    admissionv1beta1.Create,
+			Installation: &integreatlyv1alpha1.RHMI{Spec: integreatlyv1alpha1.RHMISpec{Type: string(integreatlyv1alpha1.InstallationTypeManaged)}},
+			InitObjs:     []runtime.Object{existing},
+		},
+		{
+			Name:         "updates are allowed",
+			Operation:    admissionv1beta1.Update,
+			Installation: &integreatlyv1alpha1.RHMI{Spec: integreatlyv1alpha1.RHMISpec{Type: "unknown"}},
+			InitObjs:     []runtime.Object{existing},
+			Allowed:      true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			scenario.Installation.Name = "rhmi"
+			scenario.Installation.Namespace = defaultNamespace
+			raw, err := json.Marshal(scenario.Installation)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			handler := NewRHMIValidatingHandler()
+			if _, err := admission.InjectDecoderInto(decoder, handler); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := handler.(*rhmiValidatingHandler).InjectClient(fake.NewFakeClientWithScheme(scheme, scenario.InitObjs...)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := handler.Handle(context.TODO(), admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+				Operation: scenario.Operation,
+				Namespace: defaultNamespace,
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+			if response.Allowed != scenario.Allowed {
+				t.Fatalf("expected allowed to be %t, got %t: %v", scenario.Allowed, response.Allowed, response.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package rhmibackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/controller/installation"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
+	"github.com/sirupsen/logrus"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Add creates a new RHMIBackup Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	r, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+	return add(mgr, r)
+}
+
+func newReconciler(mgr manager.Manager) (*ReconcileRHMIBackup, error) {
+	// the backups read snapshots and jobs the operator does not watch, a client that is not cached is used
+	client, err := k8sclient.New(mgr.GetConfig(), k8sclient.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		return nil, err
+	}
+	return &ReconcileRHMIBackup{
+		client:     client,
+		restConfig: mgr.GetConfig(),
+		mgr:        mgr,
+	}, nil
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	c, err := controller.New("rhmibackup-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &integreatlyv1alpha1.RHMIBackup{}}, &handler.EnqueueRequestForObject{})
+}
+
+var _ reconcile.Reconciler = &ReconcileRHMIBackup{}
+
+// backupCheckInterval is how often the backups in progress are checked
+const backupCheckInterval = 10 * time.Second
+
+// ReconcileRHMIBackup performs the backups of a RHMIBackup once, using the backup executors of the products. The
+// backups are started in a first reconcile and their completion is checked in the following ones
+
+type ReconcileRHMIBackup struct {
+	client     k8sclient.Client
+	restConfig *rest.Config
+	mgr        manager.Manager
+
+	// getBackupExecutors is overridden in tests to avoid building the product reconcilers
+	getBackupExecutors func(rhmi *integreatlyv1alpha1.RHMI, requested []integreatlyv1alpha1.ProductName) (map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor, error)
+}
+
+func (r *ReconcileRHMIBackup) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	ctx := context.TODO()
+
+	rhmiBackup := &integreatlyv1alpha1.RHMIBackup{}
+	if err := r.client.Get(ctx, request.NamespacedName, rhmiBackup); err != nil {
+		if k8serr.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	switch rhmiBackup.Status.Phase {
+	case integreatlyv1alpha1.BackupPhaseCompleted, integreatlyv1alpha1.BackupPhaseFailed:
+		return reconcile.Result{}, nil
+	}
+
+	timeout, err := time.ParseDuration(rhmiBackup.Spec.Timeout)
+	if rhmiBackup.Spec.Timeout == "" {
+		timeout, err = time.ParseDuration(integreatlyv1alpha1.DefaultBackupTimeout)
+	}
+	if err != nil {
+		return reconcile.Result{}, r.fail(ctx, rhmiBackup, fmt.Sprintf("invalid timeout %s: %v", rhmiBackup.Spec.Timeout, err))
+	}
+
+	rhmi, err := resources.GetRhmiCr(r.client, ctx, request.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if rhmi == nil {
+		return reconcile.Result{}, r.fail(ctx, rhmiBackup, fmt.Sprintf("no RHMI installation found in namespace %s", request.Namespace))
+	}
+
+	getBackupExecutors := r.getBackupExecutors
+	if getBackupExecutors == nil {
+		getBackupExecutors = r.buildBackupExecutors
+	}
+	executors, err := getBackupExecutors(rhmi, rhmiBackup.Spec.Products)
+	if err != nil {
+		return reconcile.Result{}, r.fail(ctx, rhmiBackup, err.Error())
+	}
+
+	if rhmiBackup.Status.Phase == "" {
+		logrus.Infof("Starting backup %s of %d products", rhmiBackup.Name, len(executors))
+		r.startBackups(rhmiBackup, executors)
+	} else {
+		r.checkBackups(rhmiBackup, executors, timeout)
+	}
+
+	// the backup is only completed when every product was backed up
+	failed, unsupported := []string{}, []string{}
+	for _, productBackup := range rhmiBackup.Status.Products {
+		switch productBackup.Phase {
+		case integreatlyv1alpha1.BackupPhaseInProgress:
+			// the backups are checked again later on, without blocking the reconcile while they run
+			return reconcile.Result{Requeue: true, RequeueAfter: backupCheckInterval}, r.client.Status().Update(ctx, rhmiBackup)
+		case integreatlyv1alpha1.BackupPhaseFailed:
+			failed = append(failed, string(productBackup.Product))
+		case integreatlyv1alpha1.BackupPhaseUnsupported:
+			unsupported = append(unsupported, string(productBackup.Product))
+		}
+	}
+	messages := []string{}
+	if len(failed) > 0 {
+		messages = append(messages, fmt.Sprintf("backups of %s failed", strings.Join(failed, ", ")))
+	}
+	if len(unsupported) > 0 {
+		messages = append(messages, fmt.Sprintf("backups of %s are not supported", strings.Join(unsupported, ", ")))
+	}
+	completedAt := metav1.Now()
+	rhmiBackup.Status.CompletedAt = &completedAt
+	rhmiBackup.Status.Phase = integreatlyv1alpha1.BackupPhaseCompleted
+	if len(messages) > 0 {
+		rhmiBackup.Status.Phase = integreatlyv1alpha1.BackupPhaseFailed
+		rhmiBackup.Status.Message = strings.Join(messages, ", ")
+	}
+	logrus.Infof("Backup %s is %s", rhmiBackup.Name, rhmiBackup.Status.Phase)
+	return reconcile.Result{}, r.client.Status().Update(ctx, rhmiBackup)
+}
+
+// startBackups starts the backups of the products, recording the started backups of each product in the status
+func (r *ReconcileRHMIBackup) startBackups(rhmiBackup *integreatlyv1alpha1.RHMIBackup, executors map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor) {
+	now := metav1.Now()
+	rhmiBackup.Status.Phase = integreatlyv1alpha1.BackupPhaseInProgress
+	rhmiBackup.Status.StartedAt = &now
+	rhmiBackup.Status.Products = []integreatlyv1alpha1.ProductBackupStatus{}
+	for _, product := range sortedProducts(executors) {
+		backups, err := executors[product].StartBackup(r.client)
+		rhmiBackup.Status.Products = append(rhmiBackup.Status.Products, integreatlyv1alpha1.ProductBackupStatus{
+			Product: product,
+			Phase:   integreatlyv1alpha1.BackupPhaseInProgress,
+			Backups: backups,
+		})
+		if err != nil {
+			completeProductBackup(rhmiBackup.GetProductBackup(product), err)
+		}
+	}
+}
+
+// checkBackups checks the backups of the products still in progress, failing the ones that did not complete within
+// the timeout of the backup
+func (r *ReconcileRHMIBackup) checkBackups(rhmiBackup *integreatlyv1alpha1.RHMIBackup, executors map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor, timeout time.Duration) {
+	timedOut := rhmiBackup.Status.StartedAt == nil || time.Now().After(rhmiBackup.Status.StartedAt.Add(timeout))
+	for i := range rhmiBackup.Status.Products {
+		productBackup := &rhmiBackup.Status.Products[i]
+		if productBackup.Phase != integreatlyv1alpha1.BackupPhaseInProgress {
+			continue
+		}
+		executor, ok := executors[productBackup.Product]
+		if !ok {
+			completeProductBackup(productBackup, fmt.Errorf("product %s is no longer installed", productBackup.Product))
+			continue
+		}
+		completed, err := executor.CheckBackup(r.client, productBackup.Backups)
+		if err != nil || completed {
+			completeProductBackup(productBackup, err)
+		} else if timedOut {
+			completeProductBackup(productBackup, fmt.Errorf("timed out after %s waiting for backups %v", timeout, productBackup.Backups))
+		}
+	}
+}
+
+// completeProductBackup records the outcome of the backup of a product in its status
+func completeProductBackup(productBackup *integreatlyv1alpha1.ProductBackupStatus, err error) {
+	now := metav1.Now()
+	productBackup.CompletedAt = &now
+	productBackup.Phase = integreatlyv1alpha1.BackupPhaseCompleted
+	if errors.Is(err, backup.ErrBackupUnsupported) {
+		logrus.Warnf("Backup of %s is not supported: %v", productBackup.Product, err)
+		productBackup.Phase = integreatlyv1alpha1.BackupPhaseUnsupported
+		productBackup.Message = err.Error()
+	} else if err != nil {
+		logrus.Errorf("Backup of %s failed: %v", productBackup.Product, err)
+		productBackup.Phase = integreatlyv1alpha1.BackupPhaseFailed
+		productBackup.Message = err.Error()
+	}
+}
+
+// buildBackupExecutors returns the backup executors of the requested products, or of every enabled product with data
+// to back up when no products are requested
+func (r *ReconcileRHMIBackup) buildBackupExecutors(rhmi *integreatlyv1alpha1.RHMI, requested []integreatlyv1alpha1.ProductName) (map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor, error) {
+	installType, err := installation.TypeFactory(context.TODO(), r.client, rhmi.Namespace, rhmi.Spec.Type)
+	if err != nil {
+		return nil, err
+	}
+	configManager, err := config.NewManager(context.TODO(), r.client, rhmi.Namespace, installation.GetInstallationConfigMapName(rhmi), rhmi)
+	if err != nil {
+		return nil, err
+	}
+
+	executors := map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor{}
+	for _, stage := range installType.GetInstallStages() {
+		for _, product := range stage.GetProductOrder() {
+			if !rhmi.IsProductEnabled(product) || (len(requested) > 0 && !containsProduct(requested, product)) {
+				continue
+			}
+			reconciler, err := products.NewReconciler(product, r.restConfig, configManager, rhmi, r.mgr)
+			if err != nil {
+				return nil, err
+			}
+			if backupReconciler, ok := reconciler.(products.BackupInterface); ok {
+				executor := backupReconciler.GetBackupExecutor()
+				// the snapshots taken on demand are not removed by the retention policy of the pre-upgrade snapshots
+				backup.SetBackupOrigin(executor, backup.OnDemandBackupOrigin)
+				asyncExecutor, ok := executor.(backup.AsyncBackupExecutor)
+				if !ok {
+					return nil, fmt.Errorf("backups of product %s can not be performed on demand", product)
+				}
+				executors[product] = asyncExecutor
+			} else if len(requested) > 0 {
+				return nil, fmt.Errorf("product %s has no data to back up", product)
+			}
+		}
+	}
+
+	for _, product := range requested {
+		if _, ok := executors[product]; !ok {
+			return nil, fmt.Errorf("product %s is not installed", product)
+		}
+	}
+	return executors, nil
+}
+
+func (r *ReconcileRHMIBackup) fail(ctx context.Context, rhmiBackup *integreatlyv1alpha1.RHMIBackup, message string) error {
+	logrus.Errorf("Backup %s failed: %s", rhmiBackup.Name, message)
+	now := metav1.Now()
+	rhmiBackup.Status.Phase = integreatlyv1alpha1.BackupPhaseFailed
+	rhmiBackup.Status.Message = message
+	rhmiBackup.Status.CompletedAt = &now
+	return r.client.Status().Update(ctx, rhmiBackup)
+}
+
+func sortedProducts(executors map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor) []integreatlyv1alpha1.ProductName {
+	names := make([]integreatlyv1alpha1.ProductName, 0, len(executors))
+	for name := range executors {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+func containsProduct(products []integreatlyv1alpha1.ProductName, product integreatlyv1alpha1.ProductName) bool {
+	for _, p := range products {
+		if p == product {
+			return true
+		}
+	}
+	return false
+}
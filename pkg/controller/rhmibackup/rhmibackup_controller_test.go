@@ -0,0 +1,198 @@
+package rhmibackup
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type mockBackupExecutor struct {
+	backups []string
+	err     error
+	pending bool
+}
+
+func (e mockBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error) {
+	return e.backups, e.err
+}
+
+func (e mockBackupExecutor) StartBackup(client k8sclient.Client) ([]string, error) {
+	return e.backups, nil
+}
+
+func (e mockBackupExecutor) CheckBackup(client k8sclient.Client, backups []string) (bool, error) {
+	return !e.pending, e.err
+}
+
+func TestReconcileRHMIBackup(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = integreatlyv1alpha1.SchemeBuilder.AddToScheme(scheme)
+
+	rhmi := &integreatlyv1alpha1.RHMI{ObjectMeta: metav1.ObjectMeta{Name: "rhmi", Namespace: "redhat-rhmi-operator"}}
+
+	cases := []struct {
+		Name           string
+		Backup         *integreatlyv1alpha1.RHMIBackup
+		Executors      map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor
+		ExecutorsErr   error
+		ExpectPhase    integreatlyv1alpha1.BackupPhase
+		ExpectRequeue  bool
+		ExpectProducts map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.BackupPhase
+	}{
+		{
+			Name: "test backups of every product are recorded",
+			Executors: map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor{
+				integreatlyv1alpha1.Product3Scale: mockBackupExecutor{backups: []string{"3scale-redis", "3scale-postgres"}},
+				integreatlyv1alpha1.ProductRHSSO:  mockBackupExecutor{backups: []string{"rhsso-postgres"}},
+			},
+			ExpectPhase: integreatlyv1alpha1.BackupPhaseCompleted,
+			ExpectProducts: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.BackupPhase{
+				integreatlyv1alpha1.Product3Scale: integreatlyv1alpha1.BackupPhaseCompleted,
+				integreatlyv1alpha1.ProductRHSSO:  integreatlyv1alpha1.BackupPhaseCompleted,
+			},
+		},
+		{
+			Name: "test backup fails when the backup of a product fails",
+			Executors: map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor{
+				integreatlyv1alpha1.Product3Scale: mockBackupExecutor{err: fmt.Errorf("snapshot failed")},
+				integreatlyv1alpha1.ProductRHSSO:  mockBackupExecutor{backups: []string{"rhsso-postgres"}},
+			},
+			ExpectPhase: integreatlyv1alpha1.BackupPhaseFailed,
+			ExpectProducts: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.BackupPhase{
+				integreatlyv1alpha1.Product3Scale: integreatlyv1alpha1.BackupPhaseFailed,
+				integreatlyv1alpha1.ProductRHSSO:  integreatlyv1alpha1.BackupPhaseCompleted,
+			},
+		},
+		{
+			Name: "test backup fails when the backup of a product is not supported",
+			Executors: map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor{
+				integreatlyv1alpha1.Product3Scale: backup.NewUnsupportedBackupExecutor(backup.ClusterStorageUnsupportedReason).(backup.AsyncBackupExecutor),
+				integreatlyv1alpha1.ProductRHSSO:  mockBackupExecutor{backups: []string{"rhsso-postgres"}},
+			},
+			ExpectPhase: integreatlyv1alpha1.BackupPhaseFailed,
+			ExpectProducts: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.BackupPhase{
+				integreatlyv1alpha1.Product3Scale: integreatlyv1alpha1.BackupPhaseUnsupported,
+				integreatlyv1alpha1.ProductRHSSO:  integreatlyv1alpha1.BackupPhaseCompleted,
+			},
+		},
+		{
+			Name:         "test backup fails when a requested product can not be backed up",
+			ExecutorsErr: fmt.Errorf("product amqonline is not installed"),
+			ExpectPhase:  integreatlyv1alpha1.BackupPhaseFailed,
+		},
+		{
+			Name: "test backup stays in progress until the backups of every product complete",
+			Executors: map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor{
+				integreatlyv1alpha1.Product3Scale: mockBackupExecutor{backups: []string{"3scale-redis"}, pending: true},
+				integreatlyv1alpha1.ProductRHSSO:  mockBackupExecutor{backups: []string{"rhsso-postgres"}},
+			},
+			ExpectPhase:   integreatlyv1alpha1.BackupPhaseInProgress,
+			ExpectRequeue: true,
+			ExpectProducts: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.BackupPhase{
+				integreatlyv1alpha1.Product3Scale: integreatlyv1alpha1.BackupPhaseInProgress,
+				integreatlyv1alpha1.ProductRHSSO:  integreatlyv1alpha1.BackupPhaseCompleted,
+			},
+		},
+		{
+			Name: "test backup in progress is resumed after a restart",
+			Backup: &integreatlyv1alpha1.RHMIBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: rhmi.Namespace},
+				Status: integreatlyv1alpha1.RHMIBackupStatus{
+					Phase:     integreatlyv1alpha1.BackupPhaseInProgress,
+					StartedAt: &metav1.Time{Time: time.Now()},
+					Products: []integreatlyv1alpha1.ProductBackupStatus{
+						{Product: integreatlyv1alpha1.ProductRHSSO, Phase: integreatlyv1alpha1.BackupPhaseInProgress, Backups: []string{"rhsso-postgres"}},
+					},
+				},
+			},
+			Executors: map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor{
+				integreatlyv1alpha1.ProductRHSSO: mockBackupExecutor{},
+			},
+			ExpectPhase: integreatlyv1alpha1.BackupPhaseCompleted,
+			ExpectProducts: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.BackupPhase{
+				integreatlyv1alpha1.ProductRHSSO: integreatlyv1alpha1.BackupPhaseCompleted,
+			},
+		},
+		{
+			Name: "test backup fails when the backups do not complete within the timeout",
+			Backup: &integreatlyv1alpha1.RHMIBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: rhmi.Namespace},
+				Spec:       integreatlyv1alpha1.RHMIBackupSpec{Timeout: "1m"},
+				Status: integreatlyv1alpha1.RHMIBackupStatus{
+					Phase:     integreatlyv1alpha1.BackupPhaseInProgress,
+					StartedAt: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+					Products: []integreatlyv1alpha1.ProductBackupStatus{
+						{Product: integreatlyv1alpha1.ProductRHSSO, Phase: integreatlyv1alpha1.BackupPhaseInProgress, Backups: []string{"rhsso-postgres"}},
+					},
+				},
+			},
+			Executors: map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor{
+				integreatlyv1alpha1.ProductRHSSO: mockBackupExecutor{pending: true},
+			},
+			ExpectPhase: integreatlyv1alpha1.BackupPhaseFailed,
+			ExpectProducts: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.BackupPhase{
+				integreatlyv1alpha1.ProductRHSSO: integreatlyv1alpha1.BackupPhaseFailed,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			rhmiBackup := tc.Backup
+			if rhmiBackup == nil {
+				rhmiBackup = &integreatlyv1alpha1.RHMIBackup{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: rhmi.Namespace}}
+			}
+			client := fake.NewFakeClientWithScheme(scheme, rhmi.DeepCopy(), rhmiBackup)
+			r := &ReconcileRHMIBackup{
+				client: client,
+				getBackupExecutors: func(*integreatlyv1alpha1.RHMI, []integreatlyv1alpha1.ProductName) (map[integreatlyv1alpha1.ProductName]backup.AsyncBackupExecutor, error) {
+					return tc.Executors, tc.ExecutorsErr
+				},
+			}
+
+			// the backups are started in a first reconcile and checked in the requeued one
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "backup", Namespace: rhmi.Namespace}}
+			var res reconcile.Result
+			for i := 0; i < 2 && (i == 0 || res.Requeue); i++ {
+				var err error
+				if res, err = r.Reconcile(request); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if res.Requeue != tc.ExpectRequeue {
+				t.Fatalf("expected requeue to be %v, got %v", tc.ExpectRequeue, res.Requeue)
+			}
+
+			result := &integreatlyv1alpha1.RHMIBackup{}
+			if err := client.Get(context.TODO(), request.NamespacedName, result); err != nil {
+				t.Fatalf("failed to get backup: %v", err)
+			}
+			if result.Status.Phase != tc.ExpectPhase {
+				t.Fatalf("expected phase %s, got %s", tc.ExpectPhase, result.Status.Phase)
+			}
+			if tc.ExpectPhase != integreatlyv1alpha1.BackupPhaseInProgress && result.Status.CompletedAt == nil {
+				t.Fatalf("expected the completion time to be recorded")
+			}
+			for product, phase := range tc.ExpectProducts {
+				productBackup := result.GetProductBackup(product)
+				if productBackup == nil || productBackup.Phase != phase {
+					t.Fatalf("expected backup of %s to be %s, got %v", product, phase, productBackup)
+				}
+				if phase == integreatlyv1alpha1.BackupPhaseCompleted && len(productBackup.Backups) == 0 {
+					t.Fatalf("expected the backups of %s to be recorded", product)
+				}
+			}
+		})
+	}
+}
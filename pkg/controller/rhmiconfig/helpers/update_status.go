@@ -2,26 +2,26 @@ package helpers
 
 import (
 	"context"
-	"strconv"
-	"strings"
 	"time"
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const WINDOW = 6
-
+// UpdateStatus calculates the next maintenance window and the upgrade schedule across all of the maintenance windows
+// of the config, skipping windows that overlap a blackout period
 func UpdateStatus(ctx context.Context, client k8sclient.Client, config *integreatlyv1alpha1.RHMIConfig) error {
+	maintenance := config.Spec.Maintenance
+
 	// Calculate the next maintenance window based on the maintenance schedule
-	if config.Spec.Maintenance.ApplyFrom != "" {
-		mtStart, _, err := getWeeklyWindowFromNow(config.Spec.Maintenance.ApplyFrom, time.Hour*WINDOW)
+	if len(maintenance.GetWindows()) > 0 {
+		mtStart, mtEnd, err := maintenance.NextWindow(time.Now().UTC())
 		if err != nil {
 			return err
 		}
 
 		config.Status.Maintenance.ApplyFrom = mtStart.Format("2-1-2006 15:04")
-		config.Status.Maintenance.Duration = strconv.Itoa(WINDOW) + "hrs"
+		config.Status.Maintenance.Duration = integreatlyv1alpha1.FormatMaintenanceDuration(mtEnd.Sub(mtStart))
 	}
 
 	client.Status().Update(ctx, config)
@@ -40,66 +40,44 @@ func UpdateStatus(ctx context.Context, client k8sclient.Client, config *integrea
 
 	upgradeSchedule := config.Status.UpgradeAvailable.AvailableAt.
 		Add(daysDuration(notBeforeDays))
+	scheduled := &integreatlyv1alpha1.UpgradeSchedule{}
+
+	// A postponed upgrade is scheduled after the date it's postponed to
+	if config.Spec.Upgrade.PostponeUntil != "" {
+		postponeUntil, err := time.Parse(integreatlyv1alpha1.DateFormat, config.Spec.Upgrade.PostponeUntil)
+		if err != nil {
+			return err
+		}
+		if postponeUntil.After(upgradeSchedule) {
+			upgradeSchedule = postponeUntil
+		}
+	}
 
 	if waitForMaintenance {
+		upgradeStart, upgradeEnd, err := maintenance.NextWindow(upgradeSchedule)
+		if err != nil {
+			return err
+		}
+		upgradeSchedule = upgradeStart
+		scheduled.Duration = integreatlyv1alpha1.FormatMaintenanceDuration(upgradeEnd.Sub(upgradeStart))
+	} else {
+		// upgrades outside of the maintenance windows still respect the blackouts
 		var err error
-		upgradeSchedule, _, err = getWeeklyWindow(upgradeSchedule, config.Spec.Maintenance.ApplyFrom, time.Hour*WINDOW)
+		upgradeSchedule, err = maintenance.EndOfBlackout(upgradeSchedule)
 		if err != nil {
 			return err
 		}
 	}
+	scheduled.For = upgradeSchedule.Format(integreatlyv1alpha1.DateFormat)
 
 	// Update the upgrade status
 	config.Status.Upgrade = integreatlyv1alpha1.RHMIConfigStatusUpgrade{
-		Scheduled: &integreatlyv1alpha1.UpgradeSchedule{
-			For: upgradeSchedule.Format(integreatlyv1alpha1.DateFormat),
-		},
+		Scheduled: scheduled,
 	}
 
 	return client.Status().Update(ctx, config)
 }
 
-func getWeeklyWindow(from time.Time, windowStartStr string, duration time.Duration) (time.Time, time.Time, error) {
-	var shortDays = map[string]int{
-		"sun": 0,
-		"mon": 1,
-		"tue": 2,
-		"wed": 3,
-		"thu": 4,
-		"fri": 5,
-		"sat": 6,
-	}
-
-	windowSegments := strings.Split(windowStartStr, " ")
-	windowDay := windowSegments[0]
-
-	windowTimeSegments := strings.Split(windowSegments[1], ":")
-	windowHour, err := strconv.Atoi(windowTimeSegments[0])
-	if err != nil {
-		return time.Time{}, time.Time{}, err
-	}
-	windowMin, err := strconv.Atoi(windowTimeSegments[1])
-	if err != nil {
-		return time.Time{}, time.Time{}, err
-	}
-
-	//calculate how far away from maintenance day today is, within the current week
-	dayDiff := shortDays[strings.ToLower(windowDay)] - int(from.Weekday())
-	if dayDiff < 0 {
-		dayDiff = 7 + dayDiff
-	}
-
-	//negative days roll back the month and year, tested here: https://play.golang.org/p/gBBHw49nH1b
-	windowStart := time.Date(from.Year(), from.Month(), from.Day(), windowHour, windowMin, 0, 0, time.UTC)
-	windowStart = windowStart.Add(daysDuration(dayDiff))
-	return windowStart, windowStart.Add(duration), nil
-}
-
-//windowStartStr must be in format: sun 23:00
-func getWeeklyWindowFromNow(windowStartStr string, duration time.Duration) (time.Time, time.Time, error) {
-	return getWeeklyWindow(time.Now().UTC(), windowStartStr, duration)
-}
-
 func daysDuration(numberOfDays int) time.Duration {
 	return time.Duration(numberOfDays) * 24 * time.Hour
 }
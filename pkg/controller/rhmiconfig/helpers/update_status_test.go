@@ -162,10 +162,8 @@ func TestUpdateStatus(t *testing.T) {
 				},
 			},
 			expectedSchedule: &integreatlyv1alpha1.UpgradeSchedule{
-				For: time.
-					Date(now().Year(), now().Month(), now().Day(), 0, 0, 0, 0, time.UTC).
-					AddDate(0, 0, (7-int(now().Weekday()))%7).
-					Format(integreatlyv1alpha1.DateFormat),
+				For:      nextSunday().Format(integreatlyv1alpha1.DateFormat),
+				Duration: "6hrs",
 			},
 		}),
 		makeScheduleScenario(&scheduleScenario{
@@ -192,6 +190,7 @@ func TestUpdateStatus(t *testing.T) {
 			expectedSchedule: &integreatlyv1alpha1.UpgradeSchedule{
 				For: time.Date(now().Year(), now().Month(), now().Day(), 0, 0, 0, 0, time.UTC).Add(6 * 24 * time.Hour).
 					Format(integreatlyv1alpha1.DateFormat),
+				Duration: "6hrs",
 			},
 		}),
 		makeScheduleScenario(&scheduleScenario{
@@ -218,6 +217,69 @@ func TestUpdateStatus(t *testing.T) {
 			expectedSchedule: &integreatlyv1alpha1.UpgradeSchedule{
 				For: time.Date(now().Year(), now().Month(), now().Day(), 0, 0, 0, 0, time.UTC).Add(10 * 24 * time.Hour).
 					Format(integreatlyv1alpha1.DateFormat),
+				Duration: "6hrs",
+			},
+		}),
+		makeScheduleScenario(&scheduleScenario{
+			name: "wait for maintenance, earliest of multiple windows outside of blackouts",
+			config: &integreatlyv1alpha1.RHMIConfig{
+				Spec: integreatlyv1alpha1.RHMIConfigSpec{
+					Maintenance: integreatlyv1alpha1.Maintenance{
+						ApplyFrom: strings.ToLower(today().AddDate(0, 0, 1).Format("Mon 15:04")),
+						Windows: []integreatlyv1alpha1.MaintenanceWindow{
+							{ApplyFrom: strings.ToLower(today().AddDate(0, 0, 3).Format("Mon 15:04")), Duration: "3h"},
+							{ApplyFrom: strings.ToLower(today().AddDate(0, 0, 5).Format("Mon 15:04")), Duration: "4h"},
+						},
+						Blackouts: []integreatlyv1alpha1.Blackout{
+							{
+								From: today().AddDate(0, 0, 1).Format(integreatlyv1alpha1.BlackoutDateFormat),
+								To:   today().AddDate(0, 0, 3).Format(integreatlyv1alpha1.BlackoutDateFormat),
+							},
+						},
+					},
+					Upgrade: integreatlyv1alpha1.Upgrade{
+						WaitForMaintenance: boolPtr(true),
+						NotBeforeDays:      intPtr(0),
+					},
+				},
+				Status: integreatlyv1alpha1.RHMIConfigStatus{
+					UpgradeAvailable: &integreatlyv1alpha1.UpgradeAvailable{
+						TargetVersion: targetVersion,
+						AvailableAt:   metav1.NewTime(today()),
+					},
+				},
+			},
+			expectedSchedule: &integreatlyv1alpha1.UpgradeSchedule{
+				For:      today().AddDate(0, 0, 5).Format(integreatlyv1alpha1.DateFormat),
+				Duration: "4hrs",
+			},
+		}),
+		makeScheduleScenario(&scheduleScenario{
+			name: "do not wait for maintenance, upgrade after blackout",
+			config: &integreatlyv1alpha1.RHMIConfig{
+				Spec: integreatlyv1alpha1.RHMIConfigSpec{
+					Maintenance: integreatlyv1alpha1.Maintenance{
+						Blackouts: []integreatlyv1alpha1.Blackout{
+							{
+								From: today().Format(integreatlyv1alpha1.BlackoutDateFormat),
+								To:   today().AddDate(0, 0, 1).Format(integreatlyv1alpha1.BlackoutDateFormat),
+							},
+						},
+					},
+					Upgrade: integreatlyv1alpha1.Upgrade{
+						NotBeforeDays:      intPtr(0),
+						WaitForMaintenance: boolPtr(false),
+					},
+				},
+				Status: integreatlyv1alpha1.RHMIConfigStatus{
+					UpgradeAvailable: &integreatlyv1alpha1.UpgradeAvailable{
+						TargetVersion: targetVersion,
+						AvailableAt:   kubeNow(0),
+					},
+				},
+			},
+			expectedSchedule: &integreatlyv1alpha1.UpgradeSchedule{
+				For: today().AddDate(0, 0, 2).Format(integreatlyv1alpha1.DateFormat),
 			},
 		}),
 		makeScheduleScenario(&scheduleScenario{
@@ -240,6 +302,52 @@ func TestUpdateStatus(t *testing.T) {
 				For: nowOffset(-2).Add(3 * 24 * time.Hour).Format(integreatlyv1alpha1.DateFormat),
 			},
 		}),
+		makeScheduleScenario(&scheduleScenario{
+			name: "wait for maintenance, postponed to after the next window",
+			config: &integreatlyv1alpha1.RHMIConfig{
+				Spec: integreatlyv1alpha1.RHMIConfigSpec{
+					Maintenance: integreatlyv1alpha1.Maintenance{
+						ApplyFrom: strings.ToLower(today().AddDate(0, 0, 1).Format("Mon 15:04")),
+					},
+					Upgrade: integreatlyv1alpha1.Upgrade{
+						WaitForMaintenance: boolPtr(true),
+						NotBeforeDays:      intPtr(0),
+						PostponeUntil:      today().AddDate(0, 0, 2).Format(integreatlyv1alpha1.DateFormat),
+					},
+				},
+				Status: integreatlyv1alpha1.RHMIConfigStatus{
+					UpgradeAvailable: &integreatlyv1alpha1.UpgradeAvailable{
+						TargetVersion: targetVersion,
+						AvailableAt:   metav1.NewTime(today()),
+					},
+				},
+			},
+			expectedSchedule: &integreatlyv1alpha1.UpgradeSchedule{
+				For:      today().AddDate(0, 0, 8).Format(integreatlyv1alpha1.DateFormat),
+				Duration: "6hrs",
+			},
+		}),
+		makeScheduleScenario(&scheduleScenario{
+			name: "do not wait for maintenance, postponed",
+			config: &integreatlyv1alpha1.RHMIConfig{
+				Spec: integreatlyv1alpha1.RHMIConfigSpec{
+					Upgrade: integreatlyv1alpha1.Upgrade{
+						NotBeforeDays:      intPtr(0),
+						WaitForMaintenance: boolPtr(false),
+						PostponeUntil:      today().AddDate(0, 0, 4).Format(integreatlyv1alpha1.DateFormat),
+					},
+				},
+				Status: integreatlyv1alpha1.RHMIConfigStatus{
+					UpgradeAvailable: &integreatlyv1alpha1.UpgradeAvailable{
+						TargetVersion: targetVersion,
+						AvailableAt:   kubeNow(0),
+					},
+				},
+			},
+			expectedSchedule: &integreatlyv1alpha1.UpgradeSchedule{
+				For: today().AddDate(0, 0, 4).Format(integreatlyv1alpha1.DateFormat),
+			},
+		}),
 	}
 
 	for _, scenario := range scenarios {
@@ -253,43 +361,6 @@ func TestUpdateStatus(t *testing.T) {
 	}
 }
 
-func TestGetWeeklyWindow(t *testing.T) {
-	// Monday
-	from := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
-
-	// Test same day
-	r, _, err := getWeeklyWindow(from, "Mon 00:00", time.Hour)
-	if err != nil {
-		t.Errorf("Error calculating weekly window for same day: %v", err)
-	} else if r.Day() != from.Day() || r.Month() != from.Month() || r.Year() != from.Year() {
-		t.Errorf("Expected result to be same day, got %s", r.Format(integreatlyv1alpha1.DateFormat))
-	}
-
-	// Test next day
-	r, _, err = getWeeklyWindow(from, "Tue 00:00", time.Hour)
-	if err != nil {
-		t.Errorf("Error calculating weekly window for same day: %v", err)
-	} else if r.Day() != from.Day()+1 || r.Month() != from.Month() || r.Year() != from.Year() {
-		t.Errorf("Expected result to be next day, got %s", r.Format(integreatlyv1alpha1.DateFormat))
-	}
-
-	// Test day before
-	r, _, err = getWeeklyWindow(from, "SuN 00:00", time.Hour)
-	if err != nil {
-		t.Errorf("Error calculating weekly window for same day: %v", err)
-	} else if r.Day() != from.Day()+6 || r.Month() != from.Month() || r.Year() != from.Year() {
-		t.Errorf("Expected result to be next Sunday, got %s", r.Format(integreatlyv1alpha1.DateFormat))
-	}
-
-	// Test 3 days after
-	r, _, err = getWeeklyWindow(from, "Thu 02:00", time.Hour)
-	if err != nil {
-		t.Errorf("Error calculating weekly window for same day: %v", err)
-	} else if r.Day() != from.Day()+3 || r.Month() != from.Month() || r.Year() != from.Year() {
-		t.Errorf("Expected result to be Thursday, got %s", r.Format(integreatlyv1alpha1.DateFormat))
-	}
-}
-
 func buildScheme() *runtime.Scheme {
 	scheme := runtime.NewScheme()
 
@@ -310,6 +381,19 @@ func kubeNow(addHours int) metav1.Time {
 	return t
 }
 
+func today() time.Time {
+	return time.Date(now().Year(), now().Month(), now().Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// nextSunday returns the start of the Sunday 00:00 maintenance window that has not ended yet
+func nextSunday() time.Time {
+	sunday := today().AddDate(0, 0, (7-int(now().Weekday()))%7)
+	if !sunday.Add(integreatlyv1alpha1.DefaultMaintenanceDuration).After(now()) {
+		sunday = sunday.AddDate(0, 0, 7)
+	}
+	return sunday
+}
+
 func now() time.Time {
 	return time.Now().UTC()
 }
@@ -27,6 +27,8 @@ import (
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/integreatly-operator/pkg/controller/rhmiconfig/helpers"
+	"github.com/integr8ly/integreatly-operator/pkg/metrics"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
 	k8sErr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -141,12 +143,29 @@ func (r *ReconcileRHMIConfig) Reconcile(request reconcile.Request) (reconcile.Re
 	// ensure values are as expected
 	if err := r.reconcileValues(rhmiConfig, reconcileBackupAndMaintenanceValues, reconcileUpgradeValues); err != nil {
 		logrus.Errorf("failed to reconcile rhmi config values : %v", err)
-		return retryRequeue, err
+		return retryRequeue, r.updateConditions(rhmiConfig, err)
 	}
 
 	// create cloud resource operator override config map
 	if err := r.ReconcileCloudResourceStrategies(rhmiConfig); err != nil {
 		logrus.Errorf("rhmi config failure while reconciling cloud resource strategies : %v", err)
+		return retryRequeue, r.updateConditions(rhmiConfig, err)
+	}
+
+	// remove the pre-upgrade snapshots not retained by the backup retention policy, a failure to remove them does not
+	// affect the config
+	if err := r.pruneSnapshots(rhmiConfig); err != nil {
+		logrus.Errorf("rhmi config failure while pruning pre-upgrade snapshots : %v", err)
+	}
+
+	// the overrides are applied by the products as they reconcile their alerts, the config reports the ones that do
+	// not apply to a built-in alert
+	if err := validateAlertOverrides(rhmiConfig); err != nil {
+		logrus.Errorf("rhmi config failure while validating alert overrides : %v", err)
+		return retryRequeue, r.updateConditions(rhmiConfig, err)
+	}
+
+	if err := r.updateConditions(rhmiConfig, nil); err != nil {
 		return retryRequeue, err
 	}
 
@@ -154,6 +173,49 @@ func (r *ReconcileRHMIConfig) Reconcile(request reconcile.Request) (reconcile.Re
 	return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Minute}, nil
 }
 
+// updateConditions sets the Available, Degraded and Upgrading conditions of the config from the outcome of the
+// reconcile, updating the status when they change. The reconcile error is returned so it can be requeued
+func (r *ReconcileRHMIConfig) updateConditions(rhmiConfig *integreatlyv1alpha1.RHMIConfig, reconcileErr error) error {
+	changed := false
+	if reconcileErr != nil {
+		changed = rhmiConfig.SetCondition(integreatlyv1alpha1.ConditionAvailable, false, "ReconcileFailed", reconcileErr.Error()) || changed
+		changed = rhmiConfig.SetCondition(integreatlyv1alpha1.ConditionDegraded, true, "ReconcileFailed", reconcileErr.Error()) || changed
+	} else {
+		changed = rhmiConfig.SetCondition(integreatlyv1alpha1.ConditionAvailable, true, "ConfigReconciled", "") || changed
+		changed = rhmiConfig.SetCondition(integreatlyv1alpha1.ConditionDegraded, false, "NoErrors", "") || changed
+	}
+
+	// the config is only upgrading once the upgrade was approved, an upgrade waiting for its approval is reported in
+	// the reason of the condition
+	if upgrade := rhmiConfig.GetUpgradeInProgress(); upgrade != nil {
+		message := fmt.Sprintf("upgrading to %s", upgrade.ToVersion)
+		if upgrade.FromVersion != "" {
+			message = fmt.Sprintf("upgrading from %s to %s", upgrade.FromVersion, upgrade.ToVersion)
+		}
+		changed = rhmiConfig.SetCondition(integreatlyv1alpha1.ConditionUpgrading, true, "UpgradeInProgress", message) || changed
+	} else if upgrade := rhmiConfig.Status.UpgradeAvailable; upgrade != nil {
+		message := fmt.Sprintf("upgrade to %s is available", upgrade.TargetVersion)
+		reason := "UpgradeAvailable"
+		if scheduled := rhmiConfig.Status.Upgrade.Scheduled; scheduled != nil {
+			message = fmt.Sprintf("upgrade to %s is scheduled for %s", upgrade.TargetVersion, scheduled.For)
+			reason = "UpgradeScheduled"
+		}
+		changed = rhmiConfig.SetCondition(integreatlyv1alpha1.ConditionUpgrading, false, reason, message) || changed
+	} else {
+		changed = rhmiConfig.SetCondition(integreatlyv1alpha1.ConditionUpgrading, false, "NoUpgradeInProgress", "") || changed
+	}
+
+	if changed {
+		if err := r.client.Status().Update(r.context, rhmiConfig); err != nil {
+			if reconcileErr != nil {
+				return reconcileErr
+			}
+			return fmt.Errorf("failed to update rhmi config conditions : %v", err)
+		}
+	}
+	return reconcileErr
+}
+
 // reconciles cloud resource strategies, setting backup and maintenance values for postgres and redis instances
 func (r *ReconcileRHMIConfig) ReconcileCloudResourceStrategies(config *integreatlyv1alpha1.RHMIConfig) error {
 	logrus.Info("reconciling cloud resource maintenance strategies")
@@ -183,6 +245,36 @@ func (r *ReconcileRHMIConfig) ReconcileCloudResourceStrategies(config *integreat
 	return nil
 }
 
+// pruneSnapshots removes the pre-upgrade snapshots of the cloud resources that are not retained by the backup
+// retention policy of the config. The snapshots are kept when no policy is set
+func (r *ReconcileRHMIConfig) pruneSnapshots(config *integreatlyv1alpha1.RHMIConfig) error {
+	retention := config.Spec.Backup.Retention
+	if retention == nil {
+		return nil
+	}
+	if err := integreatlyv1alpha1.ValidateBackupRetention(retention); err != nil {
+		return err
+	}
+
+	policy := backup.RetentionPolicy{}
+	if retention.KeepLast != nil {
+		policy.KeepLast = *retention.KeepLast
+	}
+	if retention.OlderThan != "" {
+		// the duration was validated
+		policy.OlderThan, _ = time.ParseDuration(retention.OlderThan)
+	}
+
+	results, err := backup.PruneSnapshots(r.context, r.client, config.Namespace, policy, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		metrics.SetBackupSnapshots(string(result.SnapshotType), result.ResourceName, result.Retained, result.Pruned)
+	}
+	return nil
+}
+
 // we require that blank applyOn and applyFrom values be set to defaults
 // we expect a user to set their own times, but in the case where times are not set
 // we set our maintenance applyFrom values to be Thu 02:00
@@ -217,3 +309,8 @@ func (r *ReconcileRHMIConfig) reconcileValues(rhmiConfig *integreatlyv1alpha1.RH
 
 	return nil
 }
+
+// validateAlertOverrides ensures that the alert overrides are valid and apply to the built-in alerts
+func validateAlertOverrides(config *integreatlyv1alpha1.RHMIConfig) error {
+	return integreatlyv1alpha1.ValidateAlertOverrides(config.Spec.Alerts.Overrides)
+}
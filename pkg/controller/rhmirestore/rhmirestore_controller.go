@@ -0,0 +1,346 @@
+package rhmirestore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/controller/installation"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/sirupsen/logrus"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const defaultRestoreTimeout = 30 * time.Minute
+
+// Add creates a new RHMIRestore Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	r, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+	return add(mgr, r)
+}
+
+func newReconciler(mgr manager.Manager) (*ReconcileRHMIRestore, error) {
+	// the restore scales workloads in the product namespaces the operator does not watch, a client that is not
+	// cached is used
+	client, err := k8sclient.New(mgr.GetConfig(), k8sclient.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		return nil, err
+	}
+	r := &ReconcileRHMIRestore{client: client, restConfig: mgr.GetConfig(), mgr: mgr}
+	r.getNamespaces = r.getProductNamespaces
+	r.getRestoreJob = r.getProductRestoreJob
+	return r, nil
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	c, err := controller.New("rhmirestore-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Kind{Type: &integreatlyv1alpha1.RHMIRestore{}}, &handler.EnqueueRequestForObject{})
+}
+
+var _ reconcile.Reconciler = &ReconcileRHMIRestore{}
+
+// ReconcileRHMIRestore restores a product from a RHMIBackup. The product operator and the workloads of the product
+// are scaled down, the restore job is run and the workloads are scaled back up, even when the restore job failed
+type ReconcileRHMIRestore struct {
+	client     k8sclient.Client
+	restConfig *rest.Config
+	mgr        manager.Manager
+	// getNamespaces returns the operator and product namespaces of a product, operator namespace first
+	getNamespaces func(rhmi *integreatlyv1alpha1.RHMI, product integreatlyv1alpha1.ProductName) ([]string, error)
+	// getRestoreJob returns the restore job of a product, used when the restore does not set a job
+	getRestoreJob func(rhmi *integreatlyv1alpha1.RHMI, product integreatlyv1alpha1.ProductName) (*batchv1.JobSpec, error)
+}
+
+func (r *ReconcileRHMIRestore) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	ctx := context.TODO()
+	retryRequeue := reconcile.Result{Requeue: true, RequeueAfter: 10 * time.Second}
+
+	restore := &integreatlyv1alpha1.RHMIRestore{}
+	if err := r.client.Get(ctx, request.NamespacedName, restore); err != nil {
+		if k8serr.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	var err error
+	switch restore.Status.Phase {
+	case integreatlyv1alpha1.RestorePhaseCompleted, integreatlyv1alpha1.RestorePhaseFailed:
+		return reconcile.Result{}, nil
+	case "":
+		err = r.start(ctx, restore)
+	case integreatlyv1alpha1.RestorePhaseScalingDown:
+		err = r.scaleDown(ctx, restore)
+	case integreatlyv1alpha1.RestorePhaseRestoring:
+		err = r.restore(ctx, restore)
+	case integreatlyv1alpha1.RestorePhaseScalingUp:
+		err = r.scaleUp(ctx, restore)
+	}
+	if err != nil {
+		return retryRequeue, err
+	}
+
+	if err := r.client.Status().Update(ctx, restore); err != nil {
+		return retryRequeue, err
+	}
+	if !restore.InProgress() {
+		logrus.Infof("Restore %s of %s is %s", restore.Name, restore.Spec.Product, restore.Status.Phase)
+		return reconcile.Result{}, nil
+	}
+	return retryRequeue, nil
+}
+
+// start validates the restore before anything is scaled down. Invalid restores fail straight away
+func (r *ReconcileRHMIRestore) start(ctx context.Context, restore *integreatlyv1alpha1.RHMIRestore) error {
+	now := metav1.Now()
+	restore.Status.StartedAt = &now
+
+	rhmiBackup := &integreatlyv1alpha1.RHMIBackup{}
+	err := r.client.Get(ctx, k8sclient.ObjectKey{Name: restore.Spec.Backup, Namespace: restore.Namespace}, rhmiBackup)
+	if k8serr.IsNotFound(err) {
+		complete(restore, integreatlyv1alpha1.RestorePhaseFailed, fmt.Sprintf("backup %s not found", restore.Spec.Backup))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	productBackup := rhmiBackup.GetProductBackup(restore.Spec.Product)
+	if productBackup == nil || productBackup.Phase != integreatlyv1alpha1.BackupPhaseCompleted {
+		complete(restore, integreatlyv1alpha1.RestorePhaseFailed, fmt.Sprintf("backup %s has no completed backup of %s", restore.Spec.Backup, restore.Spec.Product))
+		return nil
+	}
+	if _, err := getTimeout(restore); err != nil {
+		complete(restore, integreatlyv1alpha1.RestorePhaseFailed, err.Error())
+		return nil
+	}
+	if restore.Spec.Job == nil {
+		rhmi, err := resources.GetRhmiCr(r.client, ctx, restore.Namespace)
+		if err != nil {
+			return err
+		}
+		if rhmi == nil {
+			complete(restore, integreatlyv1alpha1.RestorePhaseFailed, fmt.Sprintf("no RHMI installation found in namespace %s", restore.Namespace))
+			return nil
+		}
+		if _, err := r.getRestoreJob(rhmi, restore.Spec.Product); err != nil {
+			complete(restore, integreatlyv1alpha1.RestorePhaseFailed, fmt.Sprintf("no restore job for %s, a job must be set: %v", restore.Spec.Product, err))
+			return nil
+		}
+	}
+
+	logrus.Infof("Restoring %s from backup %s", restore.Spec.Product, restore.Spec.Backup)
+	restore.Status.Phase = integreatlyv1alpha1.RestorePhaseScalingDown
+	return nil
+}
+
+// scaleDown scales the operator of the product down before the product, so the operator does not scale the product
+// back up. The replicas are recorded in the status before any workload is scaled down
+func (r *ReconcileRHMIRestore) scaleDown(ctx context.Context, restore *integreatlyv1alpha1.RHMIRestore) error {
+	rhmi, err := resources.GetRhmiCr(r.client, ctx, restore.Namespace)
+	if err != nil {
+		return err
+	}
+	if rhmi == nil {
+		complete(restore, integreatlyv1alpha1.RestorePhaseFailed, fmt.Sprintf("no RHMI installation found in namespace %s", restore.Namespace))
+		return nil
+	}
+	namespaces, err := r.getNamespaces(rhmi, restore.Spec.Product)
+	if err != nil {
+		return err
+	}
+
+	if restore.Status.ScaledDown == nil {
+		workloads := []integreatlyv1alpha1.ScaledWorkload{}
+		for _, namespace := range namespaces {
+			found, err := listWorkloads(ctx, r.client, namespace)
+			if err != nil {
+				return err
+			}
+			workloads = append(workloads, found...)
+		}
+		restore.Status.ScaledDown = workloads
+		// the replicas must be recorded before scaling down, to be able to scale back up after a restart
+		return nil
+	}
+
+	stopped := true
+	for _, workload := range restore.Status.ScaledDown {
+		running, err := scaleWorkload(ctx, r.client, workload, 0)
+		if err != nil {
+			return err
+		}
+		stopped = stopped && running == 0
+	}
+	if !stopped {
+		logrus.Infof("Waiting for %s to scale down", restore.Spec.Product)
+		return nil
+	}
+
+	restore.Status.Phase = integreatlyv1alpha1.RestorePhaseRestoring
+	return nil
+}
+
+// restore runs the restore job in the namespace of the product, with the names of the backups in its environment
+func (r *ReconcileRHMIRestore) restore(ctx context.Context, restore *integreatlyv1alpha1.RHMIRestore) error {
+	rhmiBackup := &integreatlyv1alpha1.RHMIBackup{}
+	if err := r.client.Get(ctx, k8sclient.ObjectKey{Name: restore.Spec.Backup, Namespace: restore.Namespace}, rhmiBackup); err != nil {
+		restore.Status.Message = fmt.Sprintf("failed to get backup %s: %v", restore.Spec.Backup, err)
+		restore.Status.Phase = integreatlyv1alpha1.RestorePhaseScalingUp
+		return nil
+	}
+	rhmi, err := resources.GetRhmiCr(r.client, ctx, restore.Namespace)
+	if err != nil || rhmi == nil {
+		return fmt.Errorf("failed to get the RHMI installation: %v", err)
+	}
+	namespaces, err := r.getNamespaces(rhmi, restore.Spec.Product)
+	if err != nil {
+		return err
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-restore", restore.Name),
+			Namespace: namespaces[len(namespaces)-1],
+		},
+	}
+	restore.Status.Job = job.Name
+
+	err = r.client.Get(ctx, k8sclient.ObjectKey{Name: job.Name, Namespace: job.Namespace}, job)
+	if k8serr.IsNotFound(err) {
+		jobSpec := restore.Spec.Job
+		if jobSpec == nil {
+			if jobSpec, err = r.getRestoreJob(rhmi, restore.Spec.Product); err != nil {
+				restore.Status.Message = fmt.Sprintf("failed to get the restore job of %s: %v", restore.Spec.Product, err)
+				restore.Status.Phase = integreatlyv1alpha1.RestorePhaseScalingUp
+				return nil
+			}
+		}
+		job.Spec = *jobSpec.DeepCopy()
+		backups := strings.Join(rhmiBackup.GetProductBackup(restore.Spec.Product).Backups, ",")
+		for i := range job.Spec.Template.Spec.Containers {
+			container := &job.Spec.Template.Spec.Containers[i]
+			container.Env = append(container.Env, corev1.EnvVar{Name: integreatlyv1alpha1.RestoreBackupsEnvVar, Value: backups})
+		}
+		logrus.Infof("Creating restore job %s in namespace %s", job.Name, job.Namespace)
+		return r.client.Create(ctx, job)
+	}
+	if err != nil {
+		return err
+	}
+
+	if job.Status.CompletionTime != nil {
+		restore.Status.Phase = integreatlyv1alpha1.RestorePhaseScalingUp
+		return nil
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			restore.Status.Message = fmt.Sprintf("restore job %s failed: %s", job.Name, condition.Message)
+			restore.Status.Phase = integreatlyv1alpha1.RestorePhaseScalingUp
+			return nil
+		}
+	}
+	timeout, _ := getTimeout(restore)
+	if time.Since(job.CreationTimestamp.Time) > timeout {
+		restore.Status.Message = fmt.Sprintf("restore job %s timed out after %s", job.Name, timeout)
+		restore.Status.Phase = integreatlyv1alpha1.RestorePhaseScalingUp
+	}
+	return nil
+}
+
+// scaleUp restores the replicas of the workloads scaled down, the product before its operator
+func (r *ReconcileRHMIRestore) scaleUp(ctx context.Context, restore *integreatlyv1alpha1.RHMIRestore) error {
+	for i := len(restore.Status.ScaledDown) - 1; i >= 0; i-- {
+		workload := restore.Status.ScaledDown[i]
+		if _, err := scaleWorkload(ctx, r.client, workload, workload.Replicas); err != nil {
+			return err
+		}
+	}
+
+	if restore.Status.Message != "" {
+		complete(restore, integreatlyv1alpha1.RestorePhaseFailed, restore.Status.Message)
+		return nil
+	}
+	complete(restore, integreatlyv1alpha1.RestorePhaseCompleted, "")
+	return nil
+}
+
+func (r *ReconcileRHMIRestore) getProductNamespaces(rhmi *integreatlyv1alpha1.RHMI, product integreatlyv1alpha1.ProductName) ([]string, error) {
+	configManager, err := config.NewManager(context.TODO(), r.client, rhmi.Namespace, installation.GetInstallationConfigMapName(rhmi), rhmi)
+	if err != nil {
+		return nil, err
+	}
+	productConfig, err := configManager.ReadProduct(product)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := []string{}
+	if operatorConfig, ok := productConfig.(interface{ GetOperatorNamespace() string }); ok && operatorConfig.GetOperatorNamespace() != "" {
+		namespaces = append(namespaces, operatorConfig.GetOperatorNamespace())
+	}
+	if productConfig.GetNamespace() == "" {
+		return nil, fmt.Errorf("namespace of %s not found", product)
+	}
+	if len(namespaces) == 0 || namespaces[0] != productConfig.GetNamespace() {
+		namespaces = append(namespaces, productConfig.GetNamespace())
+	}
+	return namespaces, nil
+}
+
+// getProductRestoreJob returns the restore job of the product, for the products whose reconciler implements
+// products.RestoreInterface
+func (r *ReconcileRHMIRestore) getProductRestoreJob(rhmi *integreatlyv1alpha1.RHMI, product integreatlyv1alpha1.ProductName) (*batchv1.JobSpec, error) {
+	configManager, err := config.NewManager(context.TODO(), r.client, rhmi.Namespace, installation.GetInstallationConfigMapName(rhmi), rhmi)
+	if err != nil {
+		return nil, err
+	}
+	reconciler, err := products.NewReconciler(product, r.restConfig, configManager, rhmi, r.mgr)
+	if err != nil {
+		return nil, err
+	}
+	restoreReconciler, ok := reconciler.(products.RestoreInterface)
+	if !ok {
+		return nil, fmt.Errorf("product %s has no restore job", product)
+	}
+	return restoreReconciler.GetRestoreJob(context.TODO(), r.client)
+}
+
+func complete(restore *integreatlyv1alpha1.RHMIRestore, phase integreatlyv1alpha1.RestorePhase, message string) {
+	now := metav1.Now()
+	restore.Status.Phase = phase
+	restore.Status.Message = message
+	restore.Status.CompletedAt = &now
+}
+
+func getTimeout(restore *integreatlyv1alpha1.RHMIRestore) (time.Duration, error) {
+	if restore.Spec.Timeout == "" {
+		return defaultRestoreTimeout, nil
+	}
+	timeout, err := time.ParseDuration(restore.Spec.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %s: %w", restore.Spec.Timeout, err)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,231 @@
+package rhmirestore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	appsv1 "github.com/openshift/api/apps/v1"
+
+	k8sappsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const operatorNamespace = "redhat-rhmi-operator"
+
+func buildScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = integreatlyv1alpha1.SchemeBuilder.AddToScheme(scheme)
+	_ = k8sappsv1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+	return scheme
+}
+
+func buildRestoreObjects() []runtime.Object {
+	replicas := int32(2)
+	return []runtime.Object{
+		&integreatlyv1alpha1.RHMI{ObjectMeta: metav1.ObjectMeta{Name: "rhmi", Namespace: operatorNamespace}},
+		&integreatlyv1alpha1.RHMIBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: operatorNamespace},
+			Status: integreatlyv1alpha1.RHMIBackupStatus{
+				Phase: integreatlyv1alpha1.BackupPhaseCompleted,
+				Products: []integreatlyv1alpha1.ProductBackupStatus{
+					{
+						Product: integreatlyv1alpha1.ProductRHSSO,
+						Phase:   integreatlyv1alpha1.BackupPhaseCompleted,
+						Backups: []string{"rhsso-postgres-1", "rhsso-postgres-2"},
+					},
+				},
+			},
+		},
+		&integreatlyv1alpha1.RHMIRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: operatorNamespace},
+			Spec: integreatlyv1alpha1.RHMIRestoreSpec{
+				Backup:  "backup",
+				Product: integreatlyv1alpha1.ProductRHSSO,
+				Job: &batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "restore", Image: "restore"}}},
+					},
+				},
+			},
+		},
+		&k8sappsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "keycloak-operator", Namespace: "redhat-rhmi-rhsso-operator"},
+			Spec:       k8sappsv1.DeploymentSpec{Replicas: &replicas},
+		},
+		&k8sappsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "keycloak", Namespace: "redhat-rhmi-rhsso"},
+			Spec:       k8sappsv1.StatefulSetSpec{Replicas: &replicas},
+		},
+	}
+}
+
+func TestReconcileRHMIRestore(t *testing.T) {
+	cases := []struct {
+		Name          string
+		JobFailed     bool
+		ProductJob    bool
+		ExpectedPhase integreatlyv1alpha1.RestorePhase
+	}{
+		{
+			Name:          "test product is restored and scaled back up",
+			ExpectedPhase: integreatlyv1alpha1.RestorePhaseCompleted,
+		},
+		{
+			Name:          "test product is restored by its restore job when the restore does not set a job",
+			ProductJob:    true,
+			ExpectedPhase: integreatlyv1alpha1.RestorePhaseCompleted,
+		},
+		{
+			Name:          "test product is scaled back up when the restore job fails",
+			JobFailed:     true,
+			ExpectedPhase: integreatlyv1alpha1.RestorePhaseFailed,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.TODO()
+			objects := buildRestoreObjects()
+			if tc.ProductJob {
+				objects[2].(*integreatlyv1alpha1.RHMIRestore).Spec.Job = nil
+			}
+			client := fake.NewFakeClientWithScheme(buildScheme(), objects...)
+			r := &ReconcileRHMIRestore{
+				client: client,
+				getNamespaces: func(*integreatlyv1alpha1.RHMI, integreatlyv1alpha1.ProductName) ([]string, error) {
+					return []string{"redhat-rhmi-rhsso-operator", "redhat-rhmi-rhsso"}, nil
+				},
+				getRestoreJob: func(*integreatlyv1alpha1.RHMI, integreatlyv1alpha1.ProductName) (*batchv1.JobSpec, error) {
+					return &batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "product-restore", Image: "backup"}}},
+						},
+					}, nil
+				},
+			}
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "restore", Namespace: operatorNamespace}}
+			restore := &integreatlyv1alpha1.RHMIRestore{}
+			reconcileRestore := func() {
+				if _, err := r.Reconcile(request); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if err := client.Get(ctx, request.NamespacedName, restore); err != nil {
+					t.Fatalf("failed to get restore: %v", err)
+				}
+			}
+
+			// validated, then the replicas are recorded before anything is scaled down
+			reconcileRestore()
+			reconcileRestore()
+			if restore.Status.Phase != integreatlyv1alpha1.RestorePhaseScalingDown || len(restore.Status.ScaledDown) != 2 {
+				t.Fatalf("expected the replicas of 2 workloads to be recorded, got %v", restore.Status)
+			}
+			if restore.Status.ScaledDown[0].Namespace != "redhat-rhmi-rhsso-operator" {
+				t.Fatalf("expected the operator to be scaled down first, got %v", restore.Status.ScaledDown)
+			}
+
+			reconcileRestore()
+			if restore.Status.Phase != integreatlyv1alpha1.RestorePhaseRestoring {
+				t.Fatalf("expected phase %s, got %s", integreatlyv1alpha1.RestorePhaseRestoring, restore.Status.Phase)
+			}
+			statefulSet := &k8sappsv1.StatefulSet{}
+			if err := client.Get(ctx, k8sclient.ObjectKey{Name: "keycloak", Namespace: "redhat-rhmi-rhsso"}, statefulSet); err != nil {
+				t.Fatalf("failed to get stateful set: %v", err)
+			}
+			if *statefulSet.Spec.Replicas != 0 {
+				t.Fatalf("expected the stateful set to be scaled down, got %d replicas", *statefulSet.Spec.Replicas)
+			}
+
+			reconcileRestore()
+			job := &batchv1.Job{}
+			if err := client.Get(ctx, k8sclient.ObjectKey{Name: "restore-restore", Namespace: "redhat-rhmi-rhsso"}, job); err != nil {
+				t.Fatalf("expected the restore job to be created: %v", err)
+			}
+			if expected := map[bool]string{false: "restore", true: "product-restore"}[tc.ProductJob]; job.Spec.Template.Spec.Containers[0].Name != expected {
+				t.Fatalf("expected the restore job to run the %s container, got %v", expected, job.Spec.Template.Spec.Containers)
+			}
+			env := job.Spec.Template.Spec.Containers[0].Env
+			if len(env) != 1 || env[0].Name != integreatlyv1alpha1.RestoreBackupsEnvVar || env[0].Value != "rhsso-postgres-1,rhsso-postgres-2" {
+				t.Fatalf("expected the backups to be set in the environment of the job, got %v", env)
+			}
+
+			if tc.JobFailed {
+				job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+			} else {
+				now := metav1.NewTime(time.Now())
+				job.Status.CompletionTime = &now
+			}
+			if err := client.Update(ctx, job); err != nil {
+				t.Fatalf("failed to update job: %v", err)
+			}
+
+			reconcileRestore()
+			reconcileRestore()
+			if restore.Status.Phase != tc.ExpectedPhase || restore.Status.CompletedAt == nil {
+				t.Fatalf("expected phase %s, got %v", tc.ExpectedPhase, restore.Status)
+			}
+			deployment := &k8sappsv1.Deployment{}
+			if err := client.Get(ctx, k8sclient.ObjectKey{Name: "keycloak-operator", Namespace: "redhat-rhmi-rhsso-operator"}, deployment); err != nil {
+				t.Fatalf("failed to get deployment: %v", err)
+			}
+			if *deployment.Spec.Replicas != 2 {
+				t.Fatalf("expected the operator to be scaled back up, got %d replicas", *deployment.Spec.Replicas)
+			}
+		})
+	}
+}
+
+func TestReconcileRHMIRestoreBackupNotCompleted(t *testing.T) {
+	objects := buildRestoreObjects()
+	objects[1].(*integreatlyv1alpha1.RHMIBackup).Status.Products[0].Phase = integreatlyv1alpha1.BackupPhaseFailed
+	client := fake.NewFakeClientWithScheme(buildScheme(), objects...)
+	r := &ReconcileRHMIRestore{client: client}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "restore", Namespace: operatorNamespace}}
+	if _, err := r.Reconcile(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restore := &integreatlyv1alpha1.RHMIRestore{}
+	if err := client.Get(context.TODO(), request.NamespacedName, restore); err != nil {
+		t.Fatalf("failed to get restore: %v", err)
+	}
+	if restore.Status.Phase != integreatlyv1alpha1.RestorePhaseFailed || len(restore.Status.ScaledDown) != 0 {
+		t.Fatalf("expected the restore to fail without scaling down, got %v", restore.Status)
+	}
+}
+
+func TestReconcileRHMIRestoreNoProductJob(t *testing.T) {
+	objects := buildRestoreObjects()
+	objects[2].(*integreatlyv1alpha1.RHMIRestore).Spec.Job = nil
+	client := fake.NewFakeClientWithScheme(buildScheme(), objects...)
+	r := &ReconcileRHMIRestore{
+		client: client,
+		getRestoreJob: func(*integreatlyv1alpha1.RHMI, integreatlyv1alpha1.ProductName) (*batchv1.JobSpec, error) {
+			return nil, fmt.Errorf("product rhsso has no restore job")
+		},
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "restore", Namespace: operatorNamespace}}
+	if _, err := r.Reconcile(request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restore := &integreatlyv1alpha1.RHMIRestore{}
+	if err := client.Get(context.TODO(), request.NamespacedName, restore); err != nil {
+		t.Fatalf("failed to get restore: %v", err)
+	}
+	if restore.Status.Phase != integreatlyv1alpha1.RestorePhaseFailed || len(restore.Status.ScaledDown) != 0 {
+		t.Fatalf("expected the restore to fail without scaling down, got %v", restore.Status)
+	}
+}
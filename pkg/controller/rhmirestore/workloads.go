@@ -0,0 +1,104 @@
+package rhmirestore
+
+import (
+	"context"
+	"fmt"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	appsv1 "github.com/openshift/api/apps/v1"
+
+	k8sappsv1 "k8s.io/api/apps/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kindDeployment       = "Deployment"
+	kindStatefulSet      = "StatefulSet"
+	kindDeploymentConfig = "DeploymentConfig"
+)
+
+// listWorkloads returns the deployments, stateful sets and deployment configs of the namespace that are scaled up,
+// with their replicas
+func listWorkloads(ctx context.Context, client k8sclient.Client, namespace string) ([]integreatlyv1alpha1.ScaledWorkload, error) {
+	workloads := []integreatlyv1alpha1.ScaledWorkload{}
+	add := func(kind, name string, replicas *int32) {
+		if replicas != nil && *replicas == 0 {
+			return
+		}
+		scaled := int32(1)
+		if replicas != nil {
+			scaled = *replicas
+		}
+		workloads = append(workloads, integreatlyv1alpha1.ScaledWorkload{Kind: kind, Name: name, Namespace: namespace, Replicas: scaled})
+	}
+
+	deployments := &k8sappsv1.DeploymentList{}
+	if err := client.List(ctx, deployments, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list deployments in %s: %w", namespace, err)
+	}
+	for _, d := range deployments.Items {
+		add(kindDeployment, d.Name, d.Spec.Replicas)
+	}
+
+	statefulSets := &k8sappsv1.StatefulSetList{}
+	if err := client.List(ctx, statefulSets, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list stateful sets in %s: %w", namespace, err)
+	}
+	for _, s := range statefulSets.Items {
+		add(kindStatefulSet, s.Name, s.Spec.Replicas)
+	}
+
+	deploymentConfigs := &appsv1.DeploymentConfigList{}
+	if err := client.List(ctx, deploymentConfigs, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list deployment configs in %s: %w", namespace, err)
+	}
+	for _, dc := range deploymentConfigs.Items {
+		replicas := dc.Spec.Replicas
+		add(kindDeploymentConfig, dc.Name, &replicas)
+	}
+	return workloads, nil
+}
+
+// scaleWorkload sets the replicas of the workload, returning the number of replicas still running
+func scaleWorkload(ctx context.Context, client k8sclient.Client, workload integreatlyv1alpha1.ScaledWorkload, replicas int32) (int32, error) {
+	key := k8sclient.ObjectKey{Name: workload.Name, Namespace: workload.Namespace}
+	switch workload.Kind {
+	case kindDeployment:
+		d := &k8sappsv1.Deployment{}
+		if err := client.Get(ctx, key, d); err != nil {
+			return 0, err
+		}
+		if d.Spec.Replicas == nil || *d.Spec.Replicas != replicas {
+			d.Spec.Replicas = &replicas
+			if err := client.Update(ctx, d); err != nil {
+				return 0, err
+			}
+		}
+		return d.Status.Replicas, nil
+	case kindStatefulSet:
+		s := &k8sappsv1.StatefulSet{}
+		if err := client.Get(ctx, key, s); err != nil {
+			return 0, err
+		}
+		if s.Spec.Replicas == nil || *s.Spec.Replicas != replicas {
+			s.Spec.Replicas = &replicas
+			if err := client.Update(ctx, s); err != nil {
+				return 0, err
+			}
+		}
+		return s.Status.Replicas, nil
+	case kindDeploymentConfig:
+		dc := &appsv1.DeploymentConfig{}
+		if err := client.Get(ctx, key, dc); err != nil {
+			return 0, err
+		}
+		if dc.Spec.Replicas != replicas {
+			dc.Spec.Replicas = replicas
+			if err := client.Update(ctx, dc); err != nil {
+				return 0, err
+			}
+		}
+		return dc.Status.Replicas, nil
+	}
+	return 0, fmt.Errorf("unsupported workload kind %s", workload.Kind)
+}
@@ -3,8 +3,6 @@ package rhmiConfigs
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
 	operatorsv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
@@ -79,22 +77,34 @@ func CanUpgradeNow(config *integreatlyv1alpha1.RHMIConfig, installation *integre
 		return false, nil
 	}
 
-	var duration int
-	// Upgrade window taken either from the maintenance window or, by default
-	// from the WINDOW constant
+	// Never upgrade during a blackout period, even if it was added after the upgrade was scheduled
+	inBlackout, err := config.Spec.Maintenance.InBlackout(time.Now().UTC())
+	if err != nil {
+		return false, err
+	}
+	if inBlackout {
+		return false, nil
+	}
+
+	var duration time.Duration
+	// Upgrade window taken either from the maintenance window the upgrade is
+	// scheduled in or, by default from the WINDOW constant
 	waitForMaintenance := *config.Spec.Upgrade.WaitForMaintenance
 	if waitForMaintenance {
-		var err error
-		duration, err = strconv.Atoi(strings.Replace(config.Status.Maintenance.Duration, "hrs", "", -1))
+		scheduledDuration := config.Status.Upgrade.Scheduled.Duration
+		if scheduledDuration == "" {
+			scheduledDuration = config.Status.Maintenance.Duration
+		}
+		duration, err = integreatlyv1alpha1.ParseMaintenanceDuration(scheduledDuration)
 		if err != nil {
 			return false, err
 		}
 	} else {
-		duration = WINDOW
+		duration = time.Hour * WINDOW
 	}
 
 	//don't approve upgrades in the last hour of the window
-	window := time.Hour * time.Duration(duration-WINDOW_MARGIN)
+	window := duration - time.Hour*WINDOW_MARGIN
 	upgradeTime, err := time.Parse(integreatlyv1alpha1.DateFormat, config.Status.Upgrade.Scheduled.For)
 	if err != nil {
 		return false, err
@@ -103,6 +113,17 @@ func CanUpgradeNow(config *integreatlyv1alpha1.RHMIConfig, installation *integre
 	return inWindow(upgradeTime, upgradeTime.Add(window)), nil
 }
 
+// IsUpgradeApprovedManually checks if the upgrade to targetVersion was approved through spec.upgrade.approveNow.
+// Manually approved upgrades skip the upgrade schedule, but still wait for any other upgrade in progress
+func IsUpgradeApprovedManually(config *integreatlyv1alpha1.RHMIConfig, installation *integreatlyv1alpha1.RHMI, targetVersion string) bool {
+	if config.Spec.Upgrade.ApproveNow == "" || config.Spec.Upgrade.ApproveNow != targetVersion {
+		return false
+	}
+
+	//Another upgrade in progress - don't proceed with upgrade
+	return !((string(installation.Status.Stage) != string(integreatlyv1alpha1.PhaseCompleted)) && installation.Status.ToVersion != "")
+}
+
 func inWindow(windowStart time.Time, windowEnd time.Time) bool {
 	now := time.Now().UTC()
 	return windowStart.Before(now) && windowEnd.After(now)
@@ -201,6 +201,98 @@ func TestCanUpgradeNow(t *testing.T) {
 				}
 			},
 		},
+		{
+			Name: "during maintenance in a blackout returns false",
+			Config: &integreatlyv1alpha1.RHMIConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhmi-config",
+					Namespace: defaultNamespace,
+				},
+				Spec: integreatlyv1alpha1.RHMIConfigSpec{
+					Maintenance: integreatlyv1alpha1.Maintenance{
+						Blackouts: []integreatlyv1alpha1.Blackout{
+							{
+								From: nowOffset(-24).Format(integreatlyv1alpha1.BlackoutDateFormat),
+								To:   nowOffset(24).Format(integreatlyv1alpha1.BlackoutDateFormat),
+							},
+						},
+					},
+					Upgrade: integreatlyv1alpha1.Upgrade{
+						WaitForMaintenance: boolPtr(true),
+						NotBeforeDays:      intPtr(0),
+					},
+				},
+				Status: integreatlyv1alpha1.RHMIConfigStatus{
+					Upgrade: integreatlyv1alpha1.RHMIConfigStatusUpgrade{
+						Scheduled: &integreatlyv1alpha1.UpgradeSchedule{
+							For:      nowOffset(-1).Format(integreatlyv1alpha1.DateFormat),
+							Duration: "6hrs",
+						},
+					},
+				},
+			},
+			Installation: &integreatlyv1alpha1.RHMI{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhmi",
+					Namespace: defaultNamespace,
+				},
+				Status: integreatlyv1alpha1.RHMIStatus{
+					Stage: integreatlyv1alpha1.StageName(integreatlyv1alpha1.PhaseCompleted),
+				},
+			},
+			Validate: func(t *testing.T, canUpgrade bool, err error) {
+				if err != nil {
+					t.Error("Expected no errors, got: " + err.Error())
+				}
+				if canUpgrade {
+					t.Error("Expected canUpgrade false, got true")
+				}
+			},
+		},
+		{
+			Name: "during maintenance window scheduled with its own duration returns true",
+			Config: &integreatlyv1alpha1.RHMIConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhmi-config",
+					Namespace: defaultNamespace,
+				},
+				Spec: integreatlyv1alpha1.RHMIConfigSpec{
+					Upgrade: integreatlyv1alpha1.Upgrade{
+						WaitForMaintenance: boolPtr(true),
+						NotBeforeDays:      intPtr(0),
+					},
+				},
+				Status: integreatlyv1alpha1.RHMIConfigStatus{
+					Maintenance: integreatlyv1alpha1.RHMIConfigStatusMaintenance{
+						ApplyFrom: nowOffset(-3).Format("2-1-2006 15:04"),
+						Duration:  "2hrs",
+					},
+					Upgrade: integreatlyv1alpha1.RHMIConfigStatusUpgrade{
+						Scheduled: &integreatlyv1alpha1.UpgradeSchedule{
+							For:      nowOffset(-3).Format(integreatlyv1alpha1.DateFormat),
+							Duration: "12hrs",
+						},
+					},
+				},
+			},
+			Installation: &integreatlyv1alpha1.RHMI{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhmi",
+					Namespace: defaultNamespace,
+				},
+				Status: integreatlyv1alpha1.RHMIStatus{
+					Stage: integreatlyv1alpha1.StageName(integreatlyv1alpha1.PhaseCompleted),
+				},
+			},
+			Validate: func(t *testing.T, canUpgrade bool, err error) {
+				if err != nil {
+					t.Error("Expected no errors, got: " + err.Error())
+				}
+				if !canUpgrade {
+					t.Error("Expected canUpgrade true, got false")
+				}
+			},
+		},
 		{
 			Name: "Do not upgrade when another upgrade is in progress",
 			Config: &integreatlyv1alpha1.RHMIConfig{
@@ -469,6 +561,61 @@ func TestApproveUpgrade(t *testing.T) {
 	}
 }
 
+func TestIsUpgradeApprovedManually(t *testing.T) {
+	targetVersion := "integreatly-operator.v2.5.0"
+
+	scenarios := []struct {
+		Name         string
+		ApproveNow   string
+		Installation *integreatlyv1alpha1.RHMI
+		Expected     bool
+	}{
+		{
+			Name:         "upgrade is not approved manually without approveNow",
+			Installation: &integreatlyv1alpha1.RHMI{},
+			Expected:     false,
+		},
+		{
+			Name:         "upgrade is approved manually with approveNow for the target version",
+			ApproveNow:   targetVersion,
+			Installation: &integreatlyv1alpha1.RHMI{},
+			Expected:     true,
+		},
+		{
+			Name:         "upgrade is not approved manually with approveNow for another version",
+			ApproveNow:   "integreatly-operator.v2.4.0",
+			Installation: &integreatlyv1alpha1.RHMI{},
+			Expected:     false,
+		},
+		{
+			Name:       "upgrade is not approved manually while another upgrade is in progress",
+			ApproveNow: targetVersion,
+			Installation: &integreatlyv1alpha1.RHMI{
+				Status: integreatlyv1alpha1.RHMIStatus{
+					Stage:     integreatlyv1alpha1.ProductsStage,
+					ToVersion: "2.4.0",
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			config := &integreatlyv1alpha1.RHMIConfig{
+				Spec: integreatlyv1alpha1.RHMIConfigSpec{
+					Upgrade: integreatlyv1alpha1.Upgrade{
+						ApproveNow: scenario.ApproveNow,
+					},
+				},
+			}
+			if approved := IsUpgradeApprovedManually(config, scenario.Installation, targetVersion); approved != scenario.Expected {
+				t.Errorf("expected upgrade approved manually to be %v, got %v", scenario.Expected, approved)
+			}
+		})
+	}
+}
+
 func buildScheme() *runtime.Scheme {
 	scheme := runtime.NewScheme()
 
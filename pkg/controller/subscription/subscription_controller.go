@@ -13,6 +13,7 @@ import (
 	"github.com/integr8ly/integreatly-operator/pkg/controller/subscription/csvlocator"
 	"github.com/integr8ly/integreatly-operator/pkg/controller/subscription/rhmiConfigs"
 	"github.com/integr8ly/integreatly-operator/pkg/controller/subscription/webapp"
+	"github.com/integr8ly/integreatly-operator/pkg/metrics"
 	"github.com/integr8ly/integreatly-operator/version"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -180,6 +181,11 @@ func (r *ReconcileSubscription) shouldReconcileSubscription(request reconcile.Re
 }
 
 func (r *ReconcileSubscription) HandleUpgrades(ctx context.Context, rhmiSubscription *operatorsv1alpha1.Subscription, installation *integreatlyv1alpha1.RHMI) (reconcile.Result, error) {
+	upgradeInProgress, err := r.reconcileUpgradeHistory(ctx, installation)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !rhmiConfigs.IsUpgradeAvailable(rhmiSubscription) {
 		logrus.Infof("no upgrade available")
 
@@ -187,6 +193,13 @@ func (r *ReconcileSubscription) HandleUpgrades(ctx context.Context, rhmiSubscrip
 			return reconcile.Result{}, err
 		}
 
+		// Requeue the reconciler until the outcome of the upgrade is recorded
+		if upgradeInProgress {
+			return reconcile.Result{
+				Requeue:      true,
+				RequeueAfter: time.Minute,
+			}, nil
+		}
 		return reconcile.Result{}, nil
 	}
 
@@ -209,7 +222,7 @@ func (r *ReconcileSubscription) HandleUpgrades(ctx context.Context, rhmiSubscrip
 
 	config := &integreatlyv1alpha1.RHMIConfig{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "rhmi-config",
+			Name:      resources.RHMIConfigName,
 			Namespace: r.operatorNamespace,
 		},
 	}
@@ -305,6 +318,7 @@ func (r *ReconcileSubscription) HandleUpgrades(ctx context.Context, rhmiSubscrip
 	if err != nil {
 		return reconcile.Result{}, err
 	}
+	approvedManually := rhmiConfigs.IsUpgradeApprovedManually(config, installation, rhmiSubscription.Status.CurrentCSV)
 
 	phase, err := r.webbappNotifier.NotifyUpgrade(config, latestRHMICSV.Spec.Version.String(), isServiceAffecting)
 	if err != nil {
@@ -314,9 +328,14 @@ func (r *ReconcileSubscription) HandleUpgrades(ctx context.Context, rhmiSubscrip
 		logrus.Infof("WebApp instance not found yet, skipping upgrade addition")
 	}
 
-	if !isServiceAffecting || canUpgradeNow {
+	if !isServiceAffecting || canUpgradeNow || approvedManually {
 		eventRecorder := r.mgr.GetEventRecorderFor("RHMI Upgrade")
 
+		scheduledFor := ""
+		if config.Status.Upgrade.Scheduled != nil {
+			scheduledFor = config.Status.Upgrade.Scheduled.For
+		}
+
 		if config.Status.UpgradeAvailable != nil && config.Status.UpgradeAvailable.TargetVersion == rhmiSubscription.Status.CurrentCSV {
 			config.Status.UpgradeAvailable = nil
 			if err := r.client.Status().Update(context.TODO(), config); err != nil {
@@ -329,6 +348,42 @@ func (r *ReconcileSubscription) HandleUpgrades(ctx context.Context, rhmiSubscrip
 			return reconcile.Result{}, err
 		}
 
+		// The user that requested an upgrade action is recorded by the mutating webhook of the config
+		approval, approvedBy := integreatlyv1alpha1.UpgradeApprovalMaintenanceWindow, ""
+		switch {
+		case approvedManually:
+			approval, approvedBy = integreatlyv1alpha1.UpgradeApprovalManual, config.Annotations[integreatlyv1alpha1.UpgradeActionUsernameAnnotation]
+		case !isServiceAffecting:
+			approval = integreatlyv1alpha1.UpgradeApprovalNotServiceAffecting
+		case config.Spec.Upgrade.PostponeUntil != "":
+			approval, approvedBy = integreatlyv1alpha1.UpgradeApprovalPostponed, config.Annotations[integreatlyv1alpha1.UpgradeActionUsernameAnnotation]
+		}
+		recorded := config.RecordUpgrade(integreatlyv1alpha1.UpgradeHistoryEntry{
+			FromVersion:  installation.Status.Version,
+			ToVersion:    latestRHMICSV.Spec.Version.String(),
+			InstallPlan:  latestRHMIInstallPlan.Name,
+			ScheduledFor: scheduledFor,
+			ApprovedAt:   metav1.Now(),
+			Approval:     approval,
+			ApprovedBy:   approvedBy,
+			Outcome:      integreatlyv1alpha1.UpgradeOutcomeInProgress,
+		})
+		if recorded {
+			if err := r.client.Status().Update(ctx, config); err != nil {
+				return reconcile.Result{}, err
+			}
+			metrics.SetUpgradeHistory(config)
+		}
+
+		// Clear the upgrade actions, as they only apply to the approved upgrade
+		if config.Spec.Upgrade.ApproveNow != "" || config.Spec.Upgrade.PostponeUntil != "" {
+			config.Spec.Upgrade.ApproveNow = ""
+			config.Spec.Upgrade.PostponeUntil = ""
+			if err := r.client.Update(ctx, config); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
 		// Requeue the reconciler until the RHMI subscription upgrade is complete
 		return reconcile.Result{
 			Requeue:      true,
@@ -340,3 +395,40 @@ func (r *ReconcileSubscription) HandleUpgrades(ctx context.Context, rhmiSubscrip
 		RequeueAfter: time.Minute,
 	}, nil
 }
+
+// reconcileUpgradeHistory records the outcome of the upgrade in progress in the RHMIConfig upgrade history. The
+// upgrade succeeded once the installation reports the version it upgrades to, and failed if its install plan
+// failed. It returns true while the upgrade is still in progress
+func (r *ReconcileSubscription) reconcileUpgradeHistory(ctx context.Context, installation *integreatlyv1alpha1.RHMI) (bool, error) {
+	config := &integreatlyv1alpha1.RHMIConfig{}
+	err := r.client.Get(ctx, k8sclient.ObjectKey{Name: resources.RHMIConfigName, Namespace: r.operatorNamespace}, config)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer metrics.SetUpgradeHistory(config)
+
+	upgrade := config.GetUpgradeInProgress()
+	if upgrade == nil {
+		return false, nil
+	}
+
+	if installation.Status.Version == upgrade.ToVersion {
+		upgrade.Complete(integreatlyv1alpha1.UpgradeOutcomeSucceeded, "")
+		return false, r.client.Status().Update(ctx, config)
+	}
+
+	installPlan := &operatorsv1alpha1.InstallPlan{}
+	err = r.client.Get(ctx, k8sclient.ObjectKey{Name: upgrade.InstallPlan, Namespace: r.operatorNamespace}, installPlan)
+	if err != nil && !errors.IsNotFound(err) {
+		return false, err
+	}
+	if err == nil && installPlan.Status.Phase == operatorsv1alpha1.InstallPlanPhaseFailed {
+		upgrade.Complete(integreatlyv1alpha1.UpgradeOutcomeFailed, fmt.Sprintf("install plan %s failed", installPlan.Name))
+		return false, r.client.Status().Update(ctx, config)
+	}
+
+	return true, nil
+}
@@ -355,6 +355,100 @@ func TestShouldReconcileSubscription(t *testing.T) {
 	}
 }
 
+func TestReconcileUpgradeHistory(t *testing.T) {
+	scheme, err := getBuildScheme()
+	if err != nil {
+		t.Fatalf("failed to build scheme: %s", err.Error())
+	}
+
+	scenarios := []struct {
+		Name               string
+		InstalledVersion   string
+		InstallPlanPhase   olmv1alpha1.InstallPlanPhase
+		ExpectedInProgress bool
+		ExpectedOutcome    integreatlyv1alpha1.UpgradeOutcome
+	}{
+		{
+			Name:               "upgrade is in progress until the installation reports the new version",
+			InstalledVersion:   "2.4.0",
+			InstallPlanPhase:   olmv1alpha1.InstallPlanPhaseInstalling,
+			ExpectedInProgress: true,
+			ExpectedOutcome:    integreatlyv1alpha1.UpgradeOutcomeInProgress,
+		},
+		{
+			Name:             "upgrade succeeds once the installation reports the new version",
+			InstalledVersion: "2.5.0",
+			InstallPlanPhase: olmv1alpha1.InstallPlanPhaseComplete,
+			ExpectedOutcome:  integreatlyv1alpha1.UpgradeOutcomeSucceeded,
+		},
+		{
+			Name:             "upgrade fails when its install plan fails",
+			InstalledVersion: "2.4.0",
+			InstallPlanPhase: olmv1alpha1.InstallPlanPhaseFailed,
+			ExpectedOutcome:  integreatlyv1alpha1.UpgradeOutcomeFailed,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			rhmiConfig := &integreatlyv1alpha1.RHMIConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhmi-config",
+					Namespace: operatorNamespace,
+				},
+				Status: integreatlyv1alpha1.RHMIConfigStatus{
+					UpgradeHistory: []integreatlyv1alpha1.UpgradeHistoryEntry{
+						{
+							FromVersion: "2.4.0",
+							ToVersion:   "2.5.0",
+							InstallPlan: "installplan",
+							Approval:    integreatlyv1alpha1.UpgradeApprovalMaintenanceWindow,
+							Outcome:     integreatlyv1alpha1.UpgradeOutcomeInProgress,
+						},
+					},
+				},
+			}
+			installPlan := &olmv1alpha1.InstallPlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "installplan",
+					Namespace: operatorNamespace,
+				},
+				Status: olmv1alpha1.InstallPlanStatus{
+					Phase: scenario.InstallPlanPhase,
+				},
+			}
+			installation := &integreatlyv1alpha1.RHMI{
+				Status: integreatlyv1alpha1.RHMIStatus{
+					Version: scenario.InstalledVersion,
+				},
+			}
+
+			client := fakeclient.NewFakeClientWithScheme(scheme, rhmiConfig, installPlan)
+			reconciler := ReconcileSubscription{
+				client:            client,
+				scheme:            scheme,
+				operatorNamespace: operatorNamespace,
+			}
+
+			inProgress, err := reconciler.reconcileUpgradeHistory(context.TODO(), installation)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if inProgress != scenario.ExpectedInProgress {
+				t.Fatalf("expected upgrade in progress to be %v, got %v", scenario.ExpectedInProgress, inProgress)
+			}
+
+			config := &integreatlyv1alpha1.RHMIConfig{}
+			if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "rhmi-config", Namespace: operatorNamespace}, config); err != nil {
+				t.Fatalf("unexpected error getting rhmi config: %s", err.Error())
+			}
+			if outcome := config.Status.UpgradeHistory[0].Outcome; outcome != scenario.ExpectedOutcome {
+				t.Fatalf("expected outcome %s, got %s", scenario.ExpectedOutcome, outcome)
+			}
+		})
+	}
+}
+
 func getCatalogSourceClient(replaces string) catalogsourceClient.CatalogSourceClientInterface {
 	return &catalogsourceClient.CatalogSourceClientInterfaceMock{
 		GetLatestCSVFunc: func(catalogSourceKey types.NamespacedName, packageName, channelName string) (*v1alpha1.ClusterServiceVersion, error) {
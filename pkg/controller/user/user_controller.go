@@ -2,12 +2,16 @@ package user
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	userHelper "github.com/integr8ly/integreatly-operator/pkg/resources/user"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	usersv1 "github.com/openshift/api/user/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,9 +25,12 @@ import (
 
 var (
 	log = logf.Log.WithName("controller_user")
-	// A set of pre configured groups used to exclude a user from rhmi specific groups
 )
 
+// developersGroupLabel labels the developers group created by the operator, so that it is deleted once the users
+// policy names another group
+const developersGroupLabel = "integreatly"
+
 // Add creates a new User Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
@@ -49,6 +56,12 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	// Watch for changes to the users policy of the RHMIConfig
+	err = c.Watch(&source.Kind{Type: &integreatlyv1alpha1.RHMIConfig{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -68,12 +81,39 @@ func (r *ReconcileUser) Reconcile(request reconcile.Request) (reconcile.Result,
 	c, _ := k8sclient.New(restConfig, k8sclient.Options{})
 	ctx := context.TODO()
 
+	policy, err := userHelper.GetPolicy(ctx, c)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	rhmiGroup, err := reconcileDevelopersGroup(ctx, c, policy)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, deletePreviousDevelopersGroups(ctx, c, rhmiGroup.Name)
+}
+
+// reconcileDevelopersGroup sets the developers allowed by the policy as the members of the developers group. An
+// existing group is only maintained when the operator created it, as the developers group of the policy may name
+// any group starting with the default developers group. The default group may have been created by a previous
+// version of the operator without the label
+func reconcileDevelopersGroup(ctx context.Context, c k8sclient.Client, policy *userHelper.Policy) (*usersv1.Group, error) {
+	reqLogger := log.WithValues("Group.Name", policy.DevelopersGroup())
+
 	rhmiGroup := &usersv1.Group{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "rhmi-developers",
+			Name: policy.DevelopersGroup(),
 		},
 	}
 
+	err := c.Get(ctx, k8sclient.ObjectKey{Name: rhmiGroup.Name}, rhmiGroup)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && rhmiGroup.Labels[developersGroupLabel] != "true" && rhmiGroup.Name != userHelper.DefaultDevelopersGroup {
+		return nil, fmt.Errorf("group %s was not created by the operator and can not be the developers group", rhmiGroup.Name)
+	}
+
 	or, err := controllerutil.CreateOrUpdate(ctx, c, rhmiGroup, func() error {
 		users := &usersv1.UserList{}
 		err := c.List(ctx, users)
@@ -87,22 +127,51 @@ func (r *ReconcileUser) Reconcile(request reconcile.Request) (reconcile.Result,
 			return err
 		}
 
-		rhmiGroup.Users = mapUserNames(users, groups)
+		if rhmiGroup.Labels == nil {
+			rhmiGroup.Labels = map[string]string{}
+		}
+		rhmiGroup.Labels[developersGroupLabel] = "true"
+		rhmiGroup.Users = mapUserNames(policy, users, groups)
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 	reqLogger.Info("The operation result for group " + rhmiGroup.Name + " was " + string(or))
+	return rhmiGroup, nil
+}
 
-	return reconcile.Result{}, err
+// deletePreviousDevelopersGroups deletes the developers groups created by the operator under a previous name of the
+// developers group of the users policy. Only the labelled groups named after the default developers group are
+// deleted
+func deletePreviousDevelopersGroups(ctx context.Context, c k8sclient.Client, developersGroup string) error {
+	groups := &usersv1.GroupList{}
+	if err := c.List(ctx, groups, k8sclient.MatchingLabels{developersGroupLabel: "true"}); err != nil {
+		return err
+	}
+	for i := range groups.Items {
+		group := &groups.Items[i]
+		if group.Name == developersGroup || !isDevelopersGroupName(group.Name) {
+			continue
+		}
+		log.Info("Deleting previous developers group " + group.Name)
+		if err := c.Delete(ctx, group); err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
 }
 
-func mapUserNames(users *usersv1.UserList, groups *usersv1.GroupList) []string {
+func isDevelopersGroupName(name string) bool {
+	return name == userHelper.DefaultDevelopersGroup || strings.HasPrefix(name, userHelper.DefaultDevelopersGroup+"-")
+}
+
+func mapUserNames(policy *userHelper.Policy, users *usersv1.UserList, groups *usersv1.GroupList) []string {
 	var result = []string{}
-	for _, user := range users.Items {
-		// Certain users such as sre do not need to be added
-		if !userHelper.UserInExclusionGroup(user, groups) {
-			result = append(result, user.Name)
-		}
+	// Certain users such as sre do not need to be added
+	for _, user := range policy.Developers(users.Items, groups) {
+		result = append(result, user.Name)
 	}
 
 	return result
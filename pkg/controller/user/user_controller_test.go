@@ -0,0 +1,114 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	userHelper "github.com/integr8ly/integreatly-operator/pkg/resources/user"
+
+	usersv1 "github.com/openshift/api/user/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func buildScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := usersv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	return scheme
+}
+
+func group(name string, labels map[string]string, users ...string) *usersv1.Group {
+	return &usersv1.Group{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}, Users: users}
+}
+
+func TestReconcileDevelopersGroup(t *testing.T) {
+	developer := &usersv1.User{ObjectMeta: metav1.ObjectMeta{Name: "developer"}}
+
+	cases := []struct {
+		Name            string
+		DevelopersGroup string
+		Existing        *usersv1.Group
+		ExpectErr       bool
+	}{
+		{
+			Name:            "test developers group is created",
+			DevelopersGroup: "rhmi-developers-team",
+		},
+		{
+			Name:            "test default group created by a previous version is adopted",
+			DevelopersGroup: userHelper.DefaultDevelopersGroup,
+			Existing:        group(userHelper.DefaultDevelopersGroup, nil),
+		},
+		{
+			Name:            "test existing group not created by the operator is not adopted",
+			DevelopersGroup: "rhmi-developers-team",
+			Existing:        group("rhmi-developers-team", nil, "admin"),
+			ExpectErr:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			objects := []runtime.Object{developer.DeepCopy()}
+			if tc.Existing != nil {
+				objects = append(objects, tc.Existing.DeepCopy())
+			}
+			client := fake.NewFakeClientWithScheme(buildScheme(t), objects...)
+			policy, err := userHelper.NewPolicy(integreatlyv1alpha1.UserPolicy{DevelopersGroup: tc.DevelopersGroup})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err = reconcileDevelopersGroup(context.TODO(), client, policy)
+			persisted := &usersv1.Group{}
+			if getErr := client.Get(context.TODO(), k8sclient.ObjectKey{Name: tc.DevelopersGroup}, persisted); getErr != nil {
+				t.Fatalf("expected the group to exist: %v", getErr)
+			}
+			if tc.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error when the group was not created by the operator")
+				}
+				if len(persisted.Users) != 1 || persisted.Users[0] != "admin" || persisted.Labels[developersGroupLabel] != "" {
+					t.Fatalf("expected the group to be left untouched, got %v", persisted)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(persisted.Users) != 1 || persisted.Users[0] != "developer" || persisted.Labels[developersGroupLabel] != "true" {
+				t.Fatalf("expected the labelled group with the developer, got %v", persisted)
+			}
+		})
+	}
+}
+
+func TestDeletePreviousDevelopersGroups(t *testing.T) {
+	owned := map[string]string{developersGroupLabel: "true"}
+	client := fake.NewFakeClientWithScheme(buildScheme(t),
+		group("rhmi-developers-team", owned),
+		group(userHelper.DefaultDevelopersGroup, owned),
+		group("dedicated-admins", owned),
+	)
+
+	if err := deletePreviousDevelopersGroups(context.TODO(), client, "rhmi-developers-team"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]bool{"rhmi-developers-team": true, userHelper.DefaultDevelopersGroup: false, "dedicated-admins": true}
+	for name, exists := range expected {
+		err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: name}, &usersv1.Group{})
+		if exists && err != nil {
+			t.Fatalf("expected group %s to be kept: %v", name, err)
+		}
+		if !exists && !k8serr.IsNotFound(err) {
+			t.Fatalf("expected group %s to be deleted, got %v", name, err)
+		}
+	}
+}
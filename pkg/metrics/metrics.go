@@ -2,11 +2,14 @@ package metrics
 
 import (
 	"fmt"
+	"time"
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/integreatly-operator/version"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 // Custom metrics
@@ -68,6 +71,122 @@ var (
 			"stage",
 		},
 	)
+
+	RHMICondition = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_status_condition",
+			Help: "RHMI installation and product conditions, 1 when the condition is true. The product label is empty for the conditions of the installation",
+		},
+		[]string{
+			"product",
+			"type",
+			"reason",
+		},
+	)
+
+	ProductVersionDrift = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_product_version_drift",
+			Help: "1 when the versions observed on the cluster for an RHMI product do not match the versions expected by the operator",
+		},
+		[]string{
+			"product",
+			"observed_version",
+			"observed_operator_version",
+		},
+	)
+
+	UpgradeHistory = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_upgrade_history",
+			Help: "Upgrades of RHMI recorded in the RHMIConfig upgrade history, the value is the time the upgrade was approved",
+		},
+		[]string{
+			"from_version",
+			"to_version",
+			"approval",
+			"approved_by",
+			"outcome",
+		},
+	)
+
+	BackupSnapshots = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_backup_snapshots",
+			Help: "Pre-upgrade snapshots of a cloud resource retained by the backup retention policy",
+		},
+		[]string{
+			"type",
+			"resource",
+		},
+	)
+
+	BackupSnapshotsPruned = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rhmi_backup_snapshots_pruned_total",
+			Help: "Pre-upgrade snapshots of a cloud resource removed by the backup retention policy",
+		},
+		[]string{
+			"type",
+			"resource",
+		},
+	)
+
+	BackupLastVerified = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_backup_last_verified_timestamp",
+			Help: "Time the latest backup of a component was last restored successfully by its verification job",
+		},
+		[]string{
+			"product_namespace",
+			"component",
+		},
+	)
+
+	BackupVerificationSuccess = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_backup_verification_success",
+			Help: "1 when the latest verification job of the backups of a component succeeded, 0 when it failed",
+		},
+		[]string{
+			"product_namespace",
+			"component",
+		},
+	)
+
+	UserSyncChanges = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_user_sync_changes",
+			Help: "Changes of the last synchronisation of the OpenShift users to a product, by action and result",
+		},
+		[]string{
+			"target",
+			"action",
+			"result",
+		},
+	)
+
+	UserSyncLastTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rhmi_user_sync_last_timestamp",
+			Help: "Time of the last synchronisation of the OpenShift users to a product",
+		},
+		[]string{
+			"target",
+		},
+	)
+
+	ProductReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rhmi_product_reconcile_duration_seconds",
+			Help:    "Time taken to reconcile a product of an RHMI installation stage",
+			Buckets: []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+		},
+		[]string{
+			"stage",
+			"product",
+		},
+	)
 )
 
 // SetRHMIInfo exposes rhmi info metrics with labels from the installation CR
@@ -92,7 +211,87 @@ func SetRHMIStatus(installation *integreatlyv1alpha1.RHMI) {
 	}
 }
 
+// SetRHMIConditions exposes the rhmi_status_condition metric for the conditions of the installation and its products
+func SetRHMIConditions(installation *integreatlyv1alpha1.RHMI) {
+	RHMICondition.Reset()
+	setConditions("", installation.Status.Conditions)
+	for _, stage := range installation.Status.Stages {
+		for productName, product := range stage.Products {
+			setConditions(string(productName), product.Conditions)
+		}
+	}
+}
+
+func setConditions(product string, conditions []integreatlyv1alpha1.Condition) {
+	for _, condition := range conditions {
+		value := float64(0)
+		if condition.Status == corev1.ConditionTrue {
+			value = 1
+		}
+		RHMICondition.WithLabelValues(product, string(condition.Type), condition.Reason).Set(value)
+	}
+}
+
+// SetProductVersionDrift exposes the rhmi_product_version_drift metric for the products of the installation
+func SetProductVersionDrift(installation *integreatlyv1alpha1.RHMI) {
+	ProductVersionDrift.Reset()
+	for _, stage := range installation.Status.Stages {
+		for productName, product := range stage.Products {
+			value := float64(0)
+			if product.VersionDrift {
+				value = 1
+			}
+			ProductVersionDrift.WithLabelValues(string(productName), string(product.ObservedVersion), string(product.ObservedOperatorVersion)).Set(value)
+		}
+	}
+}
+
+// SetUpgradeHistory exposes the rhmi_upgrade_history metric for the upgrades recorded in the RHMIConfig
+func SetUpgradeHistory(config *integreatlyv1alpha1.RHMIConfig) {
+	UpgradeHistory.Reset()
+	for _, upgrade := range config.Status.UpgradeHistory {
+		UpgradeHistory.WithLabelValues(upgrade.FromVersion, upgrade.ToVersion, string(upgrade.Approval), upgrade.ApprovedBy, string(upgrade.Outcome)).Set(float64(upgrade.ApprovedAt.Unix()))
+	}
+}
+
+// SetBackupSnapshots exposes the pre-upgrade snapshots of a cloud resource retained and removed by the backup
+// retention policy
+func SetBackupSnapshots(snapshotType string, resource string, retained int, pruned int) {
+	BackupSnapshots.WithLabelValues(snapshotType, resource).Set(float64(retained))
+	BackupSnapshotsPruned.WithLabelValues(snapshotType, resource).Add(float64(pruned))
+}
+
+// SetBackupLastVerified exposes the time the backups of a component were last verified successfully
+func SetBackupLastVerified(namespace string, component string, verifiedAt time.Time) {
+	BackupLastVerified.WithLabelValues(namespace, component).Set(float64(verifiedAt.Unix()))
+}
+
+// SetBackupVerification exposes the outcome of the latest verification of the backups of a component
+func SetBackupVerification(namespace string, component string, succeeded bool) {
+	value := float64(0)
+	if succeeded {
+		value = 1
+	}
+	BackupVerificationSuccess.WithLabelValues(namespace, component).Set(value)
+}
+
+// SetUserSync exposes the changes of the last synchronisation of the users to a product. Every action and result is
+// set, so that the changes of a previous sync do not linger
+func SetUserSync(target string, changes map[integreatlyv1alpha1.UserSyncAction]map[integreatlyv1alpha1.UserSyncResult]int, syncedAt time.Time) {
+	for _, action := range integreatlyv1alpha1.UserSyncActions {
+		for _, result := range integreatlyv1alpha1.UserSyncResults {
+			UserSyncChanges.WithLabelValues(target, string(action), string(result)).Set(float64(changes[action][result]))
+		}
+	}
+	UserSyncLastTimestamp.WithLabelValues(target).Set(float64(syncedAt.Unix()))
+}
+
 func SetRhmiVersions(stage string, version string, toVersion string, firstInstallTimestamp int64) {
 	RHMIVersion.Reset()
 	RHMIVersion.WithLabelValues(stage, version, toVersion).Set(float64(firstInstallTimestamp))
 }
+
+// ObserveProductReconcileDuration records the time taken to reconcile a product in a stage
+func ObserveProductReconcileDuration(stage string, product string, duration time.Duration) {
+	ProductReconcileDuration.WithLabelValues(stage, product).Observe(duration.Seconds())
+}
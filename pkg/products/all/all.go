@@ -0,0 +1,25 @@
+// Package all compiles the built-in products into the operator. Each product registers itself with the product
+// registry from the init function of its package, importing this package makes every built-in product available
+// to installation types
+package all
+
+import (
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/amqonline"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/amqstreams"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/apicurioregistry"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/apicurito"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/cloudresources"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/codeready"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/datasync"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/fuse"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/fuseonopenshift"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/grafana"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/marin3r"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/monitoring"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/monitoringspec"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/rhsso"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/rhssouser"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/solutionexplorer"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/threescale"
+	_ "github.com/integr8ly/integreatly-operator/pkg/products/ups"
+)
@@ -0,0 +1,49 @@
+package all
+
+import (
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+)
+
+func TestRegisteredProducts(t *testing.T) {
+	builtIn := []integreatlyv1alpha1.ProductName{
+		integreatlyv1alpha1.ProductAMQStreams,
+		integreatlyv1alpha1.ProductAMQOnline,
+		integreatlyv1alpha1.ProductSolutionExplorer,
+		integreatlyv1alpha1.ProductRHSSO,
+		integreatlyv1alpha1.ProductRHSSOUser,
+		integreatlyv1alpha1.ProductCodeReadyWorkspaces,
+		integreatlyv1alpha1.ProductFuse,
+		integreatlyv1alpha1.ProductFuseOnOpenshift,
+		integreatlyv1alpha1.Product3Scale,
+		integreatlyv1alpha1.ProductUps,
+		integreatlyv1alpha1.ProductApicurioRegistry,
+		integreatlyv1alpha1.ProductApicurito,
+		integreatlyv1alpha1.ProductMonitoring,
+		integreatlyv1alpha1.ProductCloudResources,
+		integreatlyv1alpha1.ProductDataSync,
+		integreatlyv1alpha1.ProductMonitoringSpec,
+		integreatlyv1alpha1.ProductMarin3r,
+		integreatlyv1alpha1.ProductGrafana,
+	}
+
+	for _, product := range builtIn {
+		if !products.IsRegistered(product) {
+			t.Errorf("expected %s to be registered", product)
+		}
+	}
+	if len(products.RegisteredProducts()) != len(builtIn) {
+		t.Errorf("expected %d registered products but got %v", len(builtIn), products.RegisteredProducts())
+	}
+
+	version, operatorVersion, err := products.GetVersions(integreatlyv1alpha1.ProductUps)
+	if err != nil || version != integreatlyv1alpha1.VersionUps || operatorVersion != integreatlyv1alpha1.OperatorVersionUPS {
+		t.Errorf("expected the versions of ups to be registered, got %s and %s: %v", version, operatorVersion, err)
+	}
+	detector, err := products.GetPreflightObjectFunc(integreatlyv1alpha1.ProductCodeReadyWorkspaces)
+	if err != nil || detector.GetPreflightObject("test") == nil {
+		t.Errorf("expected codeready to be detected by the preflight checks: %v", err)
+	}
+}
@@ -18,6 +18,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "rhmi-amq-online-slo",
+				Product:   r.Config.GetProductName(),
 				GroupName: "amqonline.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -56,6 +57,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "amqonline-endpoint.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -124,6 +126,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-amqonline-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "general.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
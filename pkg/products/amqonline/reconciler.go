@@ -28,6 +28,7 @@ import (
 	croUtil "github.com/integr8ly/cloud-resource-operator/pkg/client"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
@@ -144,6 +145,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.AMQOnlineSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.AMQOnlineSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, serverClient, constants.AMQOnlineSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.AMQOnlineSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileNoneAuthenticationService(ctx, serverClient)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile 'none' auth service", err)
@@ -237,6 +250,16 @@ func (r *Reconciler) createResource(ctx context.Context, resourceName string, se
 	return resource, nil
 }
 
+// GetBackupExecutor returns the backups of the product performed on demand through RHMIBackup resources
+func (r *Reconciler) GetBackupExecutor() backup.BackupExecutor {
+	return r.preUpgradeBackupExecutor()
+}
+
+// GetRestoreJob returns the job restoring the backups of the postgres database and the persistent volumes
+func (r *Reconciler) GetRestoreJob(ctx context.Context, serverClient k8sclient.Client) (*batchv1.JobSpec, error) {
+	return resources.GetBackupRestoreJob(ctx, serverClient, r.ConfigManager, r.Config.GetNamespace(), "enmasse-postgres-backup", "enmasse-pv-backup")
+}
+
 func (r *Reconciler) preUpgradeBackupExecutor() backup.BackupExecutor {
 	return backup.NewConcurrentBackupExecutor(
 		backup.NewCronJobBackupExecutor(
@@ -556,20 +579,23 @@ func (r *Reconciler) reconcileBackup(ctx context.Context, serverClient k8sclient
 		},
 		Components: []resources.BackupComponent{
 			{
-				Name:     "enmasse-postgres-backup",
-				Type:     "postgres",
-				Secret:   resources.BackupSecretLocation{Name: r.Config.GetPostgresBackupSecretName(), Namespace: r.Config.GetNamespace()},
-				Schedule: r.Config.GetBackupSchedule(),
+				Name:                 "enmasse-postgres-backup",
+				Type:                 "postgres",
+				Secret:               resources.BackupSecretLocation{Name: r.Config.GetPostgresBackupSecretName(), Namespace: r.Config.GetNamespace()},
+				Schedule:             r.Config.GetBackupSchedule(),
+				VerificationSchedule: r.Config.GetBackupVerificationSchedule(),
 			},
 			{
-				Name:     "enmasse-pv-backup",
-				Type:     "enmasse_pv",
-				Schedule: r.Config.GetBackupSchedule(),
+				Name:                 "enmasse-pv-backup",
+				Type:                 "enmasse_pv",
+				Schedule:             r.Config.GetBackupSchedule(),
+				VerificationSchedule: r.Config.GetBackupVerificationSchedule(),
 			},
 			{
-				Name:     "resources-backup",
-				Type:     "amq_online_resources",
-				Schedule: r.Config.GetBackupSchedule(),
+				Name:                 "resources-backup",
+				Type:                 "amq_online_resources",
+				Schedule:             r.Config.GetBackupSchedule(),
+				VerificationSchedule: r.Config.GetBackupVerificationSchedule(),
 			},
 		},
 	}
@@ -600,15 +626,18 @@ func (r *Reconciler) reconcileBlackboxTargets(ctx context.Context, installation
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.ProductAMQOnline)
 	target := marketplace.Target{
-		Pkg:       constants.AMQOnlineSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.AMQOnlineSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.ProductAMQOnline),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
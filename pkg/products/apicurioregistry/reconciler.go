@@ -133,6 +133,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, client, constants.ApicurioRegistrySubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.ApicurioRegistrySubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, client, constants.ApicurioRegistrySubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.ApicurioRegistrySubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileStorage(ctx, client)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile storage", err)
@@ -279,15 +291,18 @@ func (r *Reconciler) reconcileConfig(ctx context.Context, client k8sclient.Clien
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.ProductApicurioRegistry)
 	target := marketplace.Target{
-		Pkg:       constants.ApicurioRegistrySubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.ApicurioRegistrySubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.ProductApicurioRegistry),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
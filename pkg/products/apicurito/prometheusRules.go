@@ -18,6 +18,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-apicurito-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetNamespace(),
 				GroupName: "apicurito.rules",
 				Rules: []monitoringv1.Rule{
@@ -37,6 +38,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetNamespace(),
 				GroupName: "apicurito-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -65,6 +67,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "apicurito-operator-endpoint.rules",
 				Namespace: r.Config.GetOperatorNamespace(),
 				Rules: []monitoringv1.Rule{
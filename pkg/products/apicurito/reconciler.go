@@ -167,6 +167,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.ApicuritoSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.ApicuritoSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, serverClient, constants.ApicuritoSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.ApicuritoSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileComponents(ctx, installation, serverClient)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile components", err)
@@ -545,15 +557,18 @@ func (r *Reconciler) reconcileBlackboxTargets(ctx context.Context, installation
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.ProductApicurito)
 	target := marketplace.Target{
-		Pkg:       constants.ApicuritoSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.ApicuritoSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.ProductApicurito),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
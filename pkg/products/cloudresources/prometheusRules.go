@@ -16,6 +16,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetOperatorNamespace(),
 				GroupName: "cloud-resources-operator-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -141,6 +141,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, client, constants.CloudResourceSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.CloudResourceSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, client, constants.CloudResourceSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.CloudResourceSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileBackupsStorage(ctx, installation, client)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		return phase, err
@@ -297,15 +309,18 @@ func (r *Reconciler) reconcileBackupsStorage(ctx context.Context, installation *
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.ProductCloudResources)
 	target := marketplace.Target{
-		Pkg:       constants.CloudResourceSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.CloudResourceSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.ProductCloudResources),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
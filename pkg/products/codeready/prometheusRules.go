@@ -16,6 +16,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetNamespace(),
 				GroupName: "codeready-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -54,6 +55,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetOperatorNamespace(),
 				GroupName: "code-ready-operator-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -72,6 +74,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-codeready-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetNamespace(),
 				GroupName: "general.rules",
 				Rules: []monitoringv1.Rule{
@@ -25,11 +25,14 @@ import (
 	"github.com/integr8ly/integreatly-operator/pkg/products/monitoring"
 	"github.com/integr8ly/integreatly-operator/pkg/resources"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
+	userHelper "github.com/integr8ly/integreatly-operator/pkg/resources/user"
 	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
 
 	"github.com/integr8ly/integreatly-operator/pkg/resources/constants"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -43,6 +46,8 @@ const (
 	defaultClientName            = "che-client"
 	defaultCheClusterName        = "rhmi-cluster"
 	manifestPackage              = "integreatly-codeready-workspaces"
+	adminsRoleBindingName        = "rhmi-admins-codeready-view"
+	clusterViewRoleName          = "view"
 )
 
 type Reconciler struct {
@@ -141,6 +146,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.CodeReadySubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.CodeReadySubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileExternalDatasources(ctx, serverClient)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile external data sources", err)
@@ -153,12 +164,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedVersion, err = r.getObservedVersion(ctx, serverClient)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, "Failed to read the installed CodeReady version", err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileKeycloakClient(ctx, serverClient)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile keycloak client", err)
 		return phase, err
 	}
 
+	phase, err = r.reconcileAdminPermissions(ctx, serverClient)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		events.HandleError(r.recorder, installation, phase, "Failed to reconcile admin permissions", err)
+		return phase, err
+	}
+
 	phase, err = r.reconcileBlackboxTargets(ctx, serverClient)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile blackbox targets", err)
@@ -186,6 +209,38 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 	return integreatlyv1alpha1.PhaseCompleted, nil
 }
 
+// Ensures the admin groups of CodeReady, dedicated-admins by default, can view the CodeReady namespace
+func (r *Reconciler) reconcileAdminPermissions(ctx context.Context, serverClient k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
+	policy, err := userHelper.GetPolicy(ctx, serverClient)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	viewRoleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      adminsRoleBindingName,
+			Namespace: r.Config.GetNamespace(),
+		},
+	}
+
+	or, err := controllerutil.CreateOrUpdate(ctx, serverClient, viewRoleBinding, func() error {
+		owner.AddIntegreatlyOwnerAnnotations(viewRoleBinding, r.installation)
+
+		viewRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     clusterViewRoleName,
+		}
+		viewRoleBinding.Subjects = policy.AdminSubjects(integreatlyv1alpha1.ProductCodeReadyWorkspaces)
+		return nil
+	})
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to reconcile admin role binding %s: %w", viewRoleBinding.Name, err)
+	}
+	r.logger.Infof("The operation result for rolebinding %s was %s", viewRoleBinding.Name, or)
+	return integreatlyv1alpha1.PhaseCompleted, nil
+}
+
 func (r *Reconciler) reconcileExternalDatasources(ctx context.Context, serverClient k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
 	logrus.Infof("Reconciling external datastore")
 	ns := r.installation.Namespace
@@ -305,9 +360,10 @@ func (r *Reconciler) reconcileBackups(ctx context.Context, serverClient k8sclien
 		BackendSecret: resources.BackupSecretLocation{Name: r.Config.GetBackupsSecretName(), Namespace: r.Config.GetNamespace()},
 		Components: []resources.BackupComponent{
 			{
-				Name:     "codeready-pv-backup",
-				Type:     "codeready_pv",
-				Schedule: r.Config.GetBackupSchedule(),
+				Name:                 "codeready-pv-backup",
+				Type:                 "codeready_pv",
+				Schedule:             r.Config.GetBackupSchedule(),
+				VerificationSchedule: r.Config.GetBackupVerificationSchedule(),
 			},
 		},
 	}
@@ -339,6 +395,16 @@ func (r *Reconciler) handleProgressPhase(ctx context.Context, serverClient k8scl
 	return integreatlyv1alpha1.PhaseCompleted, nil
 }
 
+// getObservedVersion reads the version of CodeReady running on the cluster from the status of the CheCluster CR. An
+// empty version is returned until the CodeReady operator reports it
+func (r *Reconciler) getObservedVersion(ctx context.Context, serverClient k8sclient.Client) (integreatlyv1alpha1.ProductVersion, error) {
+	cheCluster := &chev1.CheCluster{}
+	if err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: defaultCheClusterName, Namespace: r.Config.GetNamespace()}, cheCluster); err != nil {
+		return "", fmt.Errorf("could not retrieve checluster: %w", err)
+	}
+	return integreatlyv1alpha1.ProductVersion(cheCluster.Status.CheVersion), nil
+}
+
 func (r *Reconciler) reconcileKeycloakClient(ctx context.Context, serverClient k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
 	r.logger.Infof("checking keycloak client exists for che")
 	kcConfig, err := r.ConfigManager.ReadRHSSO()
@@ -475,6 +541,16 @@ func (r *Reconciler) createCheCluster(ctx context.Context, kcCfg *config.RHSSO,
 	return cheCluster, nil
 }
 
+// GetBackupExecutor returns the backups of the product performed on demand through RHMIBackup resources
+func (r *Reconciler) GetBackupExecutor() backup.BackupExecutor {
+	return r.preUpgradeBackupExecutor()
+}
+
+// GetRestoreJob returns the job restoring the backups of the persistent volumes
+func (r *Reconciler) GetRestoreJob(ctx context.Context, serverClient k8sclient.Client) (*batchv1.JobSpec, error) {
+	return resources.GetBackupRestoreJob(ctx, serverClient, r.ConfigManager, r.Config.GetNamespace(), "codeready-pv-backup")
+}
+
 func (r *Reconciler) preUpgradeBackupExecutor() backup.BackupExecutor {
 	pvBackup := backup.NewCronJobBackupExecutor(
 		"codeready-pv-backup",
@@ -609,15 +685,18 @@ func getKeycloakClientSpec(cheURL string) keycloak.KeycloakClientSpec {
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.ProductCodeReadyWorkspaces)
 	target := marketplace.Target{
-		Pkg:       constants.CodeReadySubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.CodeReadySubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.ProductCodeReadyWorkspaces),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
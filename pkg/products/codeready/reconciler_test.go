@@ -93,6 +93,9 @@ func basicConfigMock() *config.ConfigReadWriterMock {
 		GetBackupsSecretNameFunc: func() string {
 			return "backups-s3-credentials"
 		},
+		ReadBackupFunc: func() (*config.Backup, error) {
+			return config.NewBackup(config.ProductConfig{}), nil
+		},
 	}
 }
 
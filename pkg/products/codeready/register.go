@@ -0,0 +1,23 @@
+package codeready
+
+import (
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+)
+
+func init() {
+	products.Register(integreatlyv1alpha1.ProductCodeReadyWorkspaces, products.Registration{
+		Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+			return NewReconciler(deps.ConfigManager, deps.Installation, deps.MPM, deps.Recorder)
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewCodeReady(cfg)
+		},
+		PreflightObject: (&Reconciler{}).GetPreflightObject,
+		Version:         integreatlyv1alpha1.VersionCodeReadyWorkspaces,
+		OperatorVersion: integreatlyv1alpha1.OperatorVersionCodeReadyWorkspaces,
+	})
+	grafana.RegisterProductDashboard(integreatlyv1alpha1.ProductCodeReadyWorkspaces, GetDashboard)
+}
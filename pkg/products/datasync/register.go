@@ -0,0 +1,19 @@
+package datasync
+
+import (
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+)
+
+func init() {
+	products.Register(integreatlyv1alpha1.ProductDataSync, products.Registration{
+		Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+			return NewReconciler(deps.ConfigManager, deps.Installation, deps.MPM, deps.Recorder)
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewDataSync(cfg)
+		},
+		Version: integreatlyv1alpha1.VersionDataSync,
+	})
+}
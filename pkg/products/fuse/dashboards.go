@@ -0,0 +1,14 @@
+package fuse
+
+import (
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+)
+
+// GetDashboard returns the panels Fuse adds to the dashboards of the monitoring stack
+func GetDashboard(installation *integreatlyv1alpha1.RHMI) grafana.ProductDashboard {
+	return grafana.ProductDashboard{
+		Namespace:   installation.Spec.NamespacePrefix + defaultInstallationNamespace,
+		AlertPrefix: "Fuse",
+	}
+}
@@ -16,6 +16,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetNamespace(),
 				GroupName: "fuse-online-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -84,6 +85,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetOperatorNamespace(),
 				GroupName: "fuse-online-operator-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -112,6 +114,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-fuse-online-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetNamespace(),
 				GroupName: "general.rules",
 				Rules: []monitoringv1.Rule{
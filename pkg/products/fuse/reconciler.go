@@ -25,6 +25,7 @@ import (
 	"github.com/integr8ly/integreatly-operator/pkg/products/monitoring"
 	"github.com/integr8ly/integreatly-operator/pkg/resources"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
+	userHelper "github.com/integr8ly/integreatly-operator/pkg/resources/user"
 
 	appsv1 "github.com/openshift/api/apps/v1"
 	routev1 "github.com/openshift/api/route/v1"
@@ -43,7 +44,7 @@ import (
 const (
 	defaultInstallationNamespace = "fuse"
 	defaultFusePullSecret        = "syndesis-pull-secret"
-	developersGroupName          = "rhmi-developers"
+	developersRoleBindingName    = "rhmi-developers-fuse-view"
 	clusterViewRoleName          = "view"
 	manifestPackage              = "integreatly-fuse-online"
 	syndesisPrometheusPVC        = "10Gi"
@@ -171,6 +172,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.FuseSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.FuseSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, serverClient, constants.FuseSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.FuseSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileCloudResources(ctx, installation, serverClient)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile cloud resources", err)
@@ -249,12 +262,18 @@ func (r *Reconciler) reconcileTemplates(ctx context.Context, serverClient k8scli
 	return integreatlyv1alpha1.PhaseCompleted, nil
 }
 
-// Ensures all users in rhmi-developers group have view Fuse permissions
+// Ensures all users in the developers group, rhmi-developers by default, have view Fuse permissions
 func (r *Reconciler) reconcileViewFusePerms(ctx context.Context, client k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
+	policy, err := userHelper.GetPolicy(ctx, client)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+	developersGroupName := policy.DevelopersGroup()
+
 	r.logger.Infof("Reconciling view Fuse permissions for %s group on %s namespace", developersGroupName, r.Config.GetNamespace())
 	viewFuseRoleBinding := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      developersGroupName + "-fuse-view",
+			Name:      developersRoleBindingName,
 			Namespace: r.Config.GetNamespace(),
 		},
 		RoleRef: rbacv1.RoleRef{
@@ -452,6 +471,16 @@ func (r *Reconciler) reconcileBlackboxTargets(ctx context.Context, installation
 
 	return integreatlyv1alpha1.PhaseCompleted, nil
 }
+
+// GetBackupExecutor returns the backups of the product performed on demand through RHMIBackup resources. The product
+// data is not backed up on cluster storage, where the backups are reported as unsupported
+func (r *Reconciler) GetBackupExecutor() backup.BackupExecutor {
+	if r.installation.Spec.UseClusterStorage != "false" {
+		return backup.NewUnsupportedBackupExecutor(backup.ClusterStorageUnsupportedReason)
+	}
+	return preUpgradeBackupExecutor(r.installation)
+}
+
 func preUpgradeBackupExecutor(rhmi *integreatlyv1alpha1.RHMI) backup.BackupExecutor {
 	pgName := fmt.Sprintf("%s%s", constants.FusePostgresPrefix, rhmi.Name)
 	if rhmi.Spec.UseClusterStorage != "false" {
@@ -466,15 +495,18 @@ func preUpgradeBackupExecutor(rhmi *integreatlyv1alpha1.RHMI) backup.BackupExecu
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.ProductFuse)
 	target := marketplace.Target{
-		Pkg:       constants.FuseSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.FuseSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.ProductFuse),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
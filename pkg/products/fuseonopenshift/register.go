@@ -0,0 +1,22 @@
+package fuseonopenshift
+
+import (
+	"net/http"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+)
+
+func init() {
+	products.Register(integreatlyv1alpha1.ProductFuseOnOpenshift, products.Registration{
+		Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+			return NewReconciler(deps.ConfigManager, deps.Installation, deps.MPM, deps.Recorder, &http.Client{}, "")
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewFuseOnOpenshift(cfg)
+		},
+		Version:         integreatlyv1alpha1.VersionFuseOnOpenshift,
+		OperatorVersion: integreatlyv1alpha1.OperatorVersionFuse,
+	})
+}
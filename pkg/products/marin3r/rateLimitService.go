@@ -2,7 +2,9 @@ package marin3r
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"sort"
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	"gopkg.in/yaml.v2"
@@ -15,10 +17,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+const (
+	rateLimitConfigMapName = "ratelimit-config"
+	// configHashAnnotation is set on the pods of the rate limit service so that they are rolled out when the
+	// configuration changes
+	configHashAnnotation = "integreatly.org/ratelimit-config-hash"
+)
+
 type RateLimitServiceReconciler struct {
 	Namespace       string
 	RedisSecretName string
 	StatsdConfig    *StatsdConfig
+	Policy          *integreatlyv1alpha1.RateLimitPolicy
 }
 
 type StatsdConfig struct {
@@ -43,9 +53,9 @@ type yamlRateLimit struct {
 
 type yamlDescriptor struct {
 	Key         string
-	Value       string
-	RateLimit   *yamlRateLimit `yaml:"rate_limit"`
-	Descriptors []yamlDescriptor
+	Value       string           `yaml:",omitempty"`
+	RateLimit   *yamlRateLimit   `yaml:"rate_limit,omitempty"`
+	Descriptors []yamlDescriptor `yaml:",omitempty"`
 }
 
 type yamlRoot struct {
@@ -57,12 +67,12 @@ type yamlRoot struct {
 // It reconciles a ConfigMap to configure the service, a Deployment to run it, and
 // exposes it as a Service
 func (r *RateLimitServiceReconciler) ReconcileRateLimitService(ctx context.Context, client k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
-	phase, err := r.reconcileConfigMap(ctx, client)
+	configHash, err := r.reconcileConfigMap(ctx, client)
 	if err != nil {
 		return integreatlyv1alpha1.PhaseFailed, err
 	}
 
-	phase, err = r.reconcileDeployment(ctx, client)
+	phase, err := r.reconcileDeployment(ctx, client, configHash)
 	if phase != integreatlyv1alpha1.PhaseCompleted {
 		return phase, err
 	}
@@ -76,53 +86,121 @@ func (r *RateLimitServiceReconciler) WithStatsdConfig(config StatsdConfig) *Rate
 	return r
 }
 
-func (r *RateLimitServiceReconciler) reconcileConfigMap(ctx context.Context, client k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
+// WithPolicy mutates r setting r.Policy to the rate limit policy of the RHMIConfig
+func (r *RateLimitServiceReconciler) WithPolicy(policy *integreatlyv1alpha1.RateLimitPolicy) *RateLimitServiceReconciler {
+	r.Policy = policy
+	return r
+}
+
+// reconcileConfigMap renders the policy as one configuration file per domain and returns the hash of the
+// configuration. The policy is validated as by the RHMIConfig webhook before it is written, an invalid one leaves
+// the previous configuration in place
+func (r *RateLimitServiceReconciler) reconcileConfigMap(ctx context.Context, client k8sclient.Client) (string, error) {
+	if r.Policy != nil {
+		if err := integreatlyv1alpha1.ValidateRateLimitPolicy(*r.Policy); err != nil {
+			return "", fmt.Errorf("rate limit configuration is not valid: %w", err)
+		}
+	}
+
+	data := map[string]string{}
+	for _, config := range newRateLimitConfigs(r.Policy) {
+		configYamlMarshalled, err := yaml.Marshal(config)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshall rate limit config: %v", err)
+		}
+		data[config.Domain+".yaml"] = string(configYamlMarshalled)
+	}
+
 	cm := &corev1.ConfigMap{
 		ObjectMeta: v1.ObjectMeta{
-			Name:      "ratelimit-config",
+			Name:      rateLimitConfigMapName,
 			Namespace: r.Namespace,
 		},
 	}
 
 	_, err := controllerutil.CreateOrUpdate(ctx, client, cm, func() error {
-		configYaml := yamlRoot{
-			Domain: "kuard",
-			Descriptors: []yamlDescriptor{
-				{
-					Key:   "generic_key",
-					Value: "slowpath",
-					RateLimit: &yamlRateLimit{
-						Unit:            "minute",
-						RequestsPerUnit: 1,
-					},
-				},
-			},
-		}
-		configYamlMarshalled, err := yaml.Marshal(configYaml)
-		if err != nil {
-			return fmt.Errorf("failed to marshall rate limit config: %v", err)
-		}
-
-		if cm.Data == nil {
-			cm.Data = map[string]string{}
-		}
 		if cm.Labels == nil {
 			cm.Labels = map[string]string{}
 		}
 
-		cm.Data["kuard.yaml"] = string(configYamlMarshalled)
+		cm.Data = data
 		cm.Labels["app"] = "ratelimit"
 		cm.Labels["part-of"] = "3scale-saas"
 		return nil
 	})
 	if err != nil {
-		return integreatlyv1alpha1.PhaseFailed, err
+		return "", err
 	}
 
-	return integreatlyv1alpha1.PhaseCompleted, nil
+	return configHash(data), nil
 }
 
-func (r *RateLimitServiceReconciler) reconcileDeployment(ctx context.Context, client k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
+// newRateLimitConfigs returns the configuration of each domain of the policy. A placeholder configuration is
+// returned when the policy has no domains
+func newRateLimitConfigs(policy *integreatlyv1alpha1.RateLimitPolicy) []yamlRoot {
+	if policy == nil || len(policy.Domains) == 0 {
+		return []yamlRoot{
+			{
+				Domain: "kuard",
+				Descriptors: []yamlDescriptor{
+					{
+						Key:   "generic_key",
+						Value: "slowpath",
+						RateLimit: &yamlRateLimit{
+							Unit:            "minute",
+							RequestsPerUnit: 1,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	configs := make([]yamlRoot, 0, len(policy.Domains))
+	for _, domain := range policy.Domains {
+		configs = append(configs, yamlRoot{
+			Domain:      domain.Domain,
+			Descriptors: newYamlDescriptors(domain.Descriptors),
+		})
+	}
+	return configs
+}
+
+func newYamlDescriptors(descriptors []integreatlyv1alpha1.RateLimitDescriptor) []yamlDescriptor {
+	var result []yamlDescriptor
+	for _, descriptor := range descriptors {
+		yamlDescriptor := yamlDescriptor{
+			Key:         descriptor.Key,
+			Value:       descriptor.Value,
+			Descriptors: newYamlDescriptors(descriptor.Descriptors),
+		}
+		if descriptor.RateLimit != nil {
+			yamlDescriptor.RateLimit = &yamlRateLimit{
+				RequestsPerUnit: descriptor.RateLimit.RequestsPerUnit,
+				Unit:            string(descriptor.RateLimit.Unit),
+			}
+		}
+		result = append(result, yamlDescriptor)
+	}
+	return result
+}
+
+// configHash returns a hash of the configuration files, independent of the order of the keys
+func configHash(data map[string]string) string {
+	files := make([]string, 0, len(data))
+	for file := range data {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	hash := sha256.New()
+	for _, file := range files {
+		fmt.Fprintf(hash, "%s\n%s\n", file, data[file])
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+func (r *RateLimitServiceReconciler) reconcileDeployment(ctx context.Context, client k8sclient.Client, configHash string) (integreatlyv1alpha1.StatusPhase, error) {
 	redisSecret, err := r.getRedisSecret(ctx, client)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -201,6 +279,9 @@ func (r *RateLimitServiceReconciler) reconcileDeployment(ctx context.Context, cl
 				Labels: map[string]string{
 					"app": "ratelimit",
 				},
+				Annotations: map[string]string{
+					configHashAnnotation: configHash,
+				},
 			},
 			Spec: corev1.PodSpec{
 				Containers: []corev1.Container{
@@ -237,7 +318,7 @@ func (r *RateLimitServiceReconciler) reconcileDeployment(ctx context.Context, cl
 						VolumeSource: corev1.VolumeSource{
 							ConfigMap: &corev1.ConfigMapVolumeSource{
 								LocalObjectReference: corev1.LocalObjectReference{
-									Name: "ratelimit-config",
+									Name: rateLimitConfigMapName,
 								},
 							},
 						},
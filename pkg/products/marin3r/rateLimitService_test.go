@@ -162,6 +162,97 @@ func TestRateLimitService(t *testing.T) {
 	}
 }
 
+func TestRateLimitServicePolicy(t *testing.T) {
+	redisSecret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "ratelimit-redis",
+			Namespace: "redhat-test-marin3r",
+		},
+		Data: map[string][]byte{
+			"URL": []byte("test-url"),
+		},
+	}
+	policy := &integreatlyv1alpha1.RateLimitPolicy{
+		Domains: []integreatlyv1alpha1.RateLimitDomain{
+			{
+				Domain: "apicast-ratelimit",
+				Descriptors: []integreatlyv1alpha1.RateLimitDescriptor{
+					{
+						Key: "tenant",
+						RateLimit: &integreatlyv1alpha1.RateLimit{
+							RequestsPerUnit: 100,
+							Unit:            integreatlyv1alpha1.RateLimitUnitSecond,
+						},
+						Descriptors: []integreatlyv1alpha1.RateLimitDescriptor{
+							{
+								Key:   "api",
+								Value: "orders",
+								RateLimit: &integreatlyv1alpha1.RateLimit{
+									RequestsPerUnit: 10,
+									Unit:            integreatlyv1alpha1.RateLimitUnitMinute,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	client := fake.NewFakeClientWithScheme(newScheme(), redisSecret)
+
+	phase, err := NewRateLimitServiceReconciler("redhat-test-marin3r", "ratelimit-redis").WithPolicy(policy).ReconcileRateLimitService(context.TODO(), client)
+	if err := allOf(assertNoError, assertPhase(integreatlyv1alpha1.PhaseCompleted))(client, phase, err); err != nil {
+		t.Fatal(err)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "ratelimit-config", Namespace: "redhat-test-marin3r"}, configMap); err != nil {
+		t.Fatalf("failed to obtain expected ConfigMap: %v", err)
+	}
+	expectedConfig := `domain: apicast-ratelimit
+descriptors:
+- key: tenant
+  rate_limit:
+    requests_per_unit: 100
+    unit: second
+  descriptors:
+  - key: api
+    value: orders
+    rate_limit:
+      requests_per_unit: 10
+      unit: minute
+`
+	if len(configMap.Data) != 1 || configMap.Data["apicast-ratelimit.yaml"] != expectedConfig {
+		t.Fatalf("unexpected rate limit configuration: %v", configMap.Data)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "ratelimit", Namespace: "redhat-test-marin3r"}, deployment); err != nil {
+		t.Fatalf("failed to obtain deployment: %v", err)
+	}
+	hash := deployment.Spec.Template.Annotations[configHashAnnotation]
+	if hash == "" {
+		t.Fatalf("expected the pods to be annotated with the hash of the configuration")
+	}
+
+	policy.Domains[0].Descriptors[0].RateLimit.RequestsPerUnit = 50
+	if _, err := NewRateLimitServiceReconciler("redhat-test-marin3r", "ratelimit-redis").WithPolicy(policy).ReconcileRateLimitService(context.TODO(), client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "ratelimit", Namespace: "redhat-test-marin3r"}, deployment); err != nil {
+		t.Fatalf("failed to obtain deployment: %v", err)
+	}
+	if deployment.Spec.Template.Annotations[configHashAnnotation] == hash {
+		t.Fatalf("expected the hash of the configuration to change with the policy")
+	}
+
+	policy.Domains = append(policy.Domains, integreatlyv1alpha1.RateLimitDomain{Domain: "apicast-ratelimit"})
+	phase, err = NewRateLimitServiceReconciler("redhat-test-marin3r", "ratelimit-redis").WithPolicy(policy).ReconcileRateLimitService(context.TODO(), client)
+	if err == nil || phase != integreatlyv1alpha1.PhaseFailed {
+		t.Fatalf("expected a duplicate domain to fail the reconcile, got phase %s", phase)
+	}
+}
+
 func newScheme() *runtime.Scheme {
 	scheme := runtime.NewScheme()
 	corev1.AddToScheme(scheme)
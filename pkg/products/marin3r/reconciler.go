@@ -138,6 +138,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, client, constants.Marin3rSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.Marin3rSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, client, constants.Marin3rSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.Marin3rSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	logrus.Infof("about to start reconciling the discovery service")
 	phase, err = r.reconcileDiscoveryService(ctx, client, productNamespace, installation.Spec.NamespacePrefix)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
@@ -153,7 +165,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, nil
 	}
 
+	rateLimitPolicy, err := r.getRateLimitPolicy(ctx, client)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, "Failed to read the rate limit policy", err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = NewRateLimitServiceReconciler(productNamespace, externalRedisSecretName).
+		WithPolicy(rateLimitPolicy).
 		ReconcileRateLimitService(ctx, client)
 	if err != nil {
 		events.HandleError(r.recorder, installation, phase, "Failed to reconcile rate limit service", err)
@@ -166,6 +185,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 	return integreatlyv1alpha1.PhaseCompleted, nil
 }
 
+// getRateLimitPolicy returns the rate limit policy of the RHMIConfig, or nil when the RHMIConfig does not exist
+func (r *Reconciler) getRateLimitPolicy(ctx context.Context, client k8sclient.Client) (*integreatlyv1alpha1.RateLimitPolicy, error) {
+	rhmiConfig := &integreatlyv1alpha1.RHMIConfig{}
+	err := client.Get(ctx, k8sclient.ObjectKey{Name: resources.RHMIConfigName, Namespace: r.installation.Namespace}, rhmiConfig)
+	if k8serr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve rhmi config: %w", err)
+	}
+	return &rhmiConfig.Spec.RateLimit, nil
+}
+
 func (r *Reconciler) reconcileRedis(ctx context.Context, client k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
 	logrus.Info("Creating backend redis instance in marine3r reconcile")
 
@@ -270,15 +302,18 @@ func (r *Reconciler) deleteDiscoveryService(ctx context.Context, client k8sclien
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := r.installation.GetProductSpec(integreatlyv1alpha1.ProductMarin3r)
 	target := marketplace.Target{
-		Pkg:       constants.Marin3rSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.Marin3rSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		r.installation.GetCatalogSource(integreatlyv1alpha1.ProductMarin3r),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
@@ -0,0 +1,344 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// deadMansSwitchReceiver only receives the DeadMansSwitch alert, the routes of the configured receivers are
+	// added after its route so that the alert that is always firing is not sent to them
+	deadMansSwitchReceiver = "deadmansswitch"
+
+	alertReceiverURLKey       = "url"
+	alertReceiverAPIKeyKey    = "apiKey"
+	alertReceiverAPIURLKey    = "apiURL"
+	alertReceiverSeverityKey  = "severity"
+	alertReceiverProductKey   = resources.AlertProductLabel
+	alertReceiverNamespaceKey = "namespace"
+)
+
+// alertmanagerConfig is the part of the Alertmanager configuration generated by the operator. It is parsed strictly,
+// a field it does not know fails the validation instead of being dropped from the configuration
+type alertmanagerConfig struct {
+	Global       *alertmanagerGlobal       `json:"global,omitempty"`
+	Route        *alertmanagerRoute        `json:"route"`
+	Receivers    []alertmanagerReceiver    `json:"receivers"`
+	InhibitRules []alertmanagerInhibitRule `json:"inhibit_rules,omitempty"`
+}
+
+type alertmanagerGlobal struct {
+	ResolveTimeout   string `json:"resolve_timeout,omitempty"`
+	SMTPSmarthost    string `json:"smtp_smarthost,omitempty"`
+	SMTPFrom         string `json:"smtp_from,omitempty"`
+	SMTPAuthUsername string `json:"smtp_auth_username,omitempty"`
+	SMTPAuthPassword string `json:"smtp_auth_password,omitempty"`
+}
+
+type alertmanagerRoute struct {
+	Receiver       string               `json:"receiver,omitempty"`
+	GroupBy        []string             `json:"group_by,omitempty"`
+	GroupWait      string               `json:"group_wait,omitempty"`
+	GroupInterval  string               `json:"group_interval,omitempty"`
+	RepeatInterval string               `json:"repeat_interval,omitempty"`
+	Match          map[string]string    `json:"match,omitempty"`
+	MatchRE        map[string]string    `json:"match_re,omitempty"`
+	Continue       bool                 `json:"continue,omitempty"`
+	Routes         []*alertmanagerRoute `json:"routes,omitempty"`
+}
+
+type alertmanagerReceiver struct {
+	Name             string                        `json:"name"`
+	EmailConfigs     []alertmanagerEmailConfig     `json:"email_configs,omitempty"`
+	PagerdutyConfigs []alertmanagerPagerdutyConfig `json:"pagerduty_configs,omitempty"`
+	WebhookConfigs   []alertmanagerWebhookConfig   `json:"webhook_configs,omitempty"`
+	SlackConfigs     []alertmanagerSlackConfig     `json:"slack_configs,omitempty"`
+	OpsGenieConfigs  []alertmanagerOpsGenieConfig  `json:"opsgenie_configs,omitempty"`
+}
+
+type alertmanagerEmailConfig struct {
+	SendResolved bool   `json:"send_resolved,omitempty"`
+	To           string `json:"to"`
+}
+
+type alertmanagerPagerdutyConfig struct {
+	SendResolved bool   `json:"send_resolved,omitempty"`
+	ServiceKey   string `json:"service_key"`
+}
+
+type alertmanagerWebhookConfig struct {
+	SendResolved bool   `json:"send_resolved,omitempty"`
+	URL          string `json:"url"`
+}
+
+type alertmanagerSlackConfig struct {
+	SendResolved bool   `json:"send_resolved,omitempty"`
+	APIURL       string `json:"api_url"`
+	Channel      string `json:"channel,omitempty"`
+}
+
+type alertmanagerOpsGenieConfig struct {
+	SendResolved bool   `json:"send_resolved,omitempty"`
+	APIKey       string `json:"api_key"`
+	APIURL       string `json:"api_url,omitempty"`
+}
+
+type alertmanagerInhibitRule struct {
+	SourceMatch map[string]string `json:"source_match,omitempty"`
+	TargetMatch map[string]string `json:"target_match,omitempty"`
+	Equal       []string          `json:"equal,omitempty"`
+}
+
+// parseAlertmanagerConfig parses the Alertmanager configuration, failing on the fields it does not know
+func parseAlertmanagerConfig(data []byte) (*alertmanagerConfig, error) {
+	cfg := &alertmanagerConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg, func(d *json.Decoder) *json.Decoder {
+		d.DisallowUnknownFields()
+		return d
+	}); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// buildAlertmanagerConfig adds the receivers and their routes to the configuration rendered from the template and
+// validates the result. The rendered configuration is returned unchanged when there are no receivers to add
+func buildAlertmanagerConfig(rendered []byte, receivers []alertmanagerReceiver, routes []*alertmanagerRoute) ([]byte, error) {
+	cfg, err := parseAlertmanagerConfig(rendered)
+	if err != nil {
+		return nil, err
+	}
+	if len(receivers) == 0 {
+		if err := validateAlertmanagerConfig(cfg); err != nil {
+			return nil, err
+		}
+		return rendered, nil
+	}
+
+	cfg.Receivers = append(cfg.Receivers, receivers...)
+
+	// the routes of the receivers continue, so the alerts they match are still routed by the routes of the template.
+	// An alert matched by a child route is not handled by the root route, the alerts only matched by the routes of the
+	// receivers are sent to the root receiver by a last route without matchers
+	position := 0
+	for i, route := range cfg.Route.Routes {
+		if route.Receiver == deadMansSwitchReceiver {
+			position = i + 1
+			break
+		}
+	}
+	merged := make([]*alertmanagerRoute, 0, len(cfg.Route.Routes)+len(routes)+1)
+	merged = append(merged, cfg.Route.Routes[:position]...)
+	merged = append(merged, routes...)
+	merged = append(merged, cfg.Route.Routes[position:]...)
+	merged = append(merged, &alertmanagerRoute{Receiver: cfg.Route.Receiver})
+	cfg.Route.Routes = merged
+
+	if err := validateAlertmanagerConfig(cfg); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(cfg)
+}
+
+// validateAlertmanagerConfig checks the configuration for the errors that would stop Alertmanager from loading it
+func validateAlertmanagerConfig(cfg *alertmanagerConfig) error {
+	if cfg.Route == nil {
+		return fmt.Errorf("no route is defined")
+	}
+	if cfg.Route.Receiver == "" {
+		return fmt.Errorf("the root route has no receiver")
+	}
+	if len(cfg.Route.Match) > 0 || len(cfg.Route.MatchRE) > 0 {
+		return fmt.Errorf("the root route must not have any matchers")
+	}
+
+	names := map[string]bool{}
+	for _, receiver := range cfg.Receivers {
+		if receiver.Name == "" {
+			return fmt.Errorf("a receiver has no name")
+		}
+		if names[receiver.Name] {
+			return fmt.Errorf("receiver %s is defined more than once", receiver.Name)
+		}
+		names[receiver.Name] = true
+
+		if err := validateAlertmanagerReceiver(receiver); err != nil {
+			return fmt.Errorf("receiver %s is not valid: %w", receiver.Name, err)
+		}
+	}
+
+	return validateAlertmanagerRoute(cfg.Route, names)
+}
+
+func validateAlertmanagerRoute(route *alertmanagerRoute, receivers map[string]bool) error {
+	if route.Receiver != "" && !receivers[route.Receiver] {
+		return fmt.Errorf("receiver %s of a route is not defined", route.Receiver)
+	}
+	for label, expr := range route.MatchRE {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("route to %s matches label %s with an invalid regular expression: %w", route.Receiver, label, err)
+		}
+	}
+	for _, child := range route.Routes {
+		if err := validateAlertmanagerRoute(child, receivers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAlertmanagerReceiver(receiver alertmanagerReceiver) error {
+	for _, email := range receiver.EmailConfigs {
+		if email.To == "" {
+			return fmt.Errorf("email address is undefined")
+		}
+	}
+	for _, pagerduty := range receiver.PagerdutyConfigs {
+		if pagerduty.ServiceKey == "" {
+			return fmt.Errorf("pagerduty service key is undefined")
+		}
+	}
+	for _, webhook := range receiver.WebhookConfigs {
+		if err := validateAlertmanagerURL(webhook.URL); err != nil {
+			return fmt.Errorf("webhook url is not valid: %w", err)
+		}
+	}
+	for _, slack := range receiver.SlackConfigs {
+		if err := validateAlertmanagerURL(slack.APIURL); err != nil {
+			return fmt.Errorf("slack api url is not valid: %w", err)
+		}
+	}
+	for _, opsgenie := range receiver.OpsGenieConfigs {
+		if opsgenie.APIKey == "" {
+			return fmt.Errorf("opsgenie api key is undefined")
+		}
+		if opsgenie.APIURL == "" {
+			continue
+		}
+		if err := validateAlertmanagerURL(opsgenie.APIURL); err != nil {
+			return fmt.Errorf("opsgenie api url is not valid: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateAlertmanagerURL(value string) error {
+	if value == "" {
+		return fmt.Errorf("url is undefined")
+	}
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q in %s", parsed.Scheme, value)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("no host in %s", value)
+	}
+	return nil
+}
+
+// getAlertReceivers builds the Alertmanager receivers and routes of spec.alerting.receivers, reading their
+// credentials from the referenced secrets
+func (r *Reconciler) getAlertReceivers(ctx context.Context, serverClient k8sclient.Client) ([]alertmanagerReceiver, []*alertmanagerRoute, error) {
+	if r.installation.Spec.Alerting == nil {
+		return nil, nil, nil
+	}
+
+	var receivers []alertmanagerReceiver
+	var routes []*alertmanagerRoute
+	for _, spec := range r.installation.Spec.Alerting.Receivers {
+		secret := &corev1.Secret{}
+		if err := serverClient.Get(ctx, types.NamespacedName{Name: spec.SecretRef, Namespace: r.installation.Namespace}, secret); err != nil {
+			return nil, nil, fmt.Errorf("could not obtain credentials secret of alert receiver %s: %w", spec.Name, err)
+		}
+
+		receiver, err := newAlertmanagerReceiver(spec, secret)
+		if err != nil {
+			return nil, nil, err
+		}
+		receivers = append(receivers, receiver)
+		routes = append(routes, newAlertmanagerRoute(spec))
+	}
+	return receivers, routes, nil
+}
+
+func newAlertmanagerReceiver(spec integreatlyv1alpha1.AlertReceiver, secret *corev1.Secret) (alertmanagerReceiver, error) {
+	receiver := alertmanagerReceiver{Name: spec.Name}
+
+	switch spec.Type {
+	case integreatlyv1alpha1.AlertReceiverSlack:
+		receiver.SlackConfigs = []alertmanagerSlackConfig{{
+			SendResolved: true,
+			APIURL:       string(secret.Data[alertReceiverURLKey]),
+			Channel:      spec.Channel,
+		}}
+	case integreatlyv1alpha1.AlertReceiverMSTeams, integreatlyv1alpha1.AlertReceiverWebhook:
+		receiver.WebhookConfigs = []alertmanagerWebhookConfig{{
+			SendResolved: true,
+			URL:          string(secret.Data[alertReceiverURLKey]),
+		}}
+	case integreatlyv1alpha1.AlertReceiverOpsGenie:
+		receiver.OpsGenieConfigs = []alertmanagerOpsGenieConfig{{
+			SendResolved: true,
+			APIKey:       string(secret.Data[alertReceiverAPIKeyKey]),
+			APIURL:       string(secret.Data[alertReceiverAPIURLKey]),
+		}}
+	default:
+		return receiver, fmt.Errorf("alert receiver %s has unsupported type %q", spec.Name, spec.Type)
+	}
+	return receiver, nil
+}
+
+func newAlertmanagerRoute(spec integreatlyv1alpha1.AlertReceiver) *alertmanagerRoute {
+	route := &alertmanagerRoute{
+		Receiver: spec.Name,
+		Continue: true,
+	}
+
+	products := make([]string, 0, len(spec.Products))
+	for _, product := range spec.Products {
+		products = append(products, string(product))
+	}
+	addRouteMatcher(route, alertReceiverSeverityKey, spec.Severities)
+	addRouteMatcher(route, alertReceiverProductKey, products)
+	addRouteMatcher(route, alertReceiverNamespaceKey, spec.Namespaces)
+	return route
+}
+
+// addRouteMatcher matches a label against a single value, or against any of the values with an anchored regular
+// expression
+func addRouteMatcher(route *alertmanagerRoute, label string, values []string) {
+	switch len(values) {
+	case 0:
+		return
+	case 1:
+		if route.Match == nil {
+			route.Match = map[string]string{}
+		}
+		route.Match[label] = values[0]
+	default:
+		quoted := make([]string, 0, len(values))
+		for _, value := range values {
+			quoted = append(quoted, regexp.QuoteMeta(value))
+		}
+		if route.MatchRE == nil {
+			route.MatchRE = map[string]string{}
+		}
+		route.MatchRE[label] = strings.Join(quoted, "|")
+	}
+}
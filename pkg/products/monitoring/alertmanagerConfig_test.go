@@ -0,0 +1,235 @@
+package monitoring
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	prometheusmonitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func renderAlertmanagerTemplate(t *testing.T, params map[string]string) []byte {
+	rendered, err := NewTemplateHelper(params).loadTemplate(alertManagerConfigTemplatePath)
+	if err != nil {
+		t.Fatalf("could not render the alertmanager template: %v", err)
+	}
+	return rendered
+}
+
+func TestBuildAlertmanagerConfig(t *testing.T) {
+	rendered := renderAlertmanagerTemplate(t, map[string]string{
+		"SMTPHost":            "smtp.example.com",
+		"SMTPPort":            "587",
+		"AlertManagerRoute":   "alertmanager.example.com",
+		"SMTPUsername":        "user",
+		"SMTPPassword":        "password",
+		"SMTPToAddress":       "alerts@example.com",
+		"PagerDutyServiceKey": "key",
+		"DeadMansSnitchURL":   "https://nosnch.in/123",
+	})
+
+	unchanged, err := buildAlertmanagerConfig(rendered, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(unchanged) != string(rendered) {
+		t.Fatalf("expected the rendered config to be unchanged without receivers, got %s", unchanged)
+	}
+
+	receivers := []integreatlyv1alpha1.AlertReceiver{
+		{
+			Name:       "slack",
+			Type:       integreatlyv1alpha1.AlertReceiverSlack,
+			Channel:    "#alerts",
+			Severities: []string{"critical", "warning"},
+			Products:   []integreatlyv1alpha1.ProductName{integreatlyv1alpha1.Product3Scale},
+		},
+		{
+			Name:       "opsgenie",
+			Type:       integreatlyv1alpha1.AlertReceiverOpsGenie,
+			Namespaces: []string{"redhat-rhmi-rhsso"},
+		},
+	}
+	secret := &corev1.Secret{Data: map[string][]byte{
+		alertReceiverURLKey:    []byte("https://hooks.slack.com/services/abc"),
+		alertReceiverAPIKeyKey: []byte("opsgenie-key"),
+	}}
+	var amReceivers []alertmanagerReceiver
+	var amRoutes []*alertmanagerRoute
+	for _, spec := range receivers {
+		receiver, err := newAlertmanagerReceiver(spec, secret)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		amReceivers = append(amReceivers, receiver)
+		amRoutes = append(amRoutes, newAlertmanagerRoute(spec))
+	}
+
+	data, err := buildAlertmanagerConfig(rendered, amReceivers, amRoutes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg, err := parseAlertmanagerConfig(data)
+	if err != nil {
+		t.Fatalf("generated config can not be parsed: %v", err)
+	}
+	if len(cfg.Receivers) != 5 {
+		t.Fatalf("expected 5 receivers, got %d", len(cfg.Receivers))
+	}
+
+	routes := cfg.Route.Routes
+	if len(routes) != 5 || routes[0].Receiver != deadMansSwitchReceiver || routes[1].Receiver != "slack" || routes[2].Receiver != "opsgenie" {
+		t.Fatalf("expected the receiver routes to follow the dead mans switch route, got %v", routes)
+	}
+	if last := routes[4]; last.Receiver != cfg.Route.Receiver || len(last.Match) > 0 || len(last.MatchRE) > 0 {
+		t.Fatalf("expected a last route to the default receiver, got %v", last)
+	}
+	if !routes[1].Continue || routes[1].MatchRE[alertReceiverSeverityKey] != "critical|warning" || routes[1].Match[alertReceiverProductKey] != string(integreatlyv1alpha1.Product3Scale) {
+		t.Fatalf("unexpected slack route %v", routes[1])
+	}
+	if routes[2].Match[alertReceiverNamespaceKey] != "redhat-rhmi-rhsso" {
+		t.Fatalf("unexpected opsgenie route %v", routes[2])
+	}
+
+	alerts := []struct {
+		labels    map[string]string
+		receivers []string
+	}{
+		{labels: map[string]string{"alertname": "DeadMansSwitch"}, receivers: []string{deadMansSwitchReceiver}},
+		{labels: map[string]string{"severity": "warning", "product": "3scale"}, receivers: []string{"slack", "default"}},
+		{labels: map[string]string{"severity": "critical", "product": "3scale"}, receivers: []string{"slack", "critical"}},
+		{labels: map[string]string{"severity": "warning", "namespace": "redhat-rhmi-rhsso"}, receivers: []string{"opsgenie", "default"}},
+		{labels: map[string]string{"severity": "warning", "product": "rhsso"}, receivers: []string{"default"}},
+		{labels: map[string]string{"severity": "critical"}, receivers: []string{"critical"}},
+	}
+	for _, alert := range alerts {
+		if receivers := routeAlert(cfg.Route, alert.labels); strings.Join(receivers, ",") != strings.Join(alert.receivers, ",") {
+			t.Fatalf("expected alert %v to be sent to %v, got %v", alert.labels, alert.receivers, receivers)
+		}
+	}
+}
+
+// routeAlert returns the receivers of an alert, walking the route tree as Alertmanager does: the children of a route
+// are matched in order until one that does not continue, the route handles the alert when none of them matched
+func routeAlert(route *alertmanagerRoute, labels map[string]string) []string {
+	var receivers []string
+	for _, child := range route.Routes {
+		if !routeMatches(child, labels) {
+			continue
+		}
+		receivers = append(receivers, routeAlert(child, labels)...)
+		if !child.Continue {
+			break
+		}
+	}
+	if len(receivers) == 0 {
+		return []string{route.Receiver}
+	}
+	return receivers
+}
+
+func routeMatches(route *alertmanagerRoute, labels map[string]string) bool {
+	for label, value := range route.Match {
+		if labels[label] != value {
+			return false
+		}
+	}
+	for label, expr := range route.MatchRE {
+		if !regexp.MustCompile("^(?:" + expr + ")$").MatchString(labels[label]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAlertReceiverProductRoute(t *testing.T) {
+	scheme, err := getBuildScheme()
+	if err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	client := fake.NewFakeClientWithScheme(scheme)
+	reconciler := &Reconciler{
+		Config:       config.NewMonitoring(config.ProductConfig{"OPERATOR_NAMESPACE": "redhat-rhmi-middleware-monitoring-operator"}),
+		Logger:       logrus.NewEntry(logrus.StandardLogger()),
+		installation: basicInstallation(),
+	}
+	if _, err := reconciler.newAlertsReconciler().ReconcileAlerts(context.TODO(), client); err != nil {
+		t.Fatalf("failed to reconcile the monitoring alerts: %v", err)
+	}
+
+	rule := &prometheusmonitoringv1.PrometheusRule{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "ksm-endpoint-alerts", Namespace: "redhat-rhmi-middleware-monitoring-operator"}, rule); err != nil {
+		t.Fatalf("expected the endpoint alerts to be created: %v", err)
+	}
+	var labels map[string]string
+	for _, alert := range rule.Spec.Groups[0].Rules {
+		if alert.Alert == "RHMIMiddlewareMonitoringOperatorGrafanaServiceEndpointDown" {
+			labels = alert.Labels
+		}
+	}
+	if labels == nil {
+		t.Fatal("expected the grafana endpoint alert to be created")
+	}
+
+	route := &alertmanagerRoute{Receiver: "default", Routes: []*alertmanagerRoute{
+		newAlertmanagerRoute(integreatlyv1alpha1.AlertReceiver{Name: "monitoring", Products: []integreatlyv1alpha1.ProductName{integreatlyv1alpha1.ProductMonitoring}}),
+		newAlertmanagerRoute(integreatlyv1alpha1.AlertReceiver{Name: "3scale", Products: []integreatlyv1alpha1.ProductName{integreatlyv1alpha1.Product3Scale}}),
+	}}
+	if receivers := routeAlert(route, labels); strings.Join(receivers, ",") != "monitoring" {
+		t.Fatalf("expected the alert of the monitoring product to be sent to its receiver, got %v", receivers)
+	}
+}
+
+func TestBuildAlertmanagerConfigValidation(t *testing.T) {
+	rendered := renderAlertmanagerTemplate(t, map[string]string{
+		"SMTPToAddress":       "alerts@example.com",
+		"PagerDutyServiceKey": "key",
+		"DeadMansSnitchURL":   "https://nosnch.in/123",
+	})
+
+	cases := []struct {
+		Name     string
+		Receiver alertmanagerReceiver
+	}{
+		{
+			Name:     "test receiver named like a default receiver",
+			Receiver: alertmanagerReceiver{Name: "critical", WebhookConfigs: []alertmanagerWebhookConfig{{URL: "https://example.com"}}},
+		},
+		{
+			Name:     "test webhook without url",
+			Receiver: alertmanagerReceiver{Name: "webhook", WebhookConfigs: []alertmanagerWebhookConfig{{}}},
+		},
+		{
+			Name:     "test slack with invalid url",
+			Receiver: alertmanagerReceiver{Name: "slack", SlackConfigs: []alertmanagerSlackConfig{{APIURL: "hooks.slack.com"}}},
+		},
+		{
+			Name:     "test opsgenie without api key",
+			Receiver: alertmanagerReceiver{Name: "opsgenie", OpsGenieConfigs: []alertmanagerOpsGenieConfig{{}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			route := &alertmanagerRoute{Receiver: tc.Receiver.Name, Continue: true}
+			if _, err := buildAlertmanagerConfig(rendered, []alertmanagerReceiver{tc.Receiver}, []*alertmanagerRoute{route}); err == nil {
+				t.Fatalf("expected the config to be invalid")
+			}
+		})
+	}
+
+	if _, err := buildAlertmanagerConfig([]byte("route:\n  receiver: default\n  unknown: true\nreceivers:\n  - name: default\n"), nil, nil); err == nil {
+		t.Fatalf("expected a config with unknown fields to be invalid")
+	}
+	if _, err := newAlertmanagerReceiver(integreatlyv1alpha1.AlertReceiver{Name: "irc", Type: "IRC"}, &corev1.Secret{}); err == nil {
+		t.Fatalf("expected an unsupported receiver type to fail")
+	}
+}
@@ -1,777 +1,79 @@
 package monitoring
 
-const MonitoringGrafanaDBResourceByNSJSON = `{
-	"annotations": {
-		"list": [{
-				"builtIn": 1,
-				"datasource": "-- Grafana --",
-				"enable": true,
-				"hide": true,
-				"iconColor": "rgba(0, 211, 255, 1)",
-				"name": "Annotations & Alerts",
-				"type": "dashboard"
-			},
-			{
-				"datasource": "Prometheus",
-				"enable": true,
-				"expr": "count by (stage,version,to_version)(rhmi_version{to_version!=\"\"})",
-				"hide": false,
-				"iconColor": "#FADE2A",
-				"limit": 100,
-				"name": "Upgrade",
-				"showIn": 0,
-				"step": "",
-				"tagKeys": "stage,version,to_version",
-				"tags": "",
-				"titleFormat": "Upgrade",
-				"type": "tags",
-				"useValueForTime": false
-			}
-		]
-	},
-	"editable": true,
-	"gnetId": null,
-	"graphTooltip": 0,
-	"links": [],
-	"panels": [{
-			"collapsed": false,
-			"gridPos": {
-				"h": 1,
-				"w": 24,
-				"x": 0,
-				"y": 0
-			},
-			"id": 4,
-			"panels": [],
-			"repeat": null,
-			"title": "CPU Usage",
-			"type": "row"
-		},
-		{
-			"aliasColors": {},
-			"bars": false,
-			"dashLength": 10,
-			"dashes": false,
-			"datasource": "Prometheus",
-			"fill": 1,
-			"gridPos": {
-				"h": 7,
-				"w": 24,
-				"x": 0,
-				"y": 1
-			},
-			"id": 0,
-			"legend": {
-				"avg": false,
-				"current": false,
-				"max": false,
-				"min": false,
-				"show": true,
-				"total": false,
-				"values": false
-			},
-			"lines": true,
-			"linewidth": 1,
-			"links": [],
-			"nullPointMode": "null as zero",
-			"percentage": false,
-			"pointradius": 5,
-			"points": false,
-			"renderer": "flot",
-			"seriesOverrides": [],
-			"spaceLength": 10,
-			"stack": false,
-			"steppedLine": false,
-			"targets": [{
-				"expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace'}) by (pod)",
-				"format": "time_series",
-				"intervalFactor": 2,
-				"legendFormat": "{{pod}}",
-				"legendLink": null,
-				"step": 10,
-				"refId": "A"
-			}],
-			"thresholds": [],
-			"timeFrom": null,
-			"timeRegions": [],
-			"timeShift": null,
-			"title": "CPU Usage",
-			"tooltip": {
-				"shared": true,
-				"sort": 0,
-				"value_type": "individual"
-			},
-			"type": "graph",
-			"xaxis": {
-				"buckets": null,
-				"mode": "time",
-				"name": null,
-				"show": true,
-				"values": []
-			},
-			"yaxes": [{
-					"format": "short",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": 0,
-					"show": true
-				},
-				{
-					"format": "short",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": null,
-					"show": false
-				}
-			],
-			"yaxis": {
-				"align": false,
-				"alignLevel": null
-			}
-		},
-		{
-			"collapsed": false,
-			"gridPos": {
-				"h": 1,
-				"w": 24,
-				"x": 0,
-				"y": 8
-			},
-			"id": 5,
-			"panels": [],
-			"repeat": null,
-			"title": "CPU Quota",
-			"type": "row"
-		},
-		{
-			"aliasColors": {},
-			"bars": false,
-			"columns": [],
-			"dashLength": 10,
-			"dashes": false,
-			"datasource": "Prometheus",
-			"fill": 1,
-			"fontSize": "100%",
-			"gridPos": {
-				"h": 7,
-				"w": 24,
-				"x": 0,
-				"y": 9
-			},
-			"id": 1,
-			"legend": {
-				"avg": false,
-				"current": false,
-				"max": false,
-				"min": false,
-				"show": true,
-				"total": false,
-				"values": false
-			},
-			"lines": true,
-			"linewidth": 1,
-			"links": [],
-			"nullPointMode": "null as zero",
-			"pageSize": null,
-			"percentage": false,
-			"pointradius": 5,
-			"points": false,
-			"renderer": "flot",
-			"scroll": true,
-			"seriesOverrides": [],
-			"showHeader": true,
-			"sort": {
-				"col": 1,
-				"desc": false
-			},
-			"spaceLength": 10,
-			"stack": false,
-			"steppedLine": false,
-			"styles": [{
-					"alias": "Time",
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"pattern": "Time",
-					"type": "hidden"
-				},
-				{
-					"alias": "CPU Usage",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #A",
-					"thresholds": [],
-					"type": "number",
-					"unit": "short"
-				},
-				{
-					"alias": "CPU Requests",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #B",
-					"thresholds": [],
-					"type": "number",
-					"unit": "short"
-				},
-				{
-					"alias": "CPU Requests %",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #C",
-					"thresholds": [],
-					"type": "number",
-					"unit": "percentunit"
-				},
-				{
-					"alias": "CPU Limits",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #D",
-					"thresholds": [],
-					"type": "number",
-					"unit": "short"
-				},
-				{
-					"alias": "CPU Limits %",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #E",
-					"thresholds": [],
-					"type": "number",
-					"unit": "percentunit"
-				},
-				{
-					"alias": "Pod",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": true,
-					"linkTooltip": "Drill down",
-					"linkUrl": "/d/c84ae905b9f54268be6be82c9a5b7dd6/resources-by-pod?var-namespace=$namespace&var-pod=$__cell",
-					"pattern": "pod",
-					"thresholds": [],
-					"type": "number",
-					"unit": "short"
-				},
-				{
-					"alias": "",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"pattern": "/.*/",
-					"thresholds": [],
-					"type": "string",
-					"unit": "short"
-				}
-			],
-			"targets": [{
-					"expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "A",
-					"step": 10
-				},
-				{
-					"expr": "sum(kube_pod_container_resource_requests_cpu_cores{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "B",
-					"step": 10
-				},
-				{
-					"expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace'}) by (pod) / sum(kube_pod_container_resource_requests_cpu_cores{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "C",
-					"step": 10
-				},
-				{
-					"expr": "sum(kube_pod_container_resource_limits_cpu_cores{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "D",
-					"step": 10
-				},
-				{
-					"expr": "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace'}) by (pod) / sum(kube_pod_container_resource_limits_cpu_cores{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "E",
-					"step": 10
-				}
-			],
-			"thresholds": [],
-			"timeFrom": null,
-			"timeShift": null,
-			"title": "CPU Quota",
-			"tooltip": {
-				"shared": true,
-				"sort": 0,
-				"value_type": "individual"
-			},
-			"transform": "table",
-			"type": "table",
-			"xaxis": {
-				"buckets": null,
-				"mode": "time",
-				"name": null,
-				"show": true,
-				"values": []
-			},
-			"yaxes": [{
-					"format": "short",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": 0,
-					"show": true
-				},
-				{
-					"format": "short",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": null,
-					"show": false
-				}
-			]
-		},
-		{
-			"collapsed": false,
-			"gridPos": {
-				"h": 1,
-				"w": 24,
-				"x": 0,
-				"y": 16
-			},
-			"id": 6,
-			"panels": [],
-			"repeat": null,
-			"title": "Memory Usage",
-			"type": "row"
-		},
-		{
-			"aliasColors": {},
-			"bars": false,
-			"dashLength": 10,
-			"dashes": false,
-			"datasource": "Prometheus",
-			"fill": 1,
-			"gridPos": {
-				"h": 7,
-				"w": 24,
-				"x": 0,
-				"y": 17
-			},
-			"id": 2,
-			"legend": {
-				"avg": false,
-				"current": false,
-				"max": false,
-				"min": false,
-				"show": true,
-				"total": false,
-				"values": false
-			},
-			"lines": true,
-			"linewidth": 1,
-			"links": [],
-			"nullPointMode": "null as zero",
-			"percentage": false,
-			"pointradius": 5,
-			"points": false,
-			"renderer": "flot",
-			"seriesOverrides": [],
-			"spaceLength": 10,
-			"stack": false,
-			"steppedLine": false,
-			"targets": [{
-				"expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace', container=''}) by (pod)",
-				"format": "time_series",
-				"intervalFactor": 2,
-				"legendFormat": "{{pod}}",
-				"legendLink": null,
-				"step": 10,
-				"refId": "A"
-			}],
-			"thresholds": [],
-			"timeFrom": null,
-			"timeRegions": [],
-			"timeShift": null,
-			"title": "Memory Usage",
-			"tooltip": {
-				"shared": true,
-				"sort": 0,
-				"value_type": "individual"
-			},
-			"type": "graph",
-			"xaxis": {
-				"buckets": null,
-				"mode": "time",
-				"name": null,
-				"show": true,
-				"values": []
-			},
-			"yaxes": [{
-					"format": "bytes",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": 0,
-					"show": true
-				},
-				{
-					"format": "short",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": null,
-					"show": false
-				}
-			],
-			"yaxis": {
-				"align": false,
-				"alignLevel": null
-			}
-		},
-		{
-			"collapsed": false,
-			"gridPos": {
-				"h": 1,
-				"w": 24,
-				"x": 0,
-				"y": 24
-			},
-			"id": 7,
-			"panels": [],
-			"repeat": null,
-			"title": "Memory Quota",
-			"type": "row"
-		},
-		{
-			"aliasColors": {},
-			"bars": false,
-			"columns": [],
-			"dashLength": 10,
-			"dashes": false,
-			"datasource": "Prometheus",
-			"fill": 1,
-			"fontSize": "100%",
-			"gridPos": {
-				"h": 7,
-				"w": 24,
-				"x": 0,
-				"y": 25
-			},
-			"id": 3,
-			"legend": {
-				"avg": false,
-				"current": false,
-				"max": false,
-				"min": false,
-				"show": true,
-				"total": false,
-				"values": false
-			},
-			"lines": true,
-			"linewidth": 1,
-			"links": [],
-			"nullPointMode": "null as zero",
-			"pageSize": null,
-			"percentage": false,
-			"pointradius": 5,
-			"points": false,
-			"renderer": "flot",
-			"scroll": true,
-			"seriesOverrides": [],
-			"showHeader": true,
-			"sort": {
-				"col": 1,
-				"desc": true
-			},
-			"spaceLength": 10,
-			"stack": false,
-			"steppedLine": false,
-			"styles": [{
-					"alias": "Time",
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"pattern": "Time",
-					"type": "hidden"
-				},
-				{
-					"alias": "Memory Usage",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #A",
-					"thresholds": [],
-					"type": "number",
-					"unit": "decbytes"
-				},
-				{
-					"alias": "Memory Requests",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #B",
-					"thresholds": [],
-					"type": "number",
-					"unit": "decbytes"
-				},
-				{
-					"alias": "Memory Requests %",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #C",
-					"thresholds": [],
-					"type": "number",
-					"unit": "percentunit"
-				},
-				{
-					"alias": "Memory Limits",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #D",
-					"thresholds": [],
-					"type": "number",
-					"unit": "decbytes"
-				},
-				{
-					"alias": "Memory Limits %",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": false,
-					"linkTooltip": "Drill down",
-					"linkUrl": "",
-					"pattern": "Value #E",
-					"thresholds": [],
-					"type": "number",
-					"unit": "percentunit"
-				},
-				{
-					"alias": "Pod",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"link": true,
-					"linkTooltip": "Drill down",
-					"linkUrl": "/d/c84ae905b9f54268be6be82c9a5b7dd6/resources-by-pod?var-namespace=$namespace&var-pod=$__cell",
-					"pattern": "pod",
-					"thresholds": [],
-					"type": "number",
-					"unit": "short"
-				},
-				{
-					"alias": "",
-					"colorMode": null,
-					"colors": [],
-					"dateFormat": "YYYY-MM-DD HH:mm:ss",
-					"decimals": 2,
-					"pattern": "/.*/",
-					"thresholds": [],
-					"type": "string",
-					"unit": "short"
-				}
-			],
-			"targets": [{
-					"expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace',container=''}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "A",
-					"step": 10
-				},
-				{
-					"expr": "sum(kube_pod_container_resource_requests_memory_bytes{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "B",
-					"step": 10
-				},
-				{
-					"expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace',container=''}) by (pod) / sum(kube_pod_container_resource_requests_memory_bytes{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "C",
-					"step": 10
-				},
-				{
-					"expr": "sum(kube_pod_container_resource_limits_memory_bytes{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "D",
-					"step": 10
-				},
-				{
-					"expr": "sum(container_memory_working_set_bytes{namespace=~'$namespace',container=''}) by (pod) / sum(kube_pod_container_resource_limits_memory_bytes{namespace=~'$namespace'}) by (pod)",
-					"format": "table",
-					"instant": true,
-					"intervalFactor": 2,
-					"legendFormat": "",
-					"refId": "E",
-					"step": 10
-				}
-			],
-			"thresholds": [],
-			"timeFrom": null,
-			"timeShift": null,
-			"title": "Memory Quota",
-			"tooltip": {
-				"shared": true,
-				"sort": 0,
-				"value_type": "individual"
-			},
-			"transform": "table",
-			"type": "table",
-			"xaxis": {
-				"buckets": null,
-				"mode": "time",
-				"name": null,
-				"show": true,
-				"values": []
-			},
-			"yaxes": [{
-					"format": "short",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": 0,
-					"show": true
-				},
-				{
-					"format": "short",
-					"label": null,
-					"logBase": 1,
-					"max": null,
-					"min": null,
-					"show": false
-				}
-			]
-		}
-	],
-	"refresh": "10s",
-	"schemaVersion": 16,
-	"style": "dark",
-	"tags": [],
-	"templating": {
-		"list": [{
-			"allValue": null,
-			"datasource": "Prometheus",
-			"definition": "",
-			"hide": 0,
-			"includeAll": false,
-			"label": "namespace",
-			"multi": false,
-			"name": "namespace",
-			"options": [],
-			"query": "query_result(count(kube_namespace_labels{label_monitoring_key='middleware'}) by (namespace))",
-			"refresh": 1,
-			"regex": "/\"(.*?)\"/",
-			"skipUrlSync": false,
-			"sort": 1,
-			"tagValuesQuery": "",
-			"tags": [],
-			"tagsQuery": "",
-			"type": "query",
-			"useTags": false
-		}]
-	},
-	"time": {
-		"from": "now-1h",
-		"to": "now"
-	},
-	"timepicker": {
-		"refresh_intervals": [
-			"5s",
-			"10s",
-			"30s",
-			"1m",
-			"5m",
-			"15m",
-			"30m",
-			"1h",
-			"2h",
-			"1d"
-		],
-		"time_options": [
-			"5m",
-			"15m",
-			"1h",
-			"6h",
-			"12h",
-			"24h",
-			"2d",
-			"7d",
-			"30d"
-		]
-	},
-	"timezone": "",
-	"uid": "a9ce5290ba1d485ca67e05c0a63aa2d8",
-	"title": "Resource Usage By Namespace",
-	"version": 2
-}`
+import (
+	"fmt"
+
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+)
+
+const (
+	resourcesByNamespaceUID = "a9ce5290ba1d485ca67e05c0a63aa2d8"
+	resourcesByPodUID       = "c84ae905b9f54268be6be82c9a5b7dd6"
+)
+
+// ResourcesByNamespace returns the cpu and memory used and requested by the pods of a middleware namespace
+func ResourcesByNamespace() *grafana.Dashboard {
+	dashboard := grafana.NewDashboard("Resource Usage By Namespace")
+	dashboard.UID = resourcesByNamespaceUID
+	dashboard.Refresh = "10s"
+	dashboard.AddAnnotations(upgradeAnnotation())
+	dashboard.AddVariables(namespaceVariable())
+
+	cpuUsage := "sum(node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate{namespace=~'$namespace'}) by (pod)"
+	cpuRequests := "sum(kube_pod_container_resource_requests_cpu_cores{namespace=~'$namespace'}) by (pod)"
+	cpuLimits := "sum(kube_pod_container_resource_limits_cpu_cores{namespace=~'$namespace'}) by (pod)"
+	memoryUsage := "sum(container_memory_working_set_bytes{namespace=~'$namespace', container=''}) by (pod)"
+	memoryRequests := "sum(kube_pod_container_resource_requests_memory_bytes{namespace=~'$namespace'}) by (pod)"
+	memoryLimits := "sum(kube_pod_container_resource_limits_memory_bytes{namespace=~'$namespace'}) by (pod)"
+	pod := grafana.Column{
+		Title: "Pod",
+		Label: "pod",
+		Link:  fmt.Sprintf("/d/%s/resources-by-pod?var-namespace=$namespace&var-pod=$__cell", resourcesByPodUID),
+	}
+
+	dashboard.AddRows(
+		grafana.NewRow("CPU Usage",
+			grafana.NewGraph("CPU Usage", grafana.Target{Expr: cpuUsage, Legend: "{{pod}}"}).
+				WithSize(24, 7),
+		),
+		grafana.NewRow("CPU Quota", grafana.NewTable("CPU Quota", append(cpuQuotaColumns(cpuUsage, cpuRequests, cpuLimits), pod)...)),
+		grafana.NewRow("Memory Usage",
+			grafana.NewGraph("Memory Usage", grafana.Target{Expr: memoryUsage, Legend: "{{pod}}"}).
+				WithSize(24, 7).
+				WithUnit("bytes", 0),
+		),
+		grafana.NewRow("Memory Quota", grafana.NewTable("Memory Quota", append(memoryQuotaColumns(memoryUsage, memoryRequests, memoryLimits), pod)...)),
+	)
+	return dashboard
+}
+
+// namespaceVariable lists the namespaces monitored by the middleware monitoring stack
+func namespaceVariable() *grafana.Variable {
+	variable := grafana.NewQueryVariable("namespace", "namespace", "query_result(count(kube_namespace_labels{label_monitoring_key='middleware'}) by (namespace))")
+	variable.Regex = `/"(.*?)"/`
+	return variable
+}
+
+// cpuQuotaColumns returns the columns of the cpu used, requested and limited, and of the ratio used of the requests
+// and limits
+func cpuQuotaColumns(usage, requests, limits string) []grafana.Column {
+	return []grafana.Column{
+		{Title: "CPU Usage", Expr: usage},
+		{Title: "CPU Requests", Expr: requests},
+		{Title: "CPU Requests %", Expr: fmt.Sprintf("%s / %s", usage, requests), Unit: "percentunit"},
+		{Title: "CPU Limits", Expr: limits},
+		{Title: "CPU Limits %", Expr: fmt.Sprintf("%s / %s", usage, limits), Unit: "percentunit"},
+	}
+}
+
+// memoryQuotaColumns returns the columns of the memory used, requested and limited, and of the ratio used of the
+// requests and limits
+func memoryQuotaColumns(usage, requests, limits string) []grafana.Column {
+	return []grafana.Column{
+		{Title: "Memory Usage", Expr: usage, Unit: "decbytes"},
+		{Title: "Memory Requests", Expr: requests, Unit: "decbytes"},
+		{Title: "Memory Requests %", Expr: fmt.Sprintf("%s / %s", usage, requests), Unit: "percentunit"},
+		{Title: "Memory Limits", Expr: limits, Unit: "decbytes"},
+		{Title: "Memory Limits %", Expr: fmt.Sprintf("%s / %s", usage, limits), Unit: "percentunit"},
+	}
+}
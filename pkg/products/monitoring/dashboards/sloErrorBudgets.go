@@ -0,0 +1,42 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+)
+
+// SLOErrorBudgets returns the availability and error budget of the products with an SLO, repeated for each product.
+// The burn rate is graphed over each of the windows the error ratio is recorded for
+func SLOErrorBudgets(burnRateWindows []string) *grafana.Dashboard {
+	burnRates := make([]grafana.Target, 0, len(burnRateWindows))
+	for _, window := range burnRateWindows {
+		burnRates = append(burnRates, grafana.Target{
+			Expr:   fmt.Sprintf(`slo:sli_error:ratio_rate%s{product="$product"} / ignoring() group_left() (1 - slo:objective:ratio{product="$product"})`, window),
+			Legend: window,
+		})
+	}
+
+	dashboard := grafana.NewDashboard("SLO Error Budgets")
+	dashboard.From = "now-7d"
+	dashboard.AddVariables(
+		grafana.NewQueryVariable("product", "Product", "label_values(slo:objective:ratio, product)").WithAll(),
+	)
+	dashboard.AddRows(grafana.NewRow("$product",
+		grafana.NewSingleStat("Availability", `1 - slo:sli_error:ratio_window{product="$product"}`).
+			WithUnit("percentunit", 3),
+		grafana.NewSingleStat("Objective", `slo:objective:ratio{product="$product"}`).
+			WithUnit("percentunit", 3),
+		grafana.NewSingleStat("Error Budget Remaining", `slo:error_budget:remaining_ratio{product="$product"}`).
+			WithUnit("percentunit", 1).
+			WithThresholds(grafana.ColorsGood, true, 0, 0.25).
+			WithRange(0, 1),
+		grafana.NewSingleStat("Burn Rate Alerts Firing", `count(ALERTS{alertstate="firing", product="$product", alertname=~".*ErrorBudgetBurn.*"}) or vector(0)`).
+			WithThresholds(grafana.ColorsBad, false, 1, 2),
+		grafana.NewGraph("Burn Rate", burnRates...).
+			WithUnit("short", 2),
+		grafana.NewGraph("Error Budget Remaining", grafana.Target{Expr: `slo:error_budget:remaining_ratio{product="$product"}`, Legend: "remaining"}).
+			WithUnit("percentunit", 1),
+	).RepeatFor("product"))
+	return dashboard
+}
@@ -4,38 +4,48 @@ import (
 	"fmt"
 
 	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	monitoring "github.com/integr8ly/integreatly-operator/pkg/products/monitoring/dashboards"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
 )
 
-func getSpecDetailsForDashboard(dashboard string) (string, string, error) {
+func getSpecDetailsForDashboard(dashboard string, installation *integreatlyv1alpha1.RHMI) (string, string, error) {
 
+	var builder *grafana.Dashboard
+	var name string
 	switch dashboard {
 
 	case "endpointsdetailed":
-		return monitoring.MonitoringGrafanaDBEndpointsDetailedJSON, "endpointsdetailed.json", nil
+		builder, name = monitoring.EndpointsDetailed(), "endpointsdetailed.json"
 
 	case "endpointsreport":
-		return monitoring.MonitoringGrafanaDBEndpointsReportJSON, "endpointsreport.json", nil
+		builder, name = monitoring.EndpointsReport(), "endpointsreport.json"
 
 	case "endpointssummary":
-		return monitoring.MonitoringGrafanaDBEndpointsSummaryJSON, "endpointssummary.json", nil
+		builder, name = monitoring.EndpointsSummary(), "endpointssummary.json"
 
 	case "resources-by-namespace":
-		return monitoring.MonitoringGrafanaDBResourceByNSJSON, "resources-by-namespace.json", nil
+		builder, name = monitoring.ResourcesByNamespace(), "resources-by-namespace.json"
 
 	case "resources-by-pod":
-		return monitoring.MonitoringGrafanaDBResourceByPodJSON, "resources-by-pod.json", nil
+		builder, name = monitoring.ResourcesByPod(), "resources-by-pod.json"
 
 	case "cluster-resources":
-		return monitoring.MonitoringGrafanaDBClusterResourcesJSON, "cluster-resources-new.json", nil
+		builder, name = monitoring.ClusterResources(), "cluster-resources-new.json"
 
 	case "critical-slo-alerts":
-		return monitoring.MonitoringGrafanaDBCriticalSLOAlertsJSON, "critical-slo-alerts.json", nil
+		builder, name = monitoring.CriticalSLOAlerts(grafana.GetProductDashboards(installation)), "critical-slo-alerts.json"
+
+	case "slo-error-budgets":
+		builder, name = monitoring.SLOErrorBudgets(burnRateRanges()), "slo-error-budgets.json"
 
 	default:
 		return "", "", fmt.Errorf("Invalid/Unsupported Grafana Dashboard")
 
 	}
+
+	specJSON, err := builder.JSON()
+	return specJSON, name, err
 }
 
 func getPluginsForGrafanaDashboard(name string) grafanav1alpha1.PluginList {
@@ -0,0 +1,116 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+)
+
+func TestGetSpecDetailsForDashboard(t *testing.T) {
+	grafana.RegisterProductDashboard(integreatlyv1alpha1.ProductApicurito, func(installation *integreatlyv1alpha1.RHMI) grafana.ProductDashboard {
+		return grafana.ProductDashboard{
+			Namespace:   installation.Spec.NamespacePrefix + "apicurito",
+			AlertPrefix: "Apicurito",
+			Panels:      []*grafana.Panel{grafana.NewSingleStat("Apicurito Pods", "count(kube_pod_info{namespace='apicurito'})")},
+		}
+	})
+	defer grafana.UnregisterProductDashboard(integreatlyv1alpha1.ProductApicurito)
+
+	installation := sloInstallation(integreatlyv1alpha1.ProductApicurito)
+	installation.Spec.NamespacePrefix = "test-"
+
+	for _, dashboard := range (&config.Monitoring{}).GetDashboards() {
+		specJSON, name, err := getSpecDetailsForDashboard(dashboard, installation)
+		if err != nil {
+			t.Fatalf("unexpected error for dashboard %s: %v", dashboard, err)
+		}
+		if name == "" || !json.Valid([]byte(specJSON)) {
+			t.Fatalf("expected dashboard %s to be valid json", dashboard)
+		}
+	}
+
+	specJSON, _, err := getSpecDetailsForDashboard("critical-slo-alerts", installation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var dashboard struct {
+		Panels []struct {
+			Title   string `json:"title"`
+			Targets []struct {
+				Expr string `json:"expr"`
+			} `json:"targets"`
+		} `json:"panels"`
+	}
+	if err := json.Unmarshal([]byte(specJSON), &dashboard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var productPanels []string
+	inProductRow := false
+	for _, panel := range dashboard.Panels {
+		if panel.Title == string(integreatlyv1alpha1.ProductApicurito) {
+			inProductRow = true
+			continue
+		}
+		if inProductRow {
+			productPanels = append(productPanels, panel.Title)
+		}
+	}
+	if len(productPanels) != 6 || productPanels[5] != "Apicurito Pods" {
+		t.Fatalf("expected the product row to end with the panels of the product, got %v", productPanels)
+	}
+	if expr := dashboard.Panels[len(dashboard.Panels)-6].Targets[0].Expr; !strings.Contains(expr, `ALERTS{namespace="test-apicurito"`) || !strings.Contains(expr, `alertname=~"Apicurito.*"`) {
+		t.Fatalf("expected the alerts of the product namespace and prefix to be counted, got %s", expr)
+	}
+}
+
+func TestGetSpecDetailsForDashboardLinks(t *testing.T) {
+	installation := sloInstallation(integreatlyv1alpha1.ProductApicurito)
+
+	type link struct {
+		URL     string `json:"url"`
+		LinkURL string `json:"linkUrl"`
+	}
+	uids := map[string]bool{}
+	links := map[string][]string{}
+	for _, name := range (&config.Monitoring{}).GetDashboards() {
+		specJSON, _, err := getSpecDetailsForDashboard(name, installation)
+		if err != nil {
+			t.Fatalf("unexpected error for dashboard %s: %v", name, err)
+		}
+		var dashboard struct {
+			UID    string `json:"uid"`
+			Panels []struct {
+				Links  []link `json:"links"`
+				Styles []link `json:"styles"`
+			} `json:"panels"`
+		}
+		if err := json.Unmarshal([]byte(specJSON), &dashboard); err != nil {
+			t.Fatalf("unexpected error for dashboard %s: %v", name, err)
+		}
+		uids[dashboard.UID] = true
+		for _, panel := range dashboard.Panels {
+			for _, l := range append(panel.Links, panel.Styles...) {
+				if url := l.URL + l.LinkURL; url != "" {
+					links[name] = append(links[name], url)
+				}
+			}
+		}
+	}
+
+	if len(links) == 0 {
+		t.Fatalf("expected the dashboards to drill down to each other")
+	}
+	for name, urls := range links {
+		for _, url := range urls {
+			parts := strings.SplitN(strings.TrimPrefix(url, "/d/"), "/", 2)
+			if !strings.HasPrefix(url, "/d/") || !uids[parts[0]] {
+				t.Fatalf("expected dashboard %s to link to another dashboard, got %s", name, url)
+			}
+		}
+	}
+}
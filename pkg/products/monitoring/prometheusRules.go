@@ -190,6 +190,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-monitoring-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetOperatorNamespace(),
 				GroupName: "general.rules",
 				Rules: []monitoringv1.Rule{
@@ -208,6 +209,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetOperatorNamespace(),
 				GroupName: "middleware-monitoring-operator-endpoint.rules",
 				Rules: []monitoringv1.Rule{
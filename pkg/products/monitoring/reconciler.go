@@ -220,6 +220,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.MonitoringSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.MonitoringSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, serverClient, constants.MonitoringSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.MonitoringSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.reconcileComponents(ctx, serverClient)
 	logrus.Infof("Phase: %s reconcileComponents", phase)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
@@ -278,6 +290,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	phase, err = r.reconcileSLOs(ctx, serverClient)
+	logrus.Infof("Phase: %s reconcileSLOs", phase)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		events.HandleError(r.recorder, installation, phase, "Failed to reconcile SLO rules", err)
+		return phase, err
+	}
+
 	// creates an alert to check for the presents of sendgrid smtp secret
 	phase, err = resources.CreateSmtpSecretExists(ctx, serverClient, installation)
 	logrus.Infof("Phase: %s CreateSmtpSecretExistsRule", phase)
@@ -479,7 +498,7 @@ func (r *Reconciler) reconcileGrafanaDashboards(ctx context.Context, serverClien
 		},
 	}
 
-	specJSON, name, err := getSpecDetailsForDashboard(dashboard)
+	specJSON, name, err := getSpecDetailsForDashboard(dashboard, r.installation)
 	if err != nil {
 		return err
 	}
@@ -654,6 +673,12 @@ func (r *Reconciler) reconcileAlertManagerConfigSecret(ctx context.Context, serv
 		return integreatlyv1alpha1.PhaseFailed, err
 	}
 
+	// get the receivers configured in the installation
+	alertReceivers, alertRoutes, err := r.getAlertReceivers(ctx, serverClient)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	// only set the to address to a real value for managed deployments
 	smtpToAddress := fmt.Sprintf("noreply@%s", alertmanagerRoute.Spec.Host)
 	smtpToAddressCRDVal := r.installation.Spec.AlertingEmailAddress
@@ -672,10 +697,15 @@ func (r *Reconciler) reconcileAlertManagerConfigSecret(ctx context.Context, serv
 		"PagerDutyServiceKey": pagerDutySecret,
 		"DeadMansSnitchURL":   dmsSecret,
 	})
-	configSecretData, err := templateUtil.loadTemplate(alertManagerConfigTemplatePath)
+	renderedConfig, err := templateUtil.loadTemplate(alertManagerConfigTemplatePath)
 	if err != nil {
 		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("could not parse alert manager configuration template: %w", err)
 	}
+	// an invalid configuration is never written, alert manager keeps running with the current one
+	configSecretData, err := buildAlertmanagerConfig(renderedConfig, alertReceivers, alertRoutes)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("alert manager configuration is not valid: %w", err)
+	}
 	configSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      alertManagerConfigSecretName,
@@ -749,15 +779,18 @@ func CreateBlackboxTarget(ctx context.Context, name string, target monitoring.Bl
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.ProductMonitoring)
 	target := marketplace.Target{
-		Pkg:       constants.MonitoringSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.MonitoringSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.ProductMonitoring),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
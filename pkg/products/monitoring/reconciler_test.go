@@ -656,6 +656,22 @@ func TestReconciler_reconcileAlertManagerConfigSecret(t *testing.T) {
 		},
 		Type: corev1.SecretTypeOpaque,
 	}
+	slackSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-slack",
+			Namespace: installation.Namespace,
+		},
+		Data: map[string][]byte{
+			"url": []byte("https://hooks.slack.com/services/test"),
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+	slackReceiver := integreatlyv1alpha1.AlertReceiver{
+		Name:       "slack",
+		Type:       integreatlyv1alpha1.AlertReceiverSlack,
+		SecretRef:  slackSecret.Name,
+		Severities: []string{"critical"},
+	}
 	alertmanagerConfigSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      alertManagerConfigSecretName,
@@ -846,6 +862,68 @@ func TestReconciler_reconcileAlertManagerConfigSecret(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "receivers of the installation are added to the config",
+			serverClient: func() k8sclient.Client {
+				return fakeclient.NewFakeClientWithScheme(basicScheme, smtpSecret, pagerdutySecret, dmsSecret, alertmanagerRoute, slackSecret)
+			},
+			reconciler: func() *Reconciler {
+				installation := basicInstallation()
+				installation.Spec.Alerting = &integreatlyv1alpha1.AlertingSpec{Receivers: []integreatlyv1alpha1.AlertReceiver{slackReceiver}}
+				return &Reconciler{installation: installation, Logger: basicLogger, Config: basicReconciler.Config}
+			},
+			want: integreatlyv1alpha1.PhaseCompleted,
+			wantFn: func(c k8sclient.Client) error {
+				configSecret := &corev1.Secret{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: alertManagerConfigSecretName, Namespace: defaultInstallationNamespace}, configSecret); err != nil {
+					return err
+				}
+				cfg, err := parseAlertmanagerConfig(configSecret.Data[alertManagerConfigSecretFileName])
+				if err != nil {
+					return err
+				}
+				receiver := cfg.Receivers[len(cfg.Receivers)-1]
+				if receiver.Name != slackReceiver.Name || len(receiver.SlackConfigs) != 1 || receiver.SlackConfigs[0].APIURL != string(slackSecret.Data["url"]) {
+					return fmt.Errorf("expected the slack receiver to be configured, got %v", receiver)
+				}
+				return nil
+			},
+		},
+		{
+			name: "invalid receiver config is not written",
+			serverClient: func() k8sclient.Client {
+				invalidSlackSecret := slackSecret.DeepCopy()
+				invalidSlackSecret.Data["url"] = []byte("hooks.slack.com")
+				return fakeclient.NewFakeClientWithScheme(basicScheme, smtpSecret, pagerdutySecret, dmsSecret, alertmanagerRoute, invalidSlackSecret)
+			},
+			reconciler: func() *Reconciler {
+				installation := basicInstallation()
+				installation.Spec.Alerting = &integreatlyv1alpha1.AlertingSpec{Receivers: []integreatlyv1alpha1.AlertReceiver{slackReceiver}}
+				return &Reconciler{installation: installation, Logger: basicLogger, Config: basicReconciler.Config}
+			},
+			wantErr: "alert manager configuration is not valid: receiver slack is not valid: slack api url is not valid: unsupported scheme \"\" in hooks.slack.com",
+			want:    integreatlyv1alpha1.PhaseFailed,
+			wantFn: func(c k8sclient.Client) error {
+				err := c.Get(context.TODO(), types.NamespacedName{Name: alertManagerConfigSecretName, Namespace: defaultInstallationNamespace}, &corev1.Secret{})
+				if !k8serr.IsNotFound(err) {
+					return fmt.Errorf("expected the config secret to not be created, got %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			name: "fails when receiver secret cannot be found",
+			serverClient: func() k8sclient.Client {
+				return fakeclient.NewFakeClientWithScheme(basicScheme, smtpSecret, pagerdutySecret, dmsSecret, alertmanagerRoute)
+			},
+			reconciler: func() *Reconciler {
+				installation := basicInstallation()
+				installation.Spec.Alerting = &integreatlyv1alpha1.AlertingSpec{Receivers: []integreatlyv1alpha1.AlertReceiver{slackReceiver}}
+				return &Reconciler{installation: installation, Logger: basicLogger, Config: basicReconciler.Config}
+			},
+			wantErr: "could not obtain credentials secret of alert receiver slack: secrets \"test-slack\" not found",
+			want:    integreatlyv1alpha1.PhaseFailed,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
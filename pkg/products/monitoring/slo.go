@@ -0,0 +1,345 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/prometheus/common/model"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultSLOTarget = "99.5"
+	defaultSLOWindow = "28d"
+
+	// sloWindowPlaceholder is replaced with the range of each recording rule in the error ratio of an SLO
+	sloWindowPlaceholder = "$window"
+
+	sloErrorRatioRecord       = "slo:sli_error:ratio_rate"
+	sloWindowErrorRatioRecord = "slo:sli_error:ratio_window"
+	sloObjectiveRecord        = "slo:objective:ratio"
+	sloErrorBudgetRecord      = "slo:error_budget:remaining_ratio"
+)
+
+// defaultSLOServices are the services of the blackbox targets created by the products, the availability of a
+// product is measured with them when its SLO does not set an error ratio
+var defaultSLOServices = map[integreatlyv1alpha1.ProductName][]string{
+	integreatlyv1alpha1.Product3Scale:              {"3scale-admin-ui", "3scale-developer-console-ui", "3scale-system-admin-ui"},
+	integreatlyv1alpha1.ProductAMQOnline:           {"amq-service-broker"},
+	integreatlyv1alpha1.ProductApicurito:           {"apicurito-ui"},
+	integreatlyv1alpha1.ProductCodeReadyWorkspaces: {"codeready-ui"},
+	integreatlyv1alpha1.ProductFuse:                {"syndesis-ui"},
+	integreatlyv1alpha1.ProductRHSSO:               {"rhsso-ui"},
+	integreatlyv1alpha1.ProductRHSSOUser:           {"rhssouser-ui"},
+	integreatlyv1alpha1.ProductSolutionExplorer:    {"webapp-ui"},
+	integreatlyv1alpha1.ProductUps:                 {"ups-ui"},
+}
+
+// burnRateWindow alerts when the error budget burns at a rate consuming the budget share over the long window,
+// confirmed by the short window so that the alert resolves soon after the errors stop
+type burnRateWindow struct {
+	long   string
+	short  string
+	budget float64
+}
+
+// the multiwindow, multi-burn-rate alerts of the SRE workbook, the burn rates are scaled to the window of the SLO
+var (
+	fastBurnRateWindows = []burnRateWindow{{long: "1h", short: "5m", budget: 0.02}, {long: "6h", short: "30m", budget: 0.05}}
+	slowBurnRateWindows = []burnRateWindow{{long: "1d", short: "2h", budget: 0.1}, {long: "3d", short: "6h", budget: 0.1}}
+)
+
+func allBurnRateWindows() []burnRateWindow {
+	windows := make([]burnRateWindow, 0, len(fastBurnRateWindows)+len(slowBurnRateWindows))
+	windows = append(windows, fastBurnRateWindows...)
+	return append(windows, slowBurnRateWindows...)
+}
+
+// burnRateRanges returns the long window of every burn rate window, the ranges burn rates are graphed over
+func burnRateRanges() []string {
+	var ranges []string
+	for _, window := range allBurnRateWindows() {
+		ranges = append(ranges, window.long)
+	}
+	return ranges
+}
+
+type sloDefinition struct {
+	product integreatlyv1alpha1.ProductName
+	// errorBudget is the ratio of errors allowed by the objective
+	errorBudget float64
+	window      model.Duration
+	// windowRange is the window as set in the spec, used as the range of the rules
+	windowRange string
+	// errorRatio is the expression of the ratio of errors over sloWindowPlaceholder
+	errorRatio string
+}
+
+// getSLODefinitions returns the SLOs of the enabled products of the installation, sorted by product
+func getSLODefinitions(installation *integreatlyv1alpha1.RHMI) ([]sloDefinition, error) {
+	products := map[integreatlyv1alpha1.ProductName]bool{}
+	for _, stage := range installation.Status.Stages {
+		for product := range stage.Products {
+			products[product] = true
+		}
+	}
+
+	var slos []sloDefinition
+	for product := range products {
+		if !installation.IsProductEnabled(product) {
+			continue
+		}
+		spec := installation.GetProductSpec(product).SLO
+		if spec == nil {
+			if _, ok := defaultSLOServices[product]; !ok {
+				continue
+			}
+			spec = &integreatlyv1alpha1.ProductSLO{}
+		}
+
+		slo, err := newSLODefinition(product, spec)
+		if err != nil {
+			return nil, fmt.Errorf("slo of product %s is not valid: %w", product, err)
+		}
+		slos = append(slos, slo)
+	}
+
+	sort.Slice(slos, func(i, j int) bool { return slos[i].product < slos[j].product })
+	return slos, nil
+}
+
+func newSLODefinition(product integreatlyv1alpha1.ProductName, spec *integreatlyv1alpha1.ProductSLO) (sloDefinition, error) {
+	slo := sloDefinition{product: product}
+
+	target := spec.Target
+	if target == "" {
+		target = defaultSLOTarget
+	}
+	percent, err := strconv.ParseFloat(target, 64)
+	if err != nil || percent <= 0 || percent >= 100 {
+		return slo, fmt.Errorf("target must be a percentage between 0 and 100, found: %s", target)
+	}
+	slo.errorBudget = (100 - percent) / 100
+
+	window := spec.Window
+	if window == "" {
+		window = defaultSLOWindow
+	}
+	slo.window, err = model.ParseDuration(window)
+	if err != nil {
+		return slo, fmt.Errorf("failed to parse window : expected a duration such as 28d found: %s", window)
+	}
+	slo.windowRange = window
+	for _, burnRate := range allBurnRateWindows() {
+		long, _ := model.ParseDuration(burnRate.long)
+		if slo.window < long {
+			return slo, fmt.Errorf("window must be at least %s, found: %s", burnRate.long, window)
+		}
+	}
+
+	if spec.ErrorRatio != "" {
+		if !strings.Contains(spec.ErrorRatio, sloWindowPlaceholder) {
+			return slo, fmt.Errorf("error ratio must use %s as the range of its selectors", sloWindowPlaceholder)
+		}
+		slo.errorRatio = spec.ErrorRatio
+		return slo, nil
+	}
+
+	services := spec.BlackboxServices
+	if len(services) == 0 {
+		services = defaultSLOServices[product]
+	}
+	if len(services) == 0 {
+		return slo, fmt.Errorf("no blackbox services or error ratio to measure the availability with")
+	}
+	quoted := make([]string, 0, len(services))
+	for _, service := range services {
+		quoted = append(quoted, regexp.QuoteMeta(service))
+	}
+	slo.errorRatio = fmt.Sprintf("1 - avg(avg_over_time(probe_success{service=~'%s'}[%s]))", strings.Join(quoted, "|"), sloWindowPlaceholder)
+	return slo, nil
+}
+
+// sloRuleName is the name of the PrometheusRule of the SLO of a product
+func sloRuleName(product integreatlyv1alpha1.ProductName) string {
+	return fmt.Sprintf("slo-%s", product)
+}
+
+// alertConfiguration returns the recording rules of the error ratios and error budget of the SLO, and its burn rate
+// alerts
+func (slo sloDefinition) alertConfiguration(namespace string) resources.AlertConfiguration {
+	labels := map[string]string{resources.AlertProductLabel: string(slo.product)}
+	selector := fmt.Sprintf("{product='%s'}", slo.product)
+
+	var rules []monitoringv1.Rule
+	for _, window := range slo.recordedWindows() {
+		rules = append(rules, monitoringv1.Rule{
+			Record: sloErrorRatioRecord + window,
+			Expr:   intstr.FromString(strings.Replace(slo.errorRatio, sloWindowPlaceholder, window, -1)),
+			Labels: labels,
+		})
+	}
+	rules = append(rules,
+		monitoringv1.Rule{
+			Record: sloWindowErrorRatioRecord,
+			Expr:   intstr.FromString(strings.Replace(slo.errorRatio, sloWindowPlaceholder, slo.windowRange, -1)),
+			Labels: labels,
+		},
+		monitoringv1.Rule{
+			Record: sloObjectiveRecord,
+			Expr:   intstr.FromString(fmt.Sprintf("vector(%s)", formatRatio(1-slo.errorBudget))),
+			Labels: labels,
+		},
+		monitoringv1.Rule{
+			Record: sloErrorBudgetRecord,
+			Expr:   intstr.FromString(fmt.Sprintf("1 - %s%s / %s", sloWindowErrorRatioRecord, selector, formatRatio(slo.errorBudget))),
+			Labels: labels,
+		},
+		monitoringv1.Rule{
+			Alert: fmt.Sprintf("RHMI%sErrorBudgetBurnFast", sloAlertPrefix(slo.product)),
+			Annotations: map[string]string{
+				"sop_url": resources.SopUrlAlertsAndTroubleshooting,
+				"message": fmt.Sprintf("%s is burning its error budget fast, at this rate the budget of %s is consumed in less than %s", slo.product, slo.windowRange, slo.exhaustedIn(fastBurnRateWindows[len(fastBurnRateWindows)-1])),
+			},
+			Expr:   intstr.FromString(slo.burnRateExpr(selector, fastBurnRateWindows)),
+			For:    "2m",
+			Labels: map[string]string{"severity": "critical", "product": string(slo.product)},
+		},
+		monitoringv1.Rule{
+			Alert: fmt.Sprintf("RHMI%sErrorBudgetBurnSlow", sloAlertPrefix(slo.product)),
+			Annotations: map[string]string{
+				"sop_url": resources.SopUrlAlertsAndTroubleshooting,
+				"message": fmt.Sprintf("%s is burning its error budget, at this rate the budget of %s is consumed in less than %s", slo.product, slo.windowRange, slo.exhaustedIn(slowBurnRateWindows[len(slowBurnRateWindows)-1])),
+			},
+			Expr:   intstr.FromString(slo.burnRateExpr(selector, slowBurnRateWindows)),
+			For:    "15m",
+			Labels: map[string]string{"severity": "warning", "product": string(slo.product)},
+		},
+	)
+
+	return resources.AlertConfiguration{
+		AlertName: sloRuleName(slo.product),
+		GroupName: fmt.Sprintf("%s-slo.rules", slo.product),
+		Namespace: namespace,
+		Product:   slo.product,
+		Rules:     rules,
+	}
+}
+
+// recordedWindows are the ranges of the error ratios used by the burn rate alerts
+func (slo sloDefinition) recordedWindows() []string {
+	var windows []string
+	seen := map[string]bool{}
+	for _, burnRate := range allBurnRateWindows() {
+		for _, window := range []string{burnRate.short, burnRate.long} {
+			if !seen[window] {
+				seen[window] = true
+				windows = append(windows, window)
+			}
+		}
+	}
+	return windows
+}
+
+// burnRate is how many times faster than allowed by the objective the budget is consumed when the budget share of
+// the burn rate window is consumed over its long window
+func (slo sloDefinition) burnRate(window burnRateWindow) float64 {
+	long, _ := model.ParseDuration(window.long)
+	return math.Round(window.budget*float64(slo.window)/float64(long)*100) / 100
+}
+
+// exhaustedIn is how long the whole budget lasts at the burn rate of the window
+func (slo sloDefinition) exhaustedIn(window burnRateWindow) model.Duration {
+	return model.Duration(time.Duration(float64(slo.window) / slo.burnRate(window)).Round(time.Hour))
+}
+
+func (slo sloDefinition) burnRateExpr(selector string, windows []burnRateWindow) string {
+	var conditions []string
+	for _, window := range windows {
+		threshold := fmt.Sprintf("(%s * %s)", formatRatio(slo.burnRate(window)), formatRatio(slo.errorBudget))
+		conditions = append(conditions, fmt.Sprintf("(%s%s%s > %s and %s%s%s > %s)",
+			sloErrorRatioRecord, window.long, selector, threshold,
+			sloErrorRatioRecord, window.short, selector, threshold))
+	}
+	return strings.Join(conditions, " or ")
+}
+
+func formatRatio(value float64) string {
+	// rounded to drop the floating point noise of the target conversion, such as 0.0009999999999999432 for 99.9
+	return strconv.FormatFloat(math.Round(value*1e10)/1e10, 'f', -1, 64)
+}
+
+// sloAlertPrefix turns a product name into a prefix of alert names, such as CodereadyWorkspaces
+func sloAlertPrefix(product integreatlyv1alpha1.ProductName) string {
+	var prefix strings.Builder
+	for _, part := range strings.Split(string(product), "-") {
+		if part == "" {
+			continue
+		}
+		prefix.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return prefix.String()
+}
+
+// reconcileSLOs creates the recording rules and burn rate alerts of the SLOs of the products, removing the rules of
+// products that no longer have an SLO
+func (r *Reconciler) reconcileSLOs(ctx context.Context, serverClient k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
+	slos, err := getSLODefinitions(r.installation)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	alerts := make([]resources.AlertConfiguration, 0, len(slos))
+	current := map[integreatlyv1alpha1.ProductName]bool{}
+	for _, slo := range slos {
+		alerts = append(alerts, slo.alertConfiguration(r.Config.GetOperatorNamespace()))
+		current[slo.product] = true
+	}
+
+	alertsReconciler := &resources.AlertReconcilerImpl{
+		ProductName:  "monitoring",
+		Installation: r.installation,
+		Logger:       r.Logger,
+		Alerts:       alerts,
+	}
+	if phase, err := alertsReconciler.ReconcileAlerts(ctx, serverClient); err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		return phase, err
+	}
+
+	stale := map[integreatlyv1alpha1.ProductName]bool{}
+	for product := range defaultSLOServices {
+		stale[product] = true
+	}
+	for product := range r.installation.Spec.Products {
+		stale[product] = true
+	}
+	for product := range stale {
+		if current[product] {
+			continue
+		}
+		rule := &monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sloRuleName(product),
+				Namespace: r.Config.GetOperatorNamespace(),
+			},
+		}
+		if err := serverClient.Delete(ctx, rule); err != nil && !k8serr.IsNotFound(err) {
+			return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to delete slo rules of product %s: %w", product, err)
+		}
+	}
+
+	return integreatlyv1alpha1.PhaseCompleted, nil
+}
@@ -0,0 +1,147 @@
+package monitoring
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/sirupsen/logrus"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func sloInstallation(products ...integreatlyv1alpha1.ProductName) *integreatlyv1alpha1.RHMI {
+	installation := basicInstallation()
+	stage := integreatlyv1alpha1.RHMIStageStatus{Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.RHMIProductStatus{}}
+	for _, product := range products {
+		stage.Products[product] = integreatlyv1alpha1.RHMIProductStatus{Name: product}
+	}
+	installation.Status.Stages = map[integreatlyv1alpha1.StageName]integreatlyv1alpha1.RHMIStageStatus{
+		integreatlyv1alpha1.ProductsStage: stage,
+	}
+	return installation
+}
+
+func findRule(rules []monitoringv1.Rule, name string) *monitoringv1.Rule {
+	for i := range rules {
+		if rules[i].Record == name || rules[i].Alert == name {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func TestGetSLODefinitions(t *testing.T) {
+	disabled := false
+	installation := sloInstallation(integreatlyv1alpha1.Product3Scale, integreatlyv1alpha1.ProductRHSSO, integreatlyv1alpha1.ProductUps, integreatlyv1alpha1.ProductCloudResources)
+	installation.Spec.Products = map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+		integreatlyv1alpha1.ProductRHSSO: {SLO: &integreatlyv1alpha1.ProductSLO{Target: "99.9", Window: "30d", ErrorRatio: "sum(rate(keycloak_failed_login_attempts[$window])) / sum(rate(keycloak_logins[$window]))"}},
+		integreatlyv1alpha1.ProductUps:   {Enabled: &disabled},
+	}
+
+	slos, err := getSLODefinitions(installation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slos) != 2 || slos[0].product != integreatlyv1alpha1.Product3Scale || slos[1].product != integreatlyv1alpha1.ProductRHSSO {
+		t.Fatalf("expected the slos of 3scale and rhsso, got %v", slos)
+	}
+
+	threescale := slos[0].alertConfiguration("monitoring")
+	if threescale.AlertName != "slo-3scale" {
+		t.Fatalf("unexpected rule name %s", threescale.AlertName)
+	}
+	ratio := findRule(threescale.Rules, "slo:sli_error:ratio_rate5m")
+	if ratio == nil || ratio.Expr.String() != "1 - avg(avg_over_time(probe_success{service=~'3scale-admin-ui|3scale-developer-console-ui|3scale-system-admin-ui'}[5m]))" || ratio.Labels["product"] != "3scale" {
+		t.Fatalf("unexpected error ratio rule %v", ratio)
+	}
+	if objective := findRule(threescale.Rules, "slo:objective:ratio"); objective == nil || objective.Expr.String() != "vector(0.995)" {
+		t.Fatalf("unexpected objective rule %v", objective)
+	}
+	fast := findRule(threescale.Rules, "RHMI3scaleErrorBudgetBurnFast")
+	if fast == nil || !strings.Contains(fast.Expr.String(), "slo:sli_error:ratio_rate1h{product='3scale'} > (13.44 * 0.005)") || fast.Labels["severity"] != "critical" {
+		t.Fatalf("unexpected fast burn alert %v", fast)
+	}
+
+	rhsso := slos[1].alertConfiguration("monitoring")
+	window := findRule(rhsso.Rules, "slo:sli_error:ratio_window")
+	if window == nil || window.Expr.String() != "sum(rate(keycloak_failed_login_attempts[30d])) / sum(rate(keycloak_logins[30d]))" {
+		t.Fatalf("unexpected window error ratio rule %v", window)
+	}
+	slow := findRule(rhsso.Rules, "RHMIRhssoErrorBudgetBurnSlow")
+	if slow == nil || !strings.Contains(slow.Expr.String(), "slo:sli_error:ratio_rate3d{product='rhsso'} > (1 * 0.001)") {
+		t.Fatalf("unexpected slow burn alert %v", slow)
+	}
+}
+
+func TestGetSLODefinitionsValidation(t *testing.T) {
+	cases := []struct {
+		Name string
+		SLO  integreatlyv1alpha1.ProductSLO
+	}{
+		{Name: "test invalid target", SLO: integreatlyv1alpha1.ProductSLO{Target: "100"}},
+		{Name: "test invalid window", SLO: integreatlyv1alpha1.ProductSLO{Window: "a month"}},
+		{Name: "test window shorter than the burn rate windows", SLO: integreatlyv1alpha1.ProductSLO{Window: "1d"}},
+		{Name: "test error ratio without window", SLO: integreatlyv1alpha1.ProductSLO{ErrorRatio: "sum(errors) / sum(requests)"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			slo := tc.SLO
+			installation := sloInstallation(integreatlyv1alpha1.Product3Scale)
+			installation.Spec.Products = map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+				integreatlyv1alpha1.Product3Scale: {SLO: &slo},
+			}
+			if _, err := getSLODefinitions(installation); err == nil {
+				t.Fatalf("expected the slo to be invalid")
+			}
+		})
+	}
+
+	installation := sloInstallation(integreatlyv1alpha1.ProductCloudResources)
+	installation.Spec.Products = map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+		integreatlyv1alpha1.ProductCloudResources: {SLO: &integreatlyv1alpha1.ProductSLO{Target: "99"}},
+	}
+	if _, err := getSLODefinitions(installation); err == nil {
+		t.Fatalf("expected an slo without blackbox services or error ratio to be invalid")
+	}
+}
+
+func TestReconciler_reconcileSLOs(t *testing.T) {
+	scheme, err := getBuildScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	staleRule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "slo-fuse", Namespace: defaultInstallationNamespace},
+	}
+	serverClient := fakeclient.NewFakeClientWithScheme(scheme, staleRule)
+	reconciler := &Reconciler{
+		installation: sloInstallation(integreatlyv1alpha1.ProductApicurito),
+		Logger:       logrus.NewEntry(logrus.StandardLogger()),
+		Config: &config.Monitoring{
+			Config: map[string]string{
+				"OPERATOR_NAMESPACE": defaultInstallationNamespace,
+			},
+		},
+	}
+
+	phase, err := reconciler.reconcileSLOs(context.TODO(), serverClient)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		t.Fatalf("expected phase %s, got %s: %v", integreatlyv1alpha1.PhaseCompleted, phase, err)
+	}
+
+	rule := &monitoringv1.PrometheusRule{}
+	if err := serverClient.Get(context.TODO(), types.NamespacedName{Name: "slo-apicurito", Namespace: defaultInstallationNamespace}, rule); err != nil {
+		t.Fatalf("expected the slo rules of apicurito to be created: %v", err)
+	}
+	if err := serverClient.Get(context.TODO(), types.NamespacedName{Name: "slo-fuse", Namespace: defaultInstallationNamespace}, rule); !k8serr.IsNotFound(err) {
+		t.Fatalf("expected the slo rules of fuse to be removed, got %v", err)
+	}
+}
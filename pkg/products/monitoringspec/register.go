@@ -0,0 +1,20 @@
+package monitoringspec
+
+import (
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+)
+
+func init() {
+	products.Register(integreatlyv1alpha1.ProductMonitoringSpec, products.Registration{
+		Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+			return NewReconciler(deps.ConfigManager, deps.Installation, deps.MPM, deps.Recorder)
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewMonitoringSpec(cfg)
+		},
+		Version:         integreatlyv1alpha1.VersionMonitoringSpec,
+		OperatorVersion: integreatlyv1alpha1.OperatorVersionMonitoringSpec,
+	})
+}
@@ -4,40 +4,17 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
-	"github.com/integr8ly/integreatly-operator/pkg/products/marin3r"
-	"time"
-
-	"github.com/integr8ly/integreatly-operator/pkg/products/monitoringspec"
-
-	keycloakCommon "github.com/integr8ly/keycloak-client/pkg/common"
-
 	"net/http"
+	"time"
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/integreatly-operator/pkg/config"
-	"github.com/integr8ly/integreatly-operator/pkg/products/amqstreams"
-	"github.com/integr8ly/integreatly-operator/pkg/products/apicurioregistry"
-	"github.com/integr8ly/integreatly-operator/pkg/products/apicurito"
-	"github.com/integr8ly/integreatly-operator/pkg/products/cloudresources"
-	"github.com/integr8ly/integreatly-operator/pkg/products/codeready"
-	"github.com/integr8ly/integreatly-operator/pkg/products/datasync"
-	"github.com/integr8ly/integreatly-operator/pkg/products/fuse"
-	"github.com/integr8ly/integreatly-operator/pkg/products/fuseonopenshift"
-	"github.com/integr8ly/integreatly-operator/pkg/products/grafana"
-	"github.com/integr8ly/integreatly-operator/pkg/products/monitoring"
-	"github.com/integr8ly/integreatly-operator/pkg/products/rhsso"
-	"github.com/integr8ly/integreatly-operator/pkg/products/rhssouser"
-	"github.com/integr8ly/integreatly-operator/pkg/products/solutionexplorer"
-	"github.com/integr8ly/integreatly-operator/pkg/products/ups"
 	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
 
-	"github.com/integr8ly/integreatly-operator/pkg/products/amqonline"
-	"github.com/integr8ly/integreatly-operator/pkg/products/threescale"
-	appsv1Client "github.com/openshift/client-go/apps/clientset/versioned/typed/apps/v1"
-	oauthClient "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
-
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -94,8 +71,26 @@ type Interface interface {
 	VerifyVersion(installation *integreatlyv1alpha1.RHMI) bool
 }
 
+// BackupInterface is implemented by the reconcilers of products with data to back up. The backups are performed
+// before the product operator is upgraded, and on demand through RHMIBackup resources
+type BackupInterface interface {
+	GetBackupExecutor() backup.BackupExecutor
+}
+
+// RestoreInterface is implemented by the reconcilers of products that can restore the backups of their
+// GetBackupExecutor. The restore job runs while the product is scaled down, with the names of the backups in the
+// RHMI_RESTORE_BACKUPS environment variable of its containers
+type RestoreInterface interface {
+	GetRestoreJob(ctx context.Context, serverClient k8sclient.Client) (*batchv1.JobSpec, error)
+}
+
+// NewReconciler builds the reconciler of a product using the factory the product registered with Register
 func NewReconciler(product integreatlyv1alpha1.ProductName, rc *rest.Config, configManager config.ConfigReadWriter, installation *integreatlyv1alpha1.RHMI, mgr manager.Manager) (reconciler Interface, err error) {
-	mpm := marketplace.NewManager()
+	registration, ok := getRegistration(product)
+	if !ok {
+		return &NoOp{}, errors.New("unknown products: " + string(product))
+	}
+
 	oauthHttpClient := &http.Client{
 		Timeout: time.Second * 10,
 		Transport: &http.Transport{
@@ -106,100 +101,15 @@ func NewReconciler(product integreatlyv1alpha1.ProductName, rc *rest.Config, con
 	}
 	oauthResolver := resources.NewOauthResolver(oauthHttpClient)
 	oauthResolver.Host = rc.Host
-	recorder := mgr.GetEventRecorderFor(string(product))
-
-	switch product {
-	case integreatlyv1alpha1.ProductAMQStreams:
-		reconciler, err = amqstreams.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductRHSSO:
-		oauthv1Client, err := oauthClient.NewForConfig(rc)
-		oauthv1Client.RESTClient().(*rest.RESTClient).Client.Timeout = 10 * time.Second
-		if err != nil {
-			return nil, err
-		}
-		reconciler, err = rhsso.NewReconciler(configManager, installation, oauthv1Client, mpm, recorder, rc.Host, &keycloakCommon.LocalConfigKeycloakFactory{})
-		if err != nil {
-			return nil, err
-		}
-	case integreatlyv1alpha1.ProductRHSSOUser:
-		oauthv1Client, err := oauthClient.NewForConfig(rc)
-		oauthv1Client.RESTClient().(*rest.RESTClient).Client.Timeout = 10 * time.Second
-		if err != nil {
-			return nil, err
-		}
-		reconciler, err = rhssouser.NewReconciler(configManager, installation, oauthv1Client, mpm, recorder, rc.Host, &keycloakCommon.LocalConfigKeycloakFactory{})
-		if err != nil {
-			return nil, err
-		}
-	case integreatlyv1alpha1.ProductCodeReadyWorkspaces:
-		reconciler, err = codeready.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductFuse:
-		reconciler, err = fuse.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductFuseOnOpenshift:
-		reconciler, err = fuseonopenshift.NewReconciler(configManager, installation, mpm, recorder, &http.Client{}, "")
-	case integreatlyv1alpha1.ProductAMQOnline:
-		reconciler, err = amqonline.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductSolutionExplorer:
-		oauthv1Client, err := oauthClient.NewForConfig(rc)
-		oauthv1Client.RESTClient().(*rest.RESTClient).Client.Timeout = 10 * time.Second
-		if err != nil {
-			return nil, err
-		}
-		reconciler, err = solutionexplorer.NewReconciler(configManager, installation, oauthv1Client, mpm, oauthResolver, recorder)
-		if err != nil {
-			return nil, err
-		}
-	case integreatlyv1alpha1.ProductMonitoring:
-		reconciler, err = monitoring.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductMonitoringSpec:
-		reconciler, err = monitoringspec.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductApicurioRegistry:
-		reconciler, err = apicurioregistry.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductApicurito:
-		reconciler, err = apicurito.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.Product3Scale:
-		client, err := appsv1Client.NewForConfig(rc)
-		client.RESTClient().(*rest.RESTClient).Client.Timeout = 10 * time.Second
-		if err != nil {
-			return nil, err
-		}
 
-		oauthv1Client, err := oauthClient.NewForConfig(rc)
-		oauthv1Client.RESTClient().(*rest.RESTClient).Client.Timeout = 10 * time.Second
-		if err != nil {
-			return nil, err
-		}
-
-		httpc := &http.Client{
-			Timeout: time.Second * 10,
-			Transport: &http.Transport{
-				DisableKeepAlives: true,
-				IdleConnTimeout:   time.Second * 10,
-				TLSClientConfig:   &tls.Config{InsecureSkipVerify: installation.Spec.SelfSignedCerts},
-			},
-		}
-
-		tsClient := threescale.NewThreeScaleClient(httpc, installation.Spec.RoutingSubdomain)
-
-		reconciler, err = threescale.NewReconciler(configManager, installation, client, oauthv1Client, tsClient, mpm, recorder)
-		if err != nil {
-			return nil, err
-		}
-	case integreatlyv1alpha1.ProductUps:
-		reconciler, err = ups.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductCloudResources:
-		reconciler, err = cloudresources.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductDataSync:
-		reconciler, err = datasync.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductMarin3r:
-		reconciler, err = marin3r.NewReconciler(configManager, installation, mpm, recorder)
-	case integreatlyv1alpha1.ProductGrafana:
-		reconciler, err = grafana.NewReconciler(configManager, installation, mpm, recorder)
-	default:
-		err = errors.New("unknown products: " + string(product))
-		reconciler = &NoOp{}
-	}
-	return reconciler, err
+	return registration.Reconciler(Dependencies{
+		RestConfig:    rc,
+		ConfigManager: configManager,
+		Installation:  installation,
+		MPM:           marketplace.NewManager(),
+		Recorder:      mgr.GetEventRecorderFor(string(product)),
+		OauthResolver: oauthResolver,
+	})
 }
 
 type NoOp struct {
@@ -0,0 +1,144 @@
+package products
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
+
+	oauthClient "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+)
+
+// Dependencies are the clients and configuration shared with the factory of every product reconciler
+type Dependencies struct {
+	RestConfig    *rest.Config
+	ConfigManager config.ConfigReadWriter
+	Installation  *integreatlyv1alpha1.RHMI
+	MPM           marketplace.MarketplaceInterface
+	Recorder      record.EventRecorder
+	OauthResolver *resources.OauthResolver
+}
+
+// ReconcilerFactory builds the reconciler of a product
+type ReconcilerFactory func(deps Dependencies) (Interface, error)
+
+// PreflightObjectFunc returns the object the preflight checks look for in a namespace to detect an existing install
+// of a product, or nil if the product is not detected
+type PreflightObjectFunc func(ns string) runtime.Object
+
+// GetPreflightObject makes a PreflightObjectFunc usable as the product detector of the preflight checks
+func (f PreflightObjectFunc) GetPreflightObject(ns string) runtime.Object {
+	return f(ns)
+}
+
+// Registration describes a product to the registry
+type Registration struct {
+	// Reconciler builds the reconciler of the product
+	Reconciler ReconcilerFactory
+	// Config builds the config of the product from what is stored by the config manager
+	Config config.ProductConfigFactory
+	// PreflightObject detects existing installs of the product, products without one are not detected
+	PreflightObject PreflightObjectFunc
+	// Version is the version of the product installed by the operator, left empty when it is not known in advance
+	Version integreatlyv1alpha1.ProductVersion
+	// OperatorVersion is the version of the operator of the product installed by the operator
+	OperatorVersion integreatlyv1alpha1.OperatorVersion
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[integreatlyv1alpha1.ProductName]Registration{}
+)
+
+// Register makes a product available to installation types. Products register themselves from the init function of
+// their package, built-in products are compiled in by importing pkg/products/all. Register panics if the product is
+// registered twice or without a reconciler and config factory
+func Register(product integreatlyv1alpha1.ProductName, registration Registration) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if registration.Reconciler == nil || registration.Config == nil {
+		panic(fmt.Sprintf("products: factories for %s can not be nil", product))
+	}
+	if _, ok := registry[product]; ok {
+		panic(fmt.Sprintf("products: %s is already registered", product))
+	}
+	if registration.PreflightObject == nil {
+		registration.PreflightObject = func(ns string) runtime.Object { return nil }
+	}
+	registry[product] = registration
+	config.RegisterProductConfig(product, registration.Config)
+}
+
+// Unregister removes a product from the registry, allowing tests to replace a product with a fake
+func Unregister(product integreatlyv1alpha1.ProductName) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	delete(registry, product)
+	config.UnregisterProductConfig(product)
+}
+
+// IsRegistered checks if the operator has a reconciler for the product
+func IsRegistered(product integreatlyv1alpha1.ProductName) bool {
+	_, ok := getRegistration(product)
+	return ok
+}
+
+// RegisteredProducts returns the names of every registered product, sorted
+func RegisteredProducts() []integreatlyv1alpha1.ProductName {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	names := make([]integreatlyv1alpha1.ProductName, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// GetPreflightObjectFunc returns the detector of existing installs of a registered product
+func GetPreflightObjectFunc(product integreatlyv1alpha1.ProductName) (PreflightObjectFunc, error) {
+	r, ok := getRegistration(product)
+	if !ok {
+		return nil, fmt.Errorf("unknown product: %s", product)
+	}
+	return r.PreflightObject, nil
+}
+
+// GetVersions returns the versions of a registered product and of its operator installed by the operator
+func GetVersions(product integreatlyv1alpha1.ProductName) (integreatlyv1alpha1.ProductVersion, integreatlyv1alpha1.OperatorVersion, error) {
+	r, ok := getRegistration(product)
+	if !ok {
+		return "", "", fmt.Errorf("unknown product: %s", product)
+	}
+	return r.Version, r.OperatorVersion, nil
+}
+
+func getRegistration(product integreatlyv1alpha1.ProductName) (Registration, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	r, ok := registry[product]
+	return r, ok
+}
+
+// NewOauthClient builds the OAuth client used by the products that manage OAuth clients
+func NewOauthClient(rc *rest.Config) (oauthClient.OauthV1Interface, error) {
+	oauthv1Client, err := oauthClient.NewForConfig(rc)
+	if err != nil {
+		return nil, err
+	}
+	oauthv1Client.RESTClient().(*rest.RESTClient).Client.Timeout = 10 * time.Second
+	return oauthv1Client, nil
+}
@@ -0,0 +1,112 @@
+package products
+
+import (
+	"context"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const fakeProduct integreatlyv1alpha1.ProductName = "fake-product"
+
+func TestRegister(t *testing.T) {
+	reconciler := &InterfaceMock{
+		ReconcileFunc: func(ctx context.Context, installation *integreatlyv1alpha1.RHMI, product *integreatlyv1alpha1.RHMIProductStatus, serverClient k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
+			return integreatlyv1alpha1.PhaseCompleted, nil
+		},
+		GetPreflightObjectFunc: func(ns string) runtime.Object {
+			return nil
+		},
+		VerifyVersionFunc: func(installation *integreatlyv1alpha1.RHMI) bool {
+			return true
+		},
+	}
+
+	Register(fakeProduct, Registration{
+		Reconciler: func(deps Dependencies) (Interface, error) {
+			return reconciler, nil
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewUps(cfg)
+		},
+		Version:         "1.0",
+		OperatorVersion: "0.1.0",
+	})
+	defer Unregister(fakeProduct)
+
+	if !IsRegistered(fakeProduct) {
+		t.Fatalf("expected %s to be registered", fakeProduct)
+	}
+
+	registration, _ := getRegistration(fakeProduct)
+	built, err := registration.Reconciler(Dependencies{})
+	if err != nil {
+		t.Fatalf("unexpected error building reconciler: %v", err)
+	}
+	if built != reconciler {
+		t.Fatalf("expected the registered reconciler to be built")
+	}
+
+	version, operatorVersion, err := GetVersions(fakeProduct)
+	if err != nil || version != "1.0" || operatorVersion != "0.1.0" {
+		t.Fatalf("expected the registered versions, got %s and %s: %v", version, operatorVersion, err)
+	}
+	detector, err := GetPreflightObjectFunc(fakeProduct)
+	if err != nil {
+		t.Fatalf("unexpected error getting the preflight object: %v", err)
+	}
+	if detector.GetPreflightObject("test") != nil {
+		t.Fatalf("expected a product registered without a preflight object not to be detected")
+	}
+
+	// the config of the registered product is readable through the config manager
+	fakeClient := fake.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "installation-config", Namespace: "test"},
+		Data:       map[string]string{string(fakeProduct): "NAMESPACE: fake-namespace"},
+	})
+	configManager, err := config.NewManager(context.TODO(), fakeClient, "test", "installation-config", &integreatlyv1alpha1.RHMI{})
+	if err != nil {
+		t.Fatalf("unexpected error creating config manager: %v", err)
+	}
+	productConfig, err := configManager.ReadProduct(fakeProduct)
+	if err != nil {
+		t.Fatalf("unexpected error reading config: %v", err)
+	}
+	if productConfig.GetNamespace() != "fake-namespace" {
+		t.Fatalf("expected namespace fake-namespace but got %s", productConfig.GetNamespace())
+	}
+
+	Unregister(fakeProduct)
+	if IsRegistered(fakeProduct) {
+		t.Fatalf("expected %s to be unregistered", fakeProduct)
+	}
+	if _, err := configManager.ReadProduct(fakeProduct); err == nil {
+		t.Fatalf("expected an error reading the config of an unregistered product")
+	}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected registering a product twice to panic")
+		}
+	}()
+	registration := Registration{
+		Reconciler: func(deps Dependencies) (Interface, error) {
+			return &NoOp{}, nil
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewUps(cfg)
+		},
+	}
+	Register(fakeProduct, registration)
+	defer Unregister(fakeProduct)
+	Register(fakeProduct, registration)
+}
@@ -16,6 +16,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetNamespace(),
 				GroupName: "rhsso-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -44,6 +45,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetOperatorNamespace(),
 				GroupName: "rhsso-operator-endpoint.rules",
 				Rules: []monitoringv1.Rule{
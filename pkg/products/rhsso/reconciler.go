@@ -18,6 +18,7 @@ import (
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/integreatly-operator/pkg/config"
 	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
 	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
 	"github.com/keycloak/keycloak-operator/pkg/common"
@@ -143,12 +144,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
-	phase, err = r.ReconcileSubscription(ctx, serverClient, installation, productNamespace, operatorNamespace, postgresResourceName)
+	phase, err = r.ReconcileSubscription(ctx, serverClient, installation, integreatlyv1alpha1.ProductRHSSO, productNamespace, operatorNamespace, postgresResourceName)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.Recorder, installation, phase, fmt.Sprintf("Failed to reconcile %s subscription", constants.RHSSOSubscriptionName), err)
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.RHSSOSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.Recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.RHSSOSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.CreateKeycloakRoute(ctx, serverClient, r.Config, r.Config.RHSSOCommon)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.Recorder, installation, phase, "Failed to handle in progress phase", err)
@@ -172,6 +179,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedVersion, err = r.GetObservedVersion(ctx, serverClient, keycloakName, r.Config.GetNamespace())
+	if err != nil {
+		events.HandleError(r.Recorder, installation, integreatlyv1alpha1.PhaseFailed, "Failed to read the installed RHSSO version", err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	err = r.ConfigManager.WriteConfig(r.Config)
 	if err != nil {
 		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("Error writing to config in rhsso cluster reconciler: %w", err)
@@ -310,19 +323,15 @@ func (r *Reconciler) reconcileComponents(ctx context.Context, installation *inte
 	}
 
 	// Sync keycloak with openshift users
-	users, err := syncronizeWithOpenshiftUsers(ctx, keycloakUsers, serverClient, r.Config.GetNamespace())
+	policy, err := userHelper.GetPolicy(ctx, serverClient)
 	if err != nil {
-		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to synchronize the users: %w", err)
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to get the users policy: %w", err)
 	}
-
-	// Create / update the synchronized users
-	for _, user := range users {
-		or, err = r.createOrUpdateKeycloakUser(ctx, user, serverClient)
-		if err != nil {
-			return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to create/update the customer admin user: %w", err)
-		}
-		r.Logger.Infof("The operation result for keycloakuser %s was %s", user.UserName, or)
-
+	sync := userHelper.NewSync(integreatlyv1alpha1.ProductRHSSO, policy, r.Logger)
+	err = r.syncronizeWithOpenshiftUsers(ctx, sync, policy, keycloakUsers, serverClient)
+	installation.SetUserSyncStatus(sync.Status())
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to synchronize the users: %w", err)
 	}
 	return integreatlyv1alpha1.PhaseCompleted, nil
 }
@@ -395,15 +404,15 @@ func (r *Reconciler) setupGithubIDP(ctx context.Context, kc *keycloak.Keycloak,
 	return nil
 }
 
-func getUserDiff(keycloakUsers []keycloak.KeycloakAPIUser, openshiftUsers []usersv1.User, groups *usersv1.GroupList) (added []usersv1.User, deleted []keycloak.KeycloakAPIUser) {
+func getUserDiff(policy *userHelper.Policy, keycloakUsers []keycloak.KeycloakAPIUser, openshiftUsers []usersv1.User, groups *usersv1.GroupList) (added []usersv1.User, deleted []keycloak.KeycloakAPIUser) {
 	for _, osUser := range openshiftUsers {
-		if !kcContainsOsUser(keycloakUsers, osUser) && !userHelper.UserInExclusionGroup(osUser, groups) {
+		if !kcContainsOsUser(keycloakUsers, osUser) && !policy.IsExcluded(osUser, groups) {
 			added = append(added, osUser)
 		}
 	}
 
 	for _, kcUser := range keycloakUsers {
-		if !rhssocommon.OsUserInKc(openshiftUsers, kcUser) {
+		if kcUser.UserName != "" && !rhssocommon.OsUserInKc(openshiftUsers, kcUser) {
 			deleted = append(deleted, kcUser)
 		}
 	}
@@ -411,63 +420,101 @@ func getUserDiff(keycloakUsers []keycloak.KeycloakAPIUser, openshiftUsers []user
 	return added, deleted
 }
 
-func syncronizeWithOpenshiftUsers(ctx context.Context, keycloakUsers []keycloak.KeycloakAPIUser, serverClient k8sclient.Client, ns string) ([]keycloak.KeycloakAPIUser, error) {
+// syncronizeWithOpenshiftUsers adds the OpenShift users missing from the realm and deletes the users removed from
+// OpenShift through the sync, then ensures the client roles of the remaining users
+func (r *Reconciler) syncronizeWithOpenshiftUsers(ctx context.Context, sync *userHelper.Sync, policy *userHelper.Policy, keycloakUsers []keycloak.KeycloakAPIUser, serverClient k8sclient.Client) error {
 	openshiftUsers := &usersv1.UserList{}
 	err := serverClient.List(ctx, openshiftUsers)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	groups := &usersv1.GroupList{}
 	err = serverClient.List(ctx, groups)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	added, deletedUsers := getUserDiff(keycloakUsers, openshiftUsers.Items, groups)
-
-	keycloakUsers, err = rhssocommon.DeleteKeycloakUsers(keycloakUsers, deletedUsers, ns, ctx, serverClient)
-	if err != nil {
-		return nil, err
-	}
+	added, deletedUsers := getUserDiff(policy, keycloakUsers, openshiftUsers.Items, groups)
 
+	changes := []userHelper.SyncChange{}
 	for _, osUser := range added {
-		email, err := userHelper.GetUserEmailFromIdentity(ctx, serverClient, osUser)
+		osUser := osUser
+		changes = append(changes, userHelper.SyncChange{
+			Action: integreatlyv1alpha1.UserSyncAdd,
+			User:   osUser.Name,
+			Apply: func(ctx context.Context) error {
+				newKeycloakUser, err := newKeycloakUser(ctx, serverClient, osUser)
+				if err != nil {
+					return err
+				}
+				_, err = r.createOrUpdateKeycloakUser(ctx, newKeycloakUser, serverClient)
+				return err
+			},
+		})
+	}
+	for _, kcUser := range deletedUsers {
+		kcUser := kcUser
+		changes = append(changes, userHelper.SyncChange{
+			Action: integreatlyv1alpha1.UserSyncDelete,
+			User:   kcUser.UserName,
+			Apply: func(ctx context.Context) error {
+				return rhssocommon.DeleteKeycloakUser(ctx, serverClient, kcUser, r.Config.GetNamespace())
+			},
+		})
+	}
+	sync.Apply(ctx, changes)
 
-		if err != nil {
-			return nil, err
+	for _, user := range keycloakUsers {
+		if containsKeycloakUser(deletedUsers, user) {
+			continue
 		}
-
-		if email == "" {
-			email = osUser.Name + "@rhmi.io"
+		user.ClientRoles = getKeycloakRoles()
+		or, err := r.createOrUpdateKeycloakUser(ctx, user, serverClient)
+		if err != nil {
+			return fmt.Errorf("failed to create/update the customer admin user: %w", err)
 		}
+		r.Logger.Infof("The operation result for keycloakuser %s was %s", user.UserName, or)
+	}
+	return nil
+}
 
-		newKeycloakUser := keycloak.KeycloakAPIUser{
-			Enabled:       true,
-			UserName:      osUser.Name,
-			EmailVerified: true,
-			Email:         email,
-			FederatedIdentities: []keycloak.FederatedIdentity{
-				{
-					IdentityProvider: idpAlias,
-					UserID:           string(osUser.UID),
-					UserName:         osUser.Name,
-				},
-			},
-		}
-		userHelper.AppendUpdateProfileActionForUserWithoutEmail(&newKeycloakUser)
+func newKeycloakUser(ctx context.Context, serverClient k8sclient.Client, osUser usersv1.User) (keycloak.KeycloakAPIUser, error) {
+	email, err := userHelper.GetUserEmailFromIdentity(ctx, serverClient, osUser)
+	if err != nil {
+		return keycloak.KeycloakAPIUser{}, err
+	}
 
-		keycloakUsers = append(keycloakUsers, newKeycloakUser)
+	if email == "" {
+		email = osUser.Name + "@rhmi.io"
 	}
 
-	if err != nil && !k8serr.IsNotFound(err) {
-		return nil, err
+	newKeycloakUser := keycloak.KeycloakAPIUser{
+		Enabled:       true,
+		UserName:      osUser.Name,
+		EmailVerified: true,
+		Email:         email,
+		FederatedIdentities: []keycloak.FederatedIdentity{
+			{
+				IdentityProvider: idpAlias,
+				UserID:           string(osUser.UID),
+				UserName:         osUser.Name,
+			},
+		},
+		ClientRoles: getKeycloakRoles(),
 	}
-	for index := range keycloakUsers {
-		keycloakUsers[index].ClientRoles = getKeycloakRoles()
+	userHelper.AppendUpdateProfileActionForUserWithoutEmail(&newKeycloakUser)
+	return newKeycloakUser, nil
+}
+
+func containsKeycloakUser(kcUsers []keycloak.KeycloakAPIUser, kcUser keycloak.KeycloakAPIUser) bool {
+	for _, kcu := range kcUsers {
+		if kcu.UserName == kcUser.UserName {
+			return true
+		}
 	}
 
-	return keycloakUsers, nil
+	return false
 }
 
 func kcContainsOsUser(kcUsers []keycloak.KeycloakAPIUser, osUser usersv1.User) bool {
@@ -585,3 +632,9 @@ func GetInstanceLabels() map[string]string {
 		SSOLabelKey: SSOLabelValue,
 	}
 }
+
+// GetBackupExecutor returns the backups of the product performed on demand through RHMIBackup resources. The product
+// data is not backed up on cluster storage, where the backups are reported as unsupported
+func (r *Reconciler) GetBackupExecutor() backup.BackupExecutor {
+	return r.BackupsExecutor(postgresResourceName)
+}
@@ -0,0 +1,467 @@
+package rhssocommon
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	keycloakCommon "github.com/integr8ly/keycloak-client/pkg/common"
+	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultFirstBrokerLoginFlow = "first broker login"
+	defaultNameIDPolicyFormat   = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+
+	oidcClientSecretKey       = "clientSecret"
+	samlSigningCertificateKey = "signingCertificate"
+	ldapBindCredentialKey     = "bindCredential"
+)
+
+// SetupIdentityProviders declares the OIDC and SAML providers of spec.authentication in the realm, next to the
+// OpenShift provider. The Keycloak operator only applies the realm when it creates it, so the providers are then
+// reconciled into the live realm by ReconcileIdentityProviders
+func (r *Reconciler) SetupIdentityProviders(ctx context.Context, serverClient k8sclient.Client, kcr *keycloak.KeycloakRealm) error {
+	if r.Installation.Spec.Authentication == nil {
+		return nil
+	}
+	for _, idp := range r.Installation.Spec.Authentication.IdentityProviders {
+		if idp.Type == integreatlyv1alpha1.IdentityProviderLDAP || ContainsIdentityProvider(kcr.Spec.Realm.IdentityProviders, idp.Alias) {
+			continue
+		}
+		secret, err := r.getIdentityProviderSecret(ctx, serverClient, idp)
+		if err != nil {
+			return err
+		}
+		kcr.Spec.Realm.IdentityProviders = append(kcr.Spec.Realm.IdentityProviders, newKeycloakIdentityProvider(idp, secret))
+	}
+	return nil
+}
+
+// ReconcileIdentityProviders federates the identity providers declared in spec.authentication into the realm of the
+// KeycloakRealm. OIDC and SAML providers are the brokered identity providers declared in the realm by
+// SetupIdentityProviders and LDAP providers are user storage components. Providers removed from the spec are removed
+// from the realm, providers that were not federated from the spec, such as the OpenShift provider, are left
+// untouched. The federated providers are recorded in the config
+func (r *Reconciler) ReconcileIdentityProviders(ctx context.Context, serverClient k8sclient.Client, kc *keycloak.Keycloak, kcr *keycloak.KeycloakRealm, cfg config.ConfigReadable, ssoCommon *config.RHSSOCommon) (integreatlyv1alpha1.StatusPhase, error) {
+	realmName := kcr.Spec.Realm.Realm
+	idps := []integreatlyv1alpha1.IdentityProviderSpec{}
+	if r.Installation.Spec.Authentication != nil {
+		idps = r.Installation.Spec.Authentication.IdentityProviders
+	}
+	federated := ssoCommon.GetIdentityProviders()
+	if len(idps) == 0 && len(federated) == 0 {
+		return integreatlyv1alpha1.PhaseCompleted, nil
+	}
+
+	kcClient, err := r.KeycloakClientFactory.AuthenticatedClient(*kc)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to authenticate client in keycloak api %w", err)
+	}
+	federationClient, err := r.KeycloakFederationClientFactory.AuthenticatedClient(ctx, serverClient, *kc)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to authenticate federation client in keycloak api %w", err)
+	}
+
+	brokered, err := kcClient.ListIdentityProviders(realmName)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to list identity providers via keycloak api %w", err)
+	}
+	ldapProviders, err := federationClient.ListComponents("", UserStorageProviderType, realmName)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to list user storage providers via keycloak api %w", err)
+	}
+
+	aliases := []string{}
+	ldapMappers := []string{}
+	for _, idp := range idps {
+		secret, err := r.getIdentityProviderSecret(ctx, serverClient, idp)
+		if err != nil {
+			return integreatlyv1alpha1.PhaseFailed, err
+		}
+
+		// a provider federated with another type is removed before it is federated again
+		if idp.Type == integreatlyv1alpha1.IdentityProviderLDAP {
+			if resources.Contains(federated, idp.Alias) && ContainsIdentityProvider(brokered, idp.Alias) {
+				if err := kcClient.DeleteIdentityProvider(idp.Alias, realmName); err != nil {
+					return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to delete identity provider %s via keycloak api %w", idp.Alias, err)
+				}
+			}
+			mappers, err := reconcileLDAPProvider(federationClient, realmName, idp, secret, ldapProviders, ssoCommon.GetLDAPMappers())
+			if err != nil {
+				return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to reconcile ldap provider %s: %w", idp.Alias, err)
+			}
+			ldapMappers = append(ldapMappers, mappers...)
+		} else {
+			if component := findComponent(ldapProviders, idp.Alias); resources.Contains(federated, idp.Alias) && component != nil {
+				if err := federationClient.DeleteComponent(component.ID, realmName); err != nil {
+					return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to delete user storage provider %s via keycloak api %w", idp.Alias, err)
+				}
+			}
+			desired := findIdentityProvider(kcr.Spec.Realm.IdentityProviders, idp.Alias)
+			if desired == nil {
+				return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("identity provider %s is not declared in the %s realm", idp.Alias, kcr.Name)
+			}
+			if err := reconcileBrokeredIdentityProvider(kcClient, federationClient, realmName, idp, desired, brokered); err != nil {
+				return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to reconcile identity provider %s: %w", idp.Alias, err)
+			}
+		}
+		aliases = append(aliases, idp.Alias)
+	}
+
+	for _, alias := range federated {
+		if resources.Contains(aliases, alias) {
+			continue
+		}
+		if ContainsIdentityProvider(brokered, alias) {
+			if err := kcClient.DeleteIdentityProvider(alias, realmName); err != nil {
+				return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to delete identity provider %s via keycloak api %w", alias, err)
+			}
+		}
+		// the mappers of the provider are deleted with it
+		if component := findComponent(ldapProviders, alias); component != nil {
+			if err := federationClient.DeleteComponent(component.ID, realmName); err != nil {
+				return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to delete user storage provider %s via keycloak api %w", alias, err)
+			}
+		}
+		r.Logger.Infof("Removed identity provider %s from the %s realm", alias, realmName)
+	}
+
+	ssoCommon.SetIdentityProviders(aliases)
+	ssoCommon.SetLDAPMappers(ldapMappers)
+	if err := r.ConfigManager.WriteConfig(cfg); err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("error writing to config in rhsso reconciler: %w", err)
+	}
+	return integreatlyv1alpha1.PhaseCompleted, nil
+}
+
+// getIdentityProviderSecret reads the secret of the provider from the installation namespace, ensuring it contains
+// the credentials the type of the provider requires
+func (r *Reconciler) getIdentityProviderSecret(ctx context.Context, serverClient k8sclient.Client, idp integreatlyv1alpha1.IdentityProviderSpec) (map[string][]byte, error) {
+	if idp.SecretRef == "" {
+		return map[string][]byte{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: idp.SecretRef, Namespace: r.Installation.Namespace}, secret); err != nil {
+		return nil, fmt.Errorf("could not find %s secret of identity provider %s: %w", idp.SecretRef, idp.Alias, err)
+	}
+
+	required := map[integreatlyv1alpha1.IdentityProviderType]string{
+		integreatlyv1alpha1.IdentityProviderOIDC: oidcClientSecretKey,
+		integreatlyv1alpha1.IdentityProviderLDAP: ldapBindCredentialKey,
+	}
+	if key, ok := required[idp.Type]; ok && len(secret.Data[key]) == 0 {
+		return nil, fmt.Errorf("could not find %s key in %s secret of identity provider %s", key, idp.SecretRef, idp.Alias)
+	}
+	return secret.Data, nil
+}
+
+// reconcileBrokeredIdentityProvider creates or updates the provider declared in the realm and its mappers
+func reconcileBrokeredIdentityProvider(kcClient keycloakCommon.KeycloakInterface, federationClient KeycloakFederationInterface, realmName string, idp integreatlyv1alpha1.IdentityProviderSpec, declared *keycloak.KeycloakIdentityProvider, brokered []*keycloak.KeycloakIdentityProvider) error {
+	desired := declared.DeepCopy()
+
+	var existing *keycloak.KeycloakIdentityProvider
+	for _, provider := range brokered {
+		if provider.Alias == idp.Alias {
+			existing = provider
+		}
+	}
+	if existing == nil {
+		if _, err := kcClient.CreateIdentityProvider(desired, realmName); err != nil {
+			return fmt.Errorf("failed to create identity provider via keycloak api %w", err)
+		}
+	} else {
+		desired.InternalID = existing.InternalID
+		if err := kcClient.UpdateIdentityProvider(desired, realmName); err != nil {
+			return fmt.Errorf("failed to update identity provider via keycloak api %w", err)
+		}
+	}
+
+	// the mappers of a federated provider are all declared in the spec
+	mappers, err := federationClient.ListIdentityProviderMappers(idp.Alias, realmName)
+	if err != nil {
+		return fmt.Errorf("failed to list identity provider mappers via keycloak api %w", err)
+	}
+	for _, mapper := range idp.Mappers {
+		desiredMapper := newIdentityProviderMapper(idp, mapper)
+		existingMapper := findIdentityProviderMapper(mappers, mapper.Name)
+		if existingMapper == nil {
+			if err := federationClient.CreateIdentityProviderMapper(desiredMapper, realmName); err != nil {
+				return fmt.Errorf("failed to create identity provider mapper %s via keycloak api %w", mapper.Name, err)
+			}
+			continue
+		}
+		if existingMapper.IdentityProviderMapper == desiredMapper.IdentityProviderMapper && containsConfig(existingMapper.Config, desiredMapper.Config) {
+			continue
+		}
+		desiredMapper.ID = existingMapper.ID
+		if err := federationClient.UpdateIdentityProviderMapper(desiredMapper, realmName); err != nil {
+			return fmt.Errorf("failed to update identity provider mapper %s via keycloak api %w", mapper.Name, err)
+		}
+	}
+	for _, existingMapper := range mappers {
+		if findMapperSpec(idp.Mappers, existingMapper.Name) == nil {
+			if err := federationClient.DeleteIdentityProviderMapper(existingMapper, realmName); err != nil {
+				return fmt.Errorf("failed to delete identity provider mapper %s via keycloak api %w", existingMapper.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func newKeycloakIdentityProvider(idp integreatlyv1alpha1.IdentityProviderSpec, secret map[string][]byte) *keycloak.KeycloakIdentityProvider {
+	firstBrokerLoginFlow := idp.FirstBrokerLoginFlow
+	if firstBrokerLoginFlow == "" {
+		firstBrokerLoginFlow = defaultFirstBrokerLoginFlow
+	}
+
+	provider := &keycloak.KeycloakIdentityProvider{
+		Alias:                     idp.Alias,
+		DisplayName:               idp.DisplayName,
+		Enabled:                   true,
+		TrustEmail:                idp.TrustEmail,
+		FirstBrokerLoginFlowAlias: firstBrokerLoginFlow,
+		Config: map[string]string{
+			"hideOnLoginPage": strconv.FormatBool(idp.HideOnLoginPage),
+			"syncMode":        "IMPORT",
+		},
+	}
+
+	switch idp.Type {
+	case integreatlyv1alpha1.IdentityProviderOIDC:
+		scopes := idp.OIDC.Scopes
+		if len(scopes) == 0 {
+			scopes = []string{"openid"}
+		}
+		provider.ProviderID = "oidc"
+		provider.Config["authorizationUrl"] = idp.OIDC.AuthorizationURL
+		provider.Config["tokenUrl"] = idp.OIDC.TokenURL
+		provider.Config["userInfoUrl"] = idp.OIDC.UserInfoURL
+		provider.Config["issuer"] = idp.OIDC.Issuer
+		provider.Config["clientId"] = idp.OIDC.ClientID
+		provider.Config["clientSecret"] = string(secret[oidcClientSecretKey])
+		provider.Config["clientAuthMethod"] = "client_secret_post"
+		provider.Config["defaultScope"] = strings.Join(scopes, " ")
+		if idp.OIDC.JwksURL != "" {
+			provider.Config["validateSignature"] = "true"
+			provider.Config["useJwksUrl"] = "true"
+			provider.Config["jwksUrl"] = idp.OIDC.JwksURL
+		}
+	case integreatlyv1alpha1.IdentityProviderSAML:
+		nameIDPolicyFormat := idp.SAML.NameIDPolicyFormat
+		if nameIDPolicyFormat == "" {
+			nameIDPolicyFormat = defaultNameIDPolicyFormat
+		}
+		provider.ProviderID = "saml"
+		provider.Config["singleSignOnServiceUrl"] = idp.SAML.SingleSignOnServiceURL
+		provider.Config["singleLogoutServiceUrl"] = idp.SAML.SingleLogoutServiceURL
+		provider.Config["nameIDPolicyFormat"] = nameIDPolicyFormat
+		provider.Config["postBindingResponse"] = "true"
+		provider.Config["postBindingAuthnRequest"] = "true"
+		provider.Config["principalType"] = "SUBJECT"
+		if idp.SAML.PrincipalAttribute != "" {
+			provider.Config["principalType"] = "ATTRIBUTE"
+			provider.Config["principalAttribute"] = idp.SAML.PrincipalAttribute
+		}
+		if certificate := string(secret[samlSigningCertificateKey]); certificate != "" {
+			provider.Config["validateSignature"] = "true"
+			provider.Config["signingCertificate"] = certificate
+		}
+	}
+	return provider
+}
+
+func newIdentityProviderMapper(idp integreatlyv1alpha1.IdentityProviderSpec, mapper integreatlyv1alpha1.IdentityProviderMapper) *IdentityProviderMapper {
+	result := &IdentityProviderMapper{
+		Name:                   mapper.Name,
+		IdentityProviderAlias:  idp.Alias,
+		IdentityProviderMapper: "oidc-user-attribute-idp-mapper",
+		Config: map[string]string{
+			"claim":          mapper.Attribute,
+			"user.attribute": mapper.UserAttribute,
+			"syncMode":       "INHERIT",
+		},
+	}
+	if idp.Type == integreatlyv1alpha1.IdentityProviderSAML {
+		result.IdentityProviderMapper = "saml-user-attribute-idp-mapper"
+		result.Config = map[string]string{
+			"attribute.name": mapper.Attribute,
+			"user.attribute": mapper.UserAttribute,
+			"syncMode":       "INHERIT",
+		}
+	}
+	return result
+}
+
+// reconcileLDAPProvider creates or updates the user storage component of the provider and its mappers, returning
+// the mappers federated from the spec. Keycloak creates default mappers with the provider, so only the mappers that
+// were federated before can be removed
+func reconcileLDAPProvider(federationClient KeycloakFederationInterface, realmName string, idp integreatlyv1alpha1.IdentityProviderSpec, secret map[string][]byte, ldapProviders []*Component, federatedMappers []string) ([]string, error) {
+	desired := newLDAPComponent(idp, secret)
+	if existing := findComponent(ldapProviders, idp.Alias); existing != nil {
+		desired.ID = existing.ID
+		desired.ParentID = existing.ParentID
+		// the bind credential is not returned by the api, so the provider is always updated
+		if err := federationClient.UpdateComponent(desired, realmName); err != nil {
+			return nil, fmt.Errorf("failed to update user storage provider via keycloak api %w", err)
+		}
+	} else {
+		id, err := federationClient.CreateComponent(desired, realmName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user storage provider via keycloak api %w", err)
+		}
+		desired.ID = id
+	}
+
+	mappers, err := federationClient.ListComponents(desired.ID, LDAPStorageMapperType, realmName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ldap mappers via keycloak api %w", err)
+	}
+	federated := []string{}
+	for _, mapper := range idp.Mappers {
+		desiredMapper := newLDAPMapperComponent(desired.ID, mapper)
+		existingMapper := findComponent(mappers, mapper.Name)
+		if existingMapper == nil {
+			if _, err := federationClient.CreateComponent(desiredMapper, realmName); err != nil {
+				return nil, fmt.Errorf("failed to create ldap mapper %s via keycloak api %w", mapper.Name, err)
+			}
+		} else if existingMapper.ProviderID != desiredMapper.ProviderID || !containsComponentConfig(existingMapper.Config, desiredMapper.Config) {
+			desiredMapper.ID = existingMapper.ID
+			if err := federationClient.UpdateComponent(desiredMapper, realmName); err != nil {
+				return nil, fmt.Errorf("failed to update ldap mapper %s via keycloak api %w", mapper.Name, err)
+			}
+		}
+		federated = append(federated, idp.Alias+"/"+mapper.Name)
+	}
+	for _, existingMapper := range mappers {
+		name := idp.Alias + "/" + existingMapper.Name
+		if resources.Contains(federatedMappers, name) && !resources.Contains(federated, name) {
+			if err := federationClient.DeleteComponent(existingMapper.ID, realmName); err != nil {
+				return nil, fmt.Errorf("failed to delete ldap mapper %s via keycloak api %w", existingMapper.Name, err)
+			}
+		}
+	}
+	return federated, nil
+}
+
+func newLDAPComponent(idp integreatlyv1alpha1.IdentityProviderSpec, secret map[string][]byte) *Component {
+	ldap := idp.LDAP
+	vendor, usernameAttribute, rdnAttribute, uuidAttribute := "other", "uid", "uid", "entryUUID"
+	objectClasses := []string{"inetOrgPerson", "organizationalPerson"}
+	if ldap.ActiveDirectory {
+		vendor, usernameAttribute, rdnAttribute, uuidAttribute = "ad", "sAMAccountName", "cn", "objectGUID"
+		objectClasses = []string{"person", "organizationalPerson", "user"}
+	}
+	if ldap.UsernameAttribute != "" {
+		usernameAttribute = ldap.UsernameAttribute
+	}
+	if len(ldap.UserObjectClasses) > 0 {
+		objectClasses = ldap.UserObjectClasses
+	}
+
+	return &Component{
+		Name:         idp.Alias,
+		ProviderID:   "ldap",
+		ProviderType: UserStorageProviderType,
+		Config: map[string][]string{
+			"enabled":                {"true"},
+			"priority":               {"0"},
+			"vendor":                 {vendor},
+			"connectionUrl":          {ldap.ConnectionURL},
+			"authType":               {"simple"},
+			"bindDn":                 {ldap.BindDN},
+			"bindCredential":         {string(secret[ldapBindCredentialKey])},
+			"usersDn":                {ldap.UsersDN},
+			"usernameLDAPAttribute":  {usernameAttribute},
+			"rdnLDAPAttribute":       {rdnAttribute},
+			"uuidLDAPAttribute":      {uuidAttribute},
+			"userObjectClasses":      {strings.Join(objectClasses, ", ")},
+			"customUserSearchFilter": {ldap.UserSearchFilter},
+			"searchScope":            {"2"},
+			"editMode":               {"READ_ONLY"},
+			"importEnabled":          {"true"},
+			"syncRegistrations":      {"false"},
+			"pagination":             {"true"},
+			"trustEmail":             {strconv.FormatBool(idp.TrustEmail)},
+		},
+	}
+}
+
+func newLDAPMapperComponent(parentID string, mapper integreatlyv1alpha1.IdentityProviderMapper) *Component {
+	return &Component{
+		Name:         mapper.Name,
+		ProviderID:   "user-attribute-ldap-mapper",
+		ProviderType: LDAPStorageMapperType,
+		ParentID:     parentID,
+		Config: map[string][]string{
+			"ldap.attribute":              {mapper.Attribute},
+			"user.model.attribute":        {mapper.UserAttribute},
+			"read.only":                   {"true"},
+			"always.read.value.from.ldap": {"true"},
+			"is.mandatory.in.ldap":        {"false"},
+		},
+	}
+}
+
+func findComponent(components []*Component, name string) *Component {
+	for _, component := range components {
+		if component.Name == name {
+			return component
+		}
+	}
+	return nil
+}
+
+func findIdentityProvider(providers []*keycloak.KeycloakIdentityProvider, alias string) *keycloak.KeycloakIdentityProvider {
+	for _, provider := range providers {
+		if provider.Alias == alias {
+			return provider
+		}
+	}
+	return nil
+}
+
+func findIdentityProviderMapper(mappers []*IdentityProviderMapper, name string) *IdentityProviderMapper {
+	for _, mapper := range mappers {
+		if mapper.Name == name {
+			return mapper
+		}
+	}
+	return nil
+}
+
+func findMapperSpec(mappers []integreatlyv1alpha1.IdentityProviderMapper, name string) *integreatlyv1alpha1.IdentityProviderMapper {
+	for i := range mappers {
+		if mappers[i].Name == name {
+			return &mappers[i]
+		}
+	}
+	return nil
+}
+
+// containsConfig returns true when every entry of desired is set in config, Keycloak adds the defaults of the
+// mapper to the config it returns
+func containsConfig(config, desired map[string]string) bool {
+	for key, value := range desired {
+		if config[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func containsComponentConfig(config, desired map[string][]string) bool {
+	for key, values := range desired {
+		if strings.Join(config[key], ",") != strings.Join(values, ",") {
+			return false
+		}
+	}
+	return true
+}
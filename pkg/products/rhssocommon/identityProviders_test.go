@@ -0,0 +1,339 @@
+package rhssocommon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	keycloakCommon "github.com/integr8ly/keycloak-client/pkg/common"
+	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeKeycloakFederation keeps the mappers and components of a realm in memory
+type fakeKeycloakFederation struct {
+	mappers    []*IdentityProviderMapper
+	components []*Component
+}
+
+func (f *fakeKeycloakFederation) AuthenticatedClient(_ context.Context, _ k8sclient.Client, _ keycloak.Keycloak) (KeycloakFederationInterface, error) {
+	return f, nil
+}
+
+func (f *fakeKeycloakFederation) ListIdentityProviderMappers(alias, _ string) ([]*IdentityProviderMapper, error) {
+	mappers := []*IdentityProviderMapper{}
+	for _, mapper := range f.mappers {
+		if mapper.IdentityProviderAlias == alias {
+			mappers = append(mappers, mapper)
+		}
+	}
+	return mappers, nil
+}
+
+func (f *fakeKeycloakFederation) CreateIdentityProviderMapper(mapper *IdentityProviderMapper, _ string) error {
+	mapper.ID = fmt.Sprintf("mapper-%d", len(f.mappers))
+	f.mappers = append(f.mappers, mapper)
+	return nil
+}
+
+func (f *fakeKeycloakFederation) UpdateIdentityProviderMapper(mapper *IdentityProviderMapper, _ string) error {
+	for i, existing := range f.mappers {
+		if existing.ID == mapper.ID {
+			f.mappers[i] = mapper
+		}
+	}
+	return nil
+}
+
+func (f *fakeKeycloakFederation) DeleteIdentityProviderMapper(mapper *IdentityProviderMapper, _ string) error {
+	mappers := []*IdentityProviderMapper{}
+	for _, existing := range f.mappers {
+		if existing.ID != mapper.ID {
+			mappers = append(mappers, existing)
+		}
+	}
+	f.mappers = mappers
+	return nil
+}
+
+func (f *fakeKeycloakFederation) ListComponents(parentID, providerType, _ string) ([]*Component, error) {
+	components := []*Component{}
+	for _, component := range f.components {
+		if component.ProviderType == providerType && (parentID == "" || component.ParentID == parentID) {
+			components = append(components, component)
+		}
+	}
+	return components, nil
+}
+
+func (f *fakeKeycloakFederation) CreateComponent(component *Component, realmName string) (string, error) {
+	component.ID = fmt.Sprintf("component-%d", len(f.components))
+	if component.ParentID == "" {
+		component.ParentID = realmName
+	}
+	f.components = append(f.components, component)
+	return component.ID, nil
+}
+
+func (f *fakeKeycloakFederation) UpdateComponent(component *Component, _ string) error {
+	for i, existing := range f.components {
+		if existing.ID == component.ID {
+			f.components[i] = component
+		}
+	}
+	return nil
+}
+
+func (f *fakeKeycloakFederation) DeleteComponent(id, _ string) error {
+	components := []*Component{}
+	for _, existing := range f.components {
+		if existing.ID != id && existing.ParentID != id {
+			components = append(components, existing)
+		}
+	}
+	f.components = components
+	return nil
+}
+
+func newIdentityProvidersKeycloakClient(providers *[]*keycloak.KeycloakIdentityProvider) *keycloakCommon.KeycloakInterfaceMock {
+	return &keycloakCommon.KeycloakInterfaceMock{
+		ListIdentityProvidersFunc: func(_ string) ([]*keycloak.KeycloakIdentityProvider, error) {
+			return *providers, nil
+		},
+		CreateIdentityProviderFunc: func(identityProvider *keycloak.KeycloakIdentityProvider, _ string) (string, error) {
+			*providers = append(*providers, identityProvider)
+			return identityProvider.Alias, nil
+		},
+		UpdateIdentityProviderFunc: func(identityProvider *keycloak.KeycloakIdentityProvider, _ string) error {
+			for i, existing := range *providers {
+				if existing.Alias == identityProvider.Alias {
+					(*providers)[i] = identityProvider
+				}
+			}
+			return nil
+		},
+		DeleteIdentityProviderFunc: func(alias string, _ string) error {
+			remaining := []*keycloak.KeycloakIdentityProvider{}
+			for _, existing := range *providers {
+				if existing.Alias != alias {
+					remaining = append(remaining, existing)
+				}
+			}
+			*providers = remaining
+			return nil
+		},
+	}
+}
+
+// newIdentityProvidersRealm returns the master realm of user SSO, declaring the OpenShift provider
+func newIdentityProvidersRealm() *keycloak.KeycloakRealm {
+	return &keycloak.KeycloakRealm{
+		ObjectMeta: metav1.ObjectMeta{Name: "master", Namespace: defaultNamespace},
+		Spec: keycloak.KeycloakRealmSpec{
+			Realm: &keycloak.KeycloakAPIRealm{
+				Realm:             "master",
+				IdentityProviders: []*keycloak.KeycloakIdentityProvider{{Alias: "openshift-v4"}},
+			},
+		},
+	}
+}
+
+func TestReconcileIdentityProviders(t *testing.T) {
+	scheme, err := getBuildScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rhmi",
+			Namespace: defaultNamespace,
+		},
+		Spec: integreatlyv1alpha1.RHMISpec{
+			Authentication: &integreatlyv1alpha1.AuthenticationSpec{
+				IdentityProviders: []integreatlyv1alpha1.IdentityProviderSpec{
+					{
+						Alias:     "azure-ad",
+						Type:      integreatlyv1alpha1.IdentityProviderOIDC,
+						SecretRef: "azure-ad",
+						OIDC: &integreatlyv1alpha1.OIDCIdentityProvider{
+							AuthorizationURL: "https://login.microsoftonline.com/tenant/oauth2/v2.0/authorize",
+							TokenURL:         "https://login.microsoftonline.com/tenant/oauth2/v2.0/token",
+							ClientID:         "rhmi",
+							Scopes:           []string{"openid", "email"},
+						},
+						Mappers: []integreatlyv1alpha1.IdentityProviderMapper{{Name: "department", Attribute: "department", UserAttribute: "department"}},
+					},
+					{
+						Alias:     "corp-ad",
+						Type:      integreatlyv1alpha1.IdentityProviderLDAP,
+						SecretRef: "corp-ad",
+						LDAP: &integreatlyv1alpha1.LDAPIdentityProvider{
+							ConnectionURL:   "ldaps://ad.example.com",
+							BindDN:          "cn=rhmi,dc=example,dc=com",
+							UsersDN:         "ou=users,dc=example,dc=com",
+							ActiveDirectory: true,
+						},
+						Mappers: []integreatlyv1alpha1.IdentityProviderMapper{{Name: "department", Attribute: "department", UserAttribute: "department"}},
+					},
+				},
+			},
+		},
+	}
+	secrets := []*corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "azure-ad", Namespace: defaultNamespace},
+			Data:       map[string][]byte{"clientSecret": []byte("oidc-secret")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "corp-ad", Namespace: defaultNamespace},
+			Data:       map[string][]byte{"bindCredential": []byte("ldap-secret")},
+		},
+	}
+
+	providers := []*keycloak.KeycloakIdentityProvider{{Alias: "openshift-v4"}, {Alias: "old-saml"}}
+	federation := &fakeKeycloakFederation{}
+	rhssoConfig := config.NewRHSSO(config.ProductConfig{"IDENTITY_PROVIDERS": "old-saml"})
+	r := &Reconciler{
+		ConfigManager: &config.ConfigReadWriterMock{
+			WriteConfigFunc: func(config config.ConfigReadable) error {
+				return nil
+			},
+		},
+		Installation: installation,
+		Logger:       logrus.NewEntry(logrus.StandardLogger()),
+		KeycloakClientFactory: &keycloakCommon.KeycloakClientFactoryMock{
+			AuthenticatedClientFunc: func(kc keycloak.Keycloak) (keycloakCommon.KeycloakInterface, error) {
+				return newIdentityProvidersKeycloakClient(&providers), nil
+			},
+		},
+		KeycloakFederationClientFactory: federation,
+	}
+	serverClient := fakeclient.NewFakeClientWithScheme(scheme, secrets[0], secrets[1])
+
+	kcr := newIdentityProvidersRealm()
+	if err := r.SetupIdentityProviders(context.TODO(), serverClient, kcr); err != nil {
+		t.Fatalf("unexpected error declaring the providers in the realm: %v", err)
+	}
+	if len(kcr.Spec.Realm.IdentityProviders) != 2 || kcr.Spec.Realm.IdentityProviders[1].Alias != "azure-ad" {
+		t.Fatalf("expected the oidc provider to be declared next to the openshift provider, got %v", kcr.Spec.Realm.IdentityProviders)
+	}
+
+	phase, err := r.ReconcileIdentityProviders(context.TODO(), serverClient, &keycloak.Keycloak{}, kcr, rhssoConfig, rhssoConfig.RHSSOCommon)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		t.Fatalf("unexpected phase %s: %v", phase, err)
+	}
+
+	if len(providers) != 2 || providers[0].Alias != "openshift-v4" || providers[1].Alias != "azure-ad" {
+		t.Fatalf("expected the removed provider to be replaced by the oidc provider, keeping the openshift provider, got %v", providers)
+	}
+	oidc := providers[1]
+	if oidc.ProviderID != "oidc" || oidc.FirstBrokerLoginFlowAlias != "first broker login" || oidc.Config["clientSecret"] != "oidc-secret" || oidc.Config["defaultScope"] != "openid email" {
+		t.Fatalf("expected the oidc provider to be configured from the spec and secret, got %v", oidc)
+	}
+	if len(federation.mappers) != 1 || federation.mappers[0].IdentityProviderMapper != "oidc-user-attribute-idp-mapper" || federation.mappers[0].Config["claim"] != "department" {
+		t.Fatalf("expected the oidc mapper to be created, got %v", federation.mappers)
+	}
+
+	ldapProviders, _ := federation.ListComponents("", UserStorageProviderType, "master")
+	if len(ldapProviders) != 1 || ldapProviders[0].Config["vendor"][0] != "ad" || ldapProviders[0].Config["bindCredential"][0] != "ldap-secret" || ldapProviders[0].Config["usernameLDAPAttribute"][0] != "sAMAccountName" {
+		t.Fatalf("expected the ldap provider to be created with the active directory defaults, got %v", ldapProviders)
+	}
+	ldapMappers, _ := federation.ListComponents(ldapProviders[0].ID, LDAPStorageMapperType, "master")
+	if len(ldapMappers) != 1 || ldapMappers[0].Config["ldap.attribute"][0] != "department" {
+		t.Fatalf("expected the ldap mapper to be created under the ldap provider, got %v", ldapMappers)
+	}
+
+	if aliases := strings.Join(rhssoConfig.GetIdentityProviders(), ","); aliases != "azure-ad,corp-ad" {
+		t.Fatalf("expected the federated providers to be recorded, got %s", aliases)
+	}
+	if mappers := strings.Join(rhssoConfig.GetLDAPMappers(), ","); mappers != "corp-ad/department" {
+		t.Fatalf("expected the federated ldap mappers to be recorded, got %s", mappers)
+	}
+
+	// Keycloak creates default mappers with the ldap provider, which are kept when the federated mappers are removed
+	federation.components = append(federation.components, &Component{ID: "email", Name: "email", ProviderType: LDAPStorageMapperType, ParentID: ldapProviders[0].ID})
+	installation.Spec.Authentication.IdentityProviders[0].Mappers = nil
+	installation.Spec.Authentication.IdentityProviders[1].Mappers = nil
+
+	phase, err = r.ReconcileIdentityProviders(context.TODO(), serverClient, &keycloak.Keycloak{}, kcr, rhssoConfig, rhssoConfig.RHSSOCommon)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		t.Fatalf("unexpected phase %s: %v", phase, err)
+	}
+	if len(federation.mappers) != 0 {
+		t.Fatalf("expected the oidc mapper to be removed, got %v", federation.mappers)
+	}
+	ldapMappers, _ = federation.ListComponents(ldapProviders[0].ID, LDAPStorageMapperType, "master")
+	if len(ldapMappers) != 1 || ldapMappers[0].Name != "email" {
+		t.Fatalf("expected only the default ldap mapper to be kept, got %v", ldapMappers)
+	}
+
+	// removing the providers from the spec removes them from the realm
+	installation.Spec.Authentication = nil
+	kcr = newIdentityProvidersRealm()
+	if err := r.SetupIdentityProviders(context.TODO(), serverClient, kcr); err != nil || len(kcr.Spec.Realm.IdentityProviders) != 1 {
+		t.Fatalf("expected only the openshift provider to be declared in the realm, got %v: %v", kcr.Spec.Realm.IdentityProviders, err)
+	}
+
+	phase, err = r.ReconcileIdentityProviders(context.TODO(), serverClient, &keycloak.Keycloak{}, kcr, rhssoConfig, rhssoConfig.RHSSOCommon)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		t.Fatalf("unexpected phase %s: %v", phase, err)
+	}
+	if len(providers) != 1 || providers[0].Alias != "openshift-v4" || len(federation.components) != 0 {
+		t.Fatalf("expected the federated providers to be removed, got %v and %v", providers, federation.components)
+	}
+	if len(rhssoConfig.GetIdentityProviders()) != 0 {
+		t.Fatalf("expected no federated providers to be recorded, got %v", rhssoConfig.GetIdentityProviders())
+	}
+}
+
+func TestReconcileIdentityProvidersMissingCredentials(t *testing.T) {
+	scheme, err := getBuildScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{
+		ObjectMeta: metav1.ObjectMeta{Name: "rhmi", Namespace: defaultNamespace},
+		Spec: integreatlyv1alpha1.RHMISpec{
+			Authentication: &integreatlyv1alpha1.AuthenticationSpec{
+				IdentityProviders: []integreatlyv1alpha1.IdentityProviderSpec{{
+					Alias:     "corp-ad",
+					Type:      integreatlyv1alpha1.IdentityProviderLDAP,
+					SecretRef: "corp-ad",
+					LDAP:      &integreatlyv1alpha1.LDAPIdentityProvider{ConnectionURL: "ldaps://ad.example.com", BindDN: "cn=rhmi", UsersDN: "dc=example"},
+				}},
+			},
+		},
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "corp-ad", Namespace: defaultNamespace}}
+
+	providers := []*keycloak.KeycloakIdentityProvider{}
+	federation := &fakeKeycloakFederation{}
+	rhssoConfig := config.NewRHSSO(config.ProductConfig{})
+	r := &Reconciler{
+		Installation: installation,
+		Logger:       logrus.NewEntry(logrus.StandardLogger()),
+		KeycloakClientFactory: &keycloakCommon.KeycloakClientFactoryMock{
+			AuthenticatedClientFunc: func(kc keycloak.Keycloak) (keycloakCommon.KeycloakInterface, error) {
+				return newIdentityProvidersKeycloakClient(&providers), nil
+			},
+		},
+		KeycloakFederationClientFactory: federation,
+	}
+
+	phase, err := r.ReconcileIdentityProviders(context.TODO(), fakeclient.NewFakeClientWithScheme(scheme, secret), &keycloak.Keycloak{}, newIdentityProvidersRealm(), rhssoConfig, rhssoConfig.RHSSOCommon)
+	if err == nil || phase != integreatlyv1alpha1.PhaseFailed {
+		t.Fatalf("expected the provider to fail without its bind credential, got %s", phase)
+	}
+	if len(federation.components) != 0 {
+		t.Fatalf("expected no provider to be created, got %v", federation.components)
+	}
+}
@@ -0,0 +1,256 @@
+package rhssocommon
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
+	"github.com/keycloak/keycloak-operator/pkg/model"
+	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	keycloakTokenPath = "auth/realms/master/protocol/openid-connect/token"
+
+	// UserStorageProviderType is the type of the components federating the users of an LDAP server
+	UserStorageProviderType = "org.keycloak.storage.UserStorageProvider"
+	// LDAPStorageMapperType is the type of the components mapping the attributes of LDAP users
+	LDAPStorageMapperType = "org.keycloak.storage.ldap.mappers.LDAPStorageMapper"
+)
+
+// serviceCAFile is the CA of the serving certificates of the cluster services, which signs the certificate of the
+// internal Keycloak service
+var serviceCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+// IdentityProviderMapper is the Keycloak representation of a mapper of an identity provider
+type IdentityProviderMapper struct {
+	ID                     string            `json:"id,omitempty"`
+	Name                   string            `json:"name"`
+	IdentityProviderAlias  string            `json:"identityProviderAlias"`
+	IdentityProviderMapper string            `json:"identityProviderMapper"`
+	Config                 map[string]string `json:"config,omitempty"`
+}
+
+// Component is the Keycloak representation of a component, such as an LDAP user storage provider or one of its
+// mappers
+type Component struct {
+	ID           string              `json:"id,omitempty"`
+	Name         string              `json:"name"`
+	ProviderID   string              `json:"providerId"`
+	ProviderType string              `json:"providerType"`
+	ParentID     string              `json:"parentId,omitempty"`
+	Config       map[string][]string `json:"config,omitempty"`
+}
+
+// KeycloakFederationInterface covers the parts of the Keycloak admin API used to federate identity providers that
+// the keycloak client does not implement: the mappers of identity providers and the user storage components
+type KeycloakFederationInterface interface {
+	ListIdentityProviderMappers(alias, realmName string) ([]*IdentityProviderMapper, error)
+	CreateIdentityProviderMapper(mapper *IdentityProviderMapper, realmName string) error
+	UpdateIdentityProviderMapper(mapper *IdentityProviderMapper, realmName string) error
+	DeleteIdentityProviderMapper(mapper *IdentityProviderMapper, realmName string) error
+
+	ListComponents(parentID, providerType, realmName string) ([]*Component, error)
+	CreateComponent(component *Component, realmName string) (string, error)
+	UpdateComponent(component *Component, realmName string) error
+	DeleteComponent(id, realmName string) error
+}
+
+// KeycloakFederationClientFactory returns a client authenticated as the admin of the Keycloak instance
+type KeycloakFederationClientFactory interface {
+	AuthenticatedClient(ctx context.Context, serverClient k8sclient.Client, kc keycloak.Keycloak) (KeycloakFederationInterface, error)
+}
+
+// LocalConfigKeycloakFederationFactory authenticates with the admin credentials the keycloak operator stores in the
+// namespace of the Keycloak instance, the same way the keycloak client does. The internal URL of the instance is
+// verified against the service CA of the cluster
+type LocalConfigKeycloakFederationFactory struct{}
+
+func (f *LocalConfigKeycloakFederationFactory) AuthenticatedClient(ctx context.Context, serverClient k8sclient.Client, kc keycloak.Keycloak) (KeycloakFederationInterface, error) {
+	adminCreds := &corev1.Secret{}
+	if err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: kc.Status.CredentialSecret, Namespace: kc.Namespace}, adminCreds); err != nil {
+		return nil, fmt.Errorf("failed to get the admin credentials: %w", err)
+	}
+
+	tlsConfig, err := serviceCATLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	client := &keycloakFederationClient{
+		URL: kc.Status.InternalURL,
+		requester: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			Timeout:   10 * time.Second,
+		},
+	}
+	if err := client.login(string(adminCreds.Data[model.AdminUsernameProperty]), string(adminCreds.Data[model.AdminPasswordProperty])); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// serviceCATLSConfig trusts the service CA of the cluster. The CA is not available when the operator runs locally, in
+// which case the certificate is not verified
+func serviceCATLSConfig() (*tls.Config, error) {
+	caCert, err := ioutil.ReadFile(serviceCAFile)
+	if os.IsNotExist(err) && os.Getenv(k8sutil.ForceRunModeEnv) == string(k8sutil.LocalRunMode) {
+		logrus.Warn("Keycloak federation client will skip certificate verification - this is acceptable only if operator is running locally")
+		return &tls.Config{InsecureSkipVerify: true}, nil // nolint
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service CA file: %w", err)
+	}
+
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to parse service CA file %s", serviceCAFile)
+	}
+	return &tls.Config{RootCAs: caCertPool}, nil
+}
+
+type keycloakFederationClient struct {
+	URL       string
+	requester *http.Client
+	token     string
+}
+
+func (c *keycloakFederationClient) login(user, pass string) error {
+	form := url.Values{}
+	form.Add("username", user)
+	form.Add("password", pass)
+	form.Add("client_id", "admin-cli")
+	form.Add("grant_type", "password")
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.URL, keycloakTokenPath), strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("error creating login request: %w", err)
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	body, _, err := c.send(req)
+	if err != nil {
+		return fmt.Errorf("error performing token request: %w", err)
+	}
+	tokenRes := &keycloak.TokenResponse{}
+	if err := json.Unmarshal(body, tokenRes); err != nil {
+		return fmt.Errorf("error parsing token response: %w", err)
+	}
+	if tokenRes.Error != "" {
+		return fmt.Errorf("error performing token request: %s", tokenRes.ErrorDescription)
+	}
+	c.token = tokenRes.AccessToken
+	return nil
+}
+
+// do sends a request to the admin API of the realm, encoding obj as the body when it is set. The body and headers of
+// the response are returned for successful responses
+func (c *keycloakFederationClient) do(method, path, realmName string, obj interface{}) ([]byte, http.Header, error) {
+	body := &bytes.Buffer{}
+	if obj != nil {
+		if err := json.NewEncoder(body).Encode(obj); err != nil {
+			return nil, nil, fmt.Errorf("error marshalling %s request: %w", path, err)
+		}
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/auth/admin/realms/%s/%s", c.URL, realmName, path), body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating %s %s request: %w", method, path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	return c.send(req)
+}
+
+func (c *keycloakFederationClient) send(req *http.Request) ([]byte, http.Header, error) {
+	res, err := c.requester.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, nil, fmt.Errorf("%s %s failed with status code %d: %s", req.Method, req.URL.Path, res.StatusCode, string(body))
+	}
+	return body, res.Header, nil
+}
+
+func (c *keycloakFederationClient) ListIdentityProviderMappers(alias, realmName string) ([]*IdentityProviderMapper, error) {
+	body, _, err := c.do(http.MethodGet, fmt.Sprintf("identity-provider/instances/%s/mappers", alias), realmName, nil)
+	if err != nil {
+		return nil, err
+	}
+	mappers := []*IdentityProviderMapper{}
+	if err := json.Unmarshal(body, &mappers); err != nil {
+		return nil, fmt.Errorf("error parsing identity provider mappers: %w", err)
+	}
+	return mappers, nil
+}
+
+func (c *keycloakFederationClient) CreateIdentityProviderMapper(mapper *IdentityProviderMapper, realmName string) error {
+	_, _, err := c.do(http.MethodPost, fmt.Sprintf("identity-provider/instances/%s/mappers", mapper.IdentityProviderAlias), realmName, mapper)
+	return err
+}
+
+func (c *keycloakFederationClient) UpdateIdentityProviderMapper(mapper *IdentityProviderMapper, realmName string) error {
+	_, _, err := c.do(http.MethodPut, fmt.Sprintf("identity-provider/instances/%s/mappers/%s", mapper.IdentityProviderAlias, mapper.ID), realmName, mapper)
+	return err
+}
+
+func (c *keycloakFederationClient) DeleteIdentityProviderMapper(mapper *IdentityProviderMapper, realmName string) error {
+	_, _, err := c.do(http.MethodDelete, fmt.Sprintf("identity-provider/instances/%s/mappers/%s", mapper.IdentityProviderAlias, mapper.ID), realmName, nil)
+	return err
+}
+
+func (c *keycloakFederationClient) ListComponents(parentID, providerType, realmName string) ([]*Component, error) {
+	query := url.Values{}
+	query.Add("type", providerType)
+	if parentID != "" {
+		query.Add("parent", parentID)
+	}
+	body, _, err := c.do(http.MethodGet, "components?"+query.Encode(), realmName, nil)
+	if err != nil {
+		return nil, err
+	}
+	components := []*Component{}
+	if err := json.Unmarshal(body, &components); err != nil {
+		return nil, fmt.Errorf("error parsing components: %w", err)
+	}
+	return components, nil
+}
+
+// CreateComponent creates the component and returns its id, read from the location of the created component
+func (c *keycloakFederationClient) CreateComponent(component *Component, realmName string) (string, error) {
+	_, header, err := c.do(http.MethodPost, "components", realmName, component)
+	if err != nil {
+		return "", err
+	}
+	location := strings.Split(header.Get("Location"), "/")
+	return location[len(location)-1], nil
+}
+
+func (c *keycloakFederationClient) UpdateComponent(component *Component, realmName string) error {
+	_, _, err := c.do(http.MethodPut, fmt.Sprintf("components/%s", component.ID), realmName, component)
+	return err
+}
+
+func (c *keycloakFederationClient) DeleteComponent(id, realmName string) error {
+	_, _, err := c.do(http.MethodDelete, fmt.Sprintf("components/%s", id), realmName, nil)
+	return err
+}
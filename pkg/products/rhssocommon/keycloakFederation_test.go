@@ -0,0 +1,124 @@
+package rhssocommon
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestKeycloakFederationClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+keycloakTokenPath {
+			if r.FormValue("username") != "admin" || r.FormValue("client_id") != "admin-cli" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"token"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/auth/admin/realms/openshift/components":
+			component := &Component{}
+			if err := json.NewDecoder(r.Body).Decode(component); err != nil || component.ProviderType != UserStorageProviderType {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Location", "https://keycloak/auth/admin/realms/openshift/components/ldap-id")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/openshift/components":
+			if r.URL.Query().Get("parent") != "ldap-id" || r.URL.Query().Get("type") != LDAPStorageMapperType {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`[{"id":"email-id","name":"email","parentId":"ldap-id"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/openshift/identity-provider/instances/azure-ad/mappers":
+			_, _ = w.Write([]byte(`[{"id":"mapper-id","name":"department","identityProviderAlias":"azure-ad"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &keycloakFederationClient{URL: server.URL, requester: server.Client()}
+	if err := client.login("admin", "password"); err != nil {
+		t.Fatalf("unexpected login error: %v", err)
+	}
+
+	id, err := client.CreateComponent(&Component{Name: "corp-ad", ProviderType: UserStorageProviderType}, "openshift")
+	if err != nil || id != "ldap-id" {
+		t.Fatalf("expected the id of the created component, got %s: %v", id, err)
+	}
+
+	components, err := client.ListComponents(id, LDAPStorageMapperType, "openshift")
+	if err != nil || len(components) != 1 || components[0].ID != "email-id" {
+		t.Fatalf("expected the components of the parent, got %v: %v", components, err)
+	}
+
+	mappers, err := client.ListIdentityProviderMappers("azure-ad", "openshift")
+	if err != nil || len(mappers) != 1 || mappers[0].ID != "mapper-id" {
+		t.Fatalf("expected the mappers of the identity provider, got %v: %v", mappers, err)
+	}
+
+	if err := client.DeleteComponent("unknown", "openshift"); err == nil {
+		t.Fatal("expected an error for an unsuccessful response")
+	}
+}
+
+func TestServiceCATLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"access_token":"token"}`))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "service-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defaultServiceCAFile := serviceCAFile
+	defer func() { serviceCAFile = defaultServiceCAFile }()
+
+	serviceCAFile = filepath.Join(dir, "missing.crt")
+	if _, err := serviceCATLSConfig(); err == nil {
+		t.Fatal("expected an error when the service CA is missing")
+	}
+
+	serviceCAFile = filepath.Join(dir, "service-ca.crt")
+	if err := ioutil.WriteFile(serviceCAFile, []byte("not a certificate"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := serviceCATLSConfig(); err == nil {
+		t.Fatal("expected an error when the service CA is invalid")
+	}
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(serviceCAFile, caCert, 0644); err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig, err := serviceCATLSConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig.InsecureSkipVerify {
+		t.Fatal("expected the certificate of keycloak to be verified")
+	}
+
+	client := &keycloakFederationClient{URL: server.URL, requester: &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}}
+	if err := client.login("admin", "password"); err != nil {
+		t.Fatalf("expected keycloak to be trusted through the service CA: %v", err)
+	}
+	client.requester = &http.Client{}
+	if err := client.login("admin", "password"); err == nil {
+		t.Fatal("expected keycloak not to be trusted without the service CA")
+	}
+}
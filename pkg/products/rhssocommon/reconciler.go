@@ -47,6 +47,8 @@ type Reconciler struct {
 	*resources.Reconciler
 	Recorder              record.EventRecorder
 	KeycloakClientFactory keycloakCommon.KeycloakClientFactory
+	// KeycloakFederationClientFactory covers the parts of the admin api the keycloak client does not implement
+	KeycloakFederationClientFactory KeycloakFederationClientFactory
 }
 
 func NewReconciler(configManager config.ConfigReadWriter, mpm marketplace.MarketplaceInterface, installation *integreatlyv1alpha1.RHMI, logger *logrus.Entry, oauthv1Client oauthClient.OauthV1Interface, recorder record.EventRecorder, APIURL string, keycloakClientFactory keycloakCommon.KeycloakClientFactory) *Reconciler {
@@ -60,6 +62,8 @@ func NewReconciler(configManager config.ConfigReadWriter, mpm marketplace.Market
 		Reconciler:            resources.NewReconciler(mpm),
 		Recorder:              recorder,
 		KeycloakClientFactory: keycloakClientFactory,
+
+		KeycloakFederationClientFactory: &LocalConfigKeycloakFederationFactory{},
 	}
 }
 
@@ -334,16 +338,27 @@ func (r *Reconciler) PreUpgradeBackupsExecutor(resourceName string) backup.Backu
 	)
 }
 
-func (r *Reconciler) ReconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string, resourceName string) (integreatlyv1alpha1.StatusPhase, error) {
+// BackupsExecutor returns the backups of the database performed on demand, which are not supported on cluster storage
+func (r *Reconciler) BackupsExecutor(resourceName string) backup.BackupExecutor {
+	if r.Installation.Spec.UseClusterStorage != "false" {
+		return backup.NewUnsupportedBackupExecutor(backup.ClusterStorageUnsupportedReason)
+	}
+	return r.PreUpgradeBackupsExecutor(resourceName)
+}
+
+func (r *Reconciler) ReconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, product integreatlyv1alpha1.ProductName, productNamespace string, operatorNamespace string, resourceName string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(product)
 	target := marketplace.Target{
-		Pkg:       constants.RHSSOSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.RHSSOSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(product),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
@@ -356,38 +371,19 @@ func (r *Reconciler) ReconcileSubscription(ctx context.Context, serverClient k8s
 	)
 }
 
-func DeleteKeycloakUsers(allKcUsers []keycloak.KeycloakAPIUser, deletedUsers []keycloak.KeycloakAPIUser, ns string, ctx context.Context, serverClient k8sclient.Client) ([]keycloak.KeycloakAPIUser, error) {
-
-	for _, delUser := range deletedUsers {
-
-		if delUser.UserName == "" {
-			continue
-		}
-
-		// Remove from all users list
-		for i, user := range allKcUsers {
-			// ID is not populated, have to use UserName. Should be unique on master Realm
-			if delUser.UserName == user.UserName {
-				allKcUsers[i] = allKcUsers[len(allKcUsers)-1]
-				allKcUsers = allKcUsers[:len(allKcUsers)-1]
-				break
-			}
-		}
-
-		// Delete the CR
-		kcUser := &keycloak.KeycloakUser{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      userHelper.GetValidGeneratedUserName(delUser),
-				Namespace: ns,
-			},
-		}
-		err := serverClient.Delete(ctx, kcUser)
-		if err != nil {
-			return nil, fmt.Errorf("failed to delete keycloak user: %w", err)
-		}
+// DeleteKeycloakUser deletes the CR of the user, the Keycloak operator removes the user from the realm. A user whose
+// CR was already deleted is not an error, so that the deletion can be retried by the next user sync
+func DeleteKeycloakUser(ctx context.Context, serverClient k8sclient.Client, user keycloak.KeycloakAPIUser, ns string) error {
+	kcUser := &keycloak.KeycloakUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      userHelper.GetValidGeneratedUserName(user),
+			Namespace: ns,
+		},
 	}
-
-	return allKcUsers, nil
+	if err := serverClient.Delete(ctx, kcUser); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to delete keycloak user: %w", err)
+	}
+	return nil
 }
 
 func OsUserInKc(osUsers []usersv1.User, kcUser keycloak.KeycloakAPIUser) bool {
@@ -400,6 +396,16 @@ func OsUserInKc(osUsers []usersv1.User, kcUser keycloak.KeycloakAPIUser) bool {
 	return false
 }
 
+// GetObservedVersion reads the version of RHSSO running on the cluster from the status of the Keycloak CR. An empty
+// version is returned until the Keycloak operator reports it
+func (r *Reconciler) GetObservedVersion(ctx context.Context, serverClient k8sclient.Client, keycloakName string, namespace string) (integreatlyv1alpha1.ProductVersion, error) {
+	kc := &keycloak.Keycloak{}
+	if err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: keycloakName, Namespace: namespace}, kc); err != nil {
+		return "", fmt.Errorf("failed to get keycloak custom resource: %w", err)
+	}
+	return integreatlyv1alpha1.ProductVersion(kc.Status.Version), nil
+}
+
 func (r *Reconciler) HandleProgressPhase(ctx context.Context, serverClient k8sclient.Client, keycloakName string, keycloakRealmName string, config config.ConfigReadable, ssoCommon *config.RHSSOCommon, rhssoVersion string, operatorVersion string) (integreatlyv1alpha1.StatusPhase, error) {
 	kc := &keycloak.Keycloak{}
 	err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: keycloakName, Namespace: config.GetNamespace()}, kc)
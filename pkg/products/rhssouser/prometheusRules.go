@@ -16,6 +16,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "user-rhsso-endpoint.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -44,6 +45,7 @@ func (r *Reconciler) newAlertsReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				Namespace: r.Config.GetOperatorNamespace(),
 				GroupName: "user-rhsso-operator-endpoint.rules",
 				Rules: []monitoringv1.Rule{
@@ -26,6 +26,7 @@ import (
 
 	"github.com/integr8ly/integreatly-operator/pkg/config"
 	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
 
 	oauthClient "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
@@ -176,12 +177,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
-	phase, err = r.ReconcileSubscription(ctx, serverClient, installation, productNamespace, operatorNamespace, postgresResourceName)
+	phase, err = r.ReconcileSubscription(ctx, serverClient, installation, integreatlyv1alpha1.ProductRHSSOUser, productNamespace, operatorNamespace, postgresResourceName)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.Recorder, installation, phase, fmt.Sprintf("Failed to reconcile %s subscription", constants.RHSSOSubscriptionName), err)
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.RHSSOSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.Recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.RHSSOSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	phase, err = r.CreateKeycloakRoute(ctx, serverClient, r.Config, r.Config.RHSSOCommon)
 	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
 		events.HandleError(r.Recorder, installation, phase, "Failed to handle in progress phase", err)
@@ -206,6 +213,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedVersion, err = r.GetObservedVersion(ctx, serverClient, keycloakName, r.Config.GetNamespace())
+	if err != nil {
+		events.HandleError(r.Recorder, installation, integreatlyv1alpha1.PhaseFailed, "Failed to read the installed RHSSO version", err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	err = r.ConfigManager.WriteConfig(r.Config)
 	if err != nil {
 		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("Error writing to config in rhssouser reconciler: %w", err)
@@ -316,6 +329,12 @@ func (r *Reconciler) reconcileComponents(ctx context.Context, installation *inte
 		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("Failed to reconcile first broker login authentication flow: %w", err)
 	}
 
+	phase, err = r.ReconcileIdentityProviders(ctx, serverClient, kc, masterKcr, r.Config, r.Config.RHSSOCommon)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		events.HandleError(r.Recorder, installation, phase, "Failed to reconcile identity providers", err)
+		return phase, err
+	}
+
 	rolesConfigured, err := r.Config.GetDevelopersGroupConfigured()
 	if err != nil {
 		return integreatlyv1alpha1.PhaseFailed, err
@@ -346,22 +365,15 @@ func (r *Reconciler) reconcileComponents(ctx context.Context, installation *inte
 	}
 
 	// Sync keycloak with openshift users
-	users, err := syncAdminUsersInMasterRealm(keycloakUsers, ctx, serverClient, r.Config.GetNamespace())
+	policy, err := userHelper.GetPolicy(ctx, serverClient)
 	if err != nil {
-		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to synchronize the users: %w", err)
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to get the users policy: %w", err)
 	}
-
-	// Create / update the synchronized users
-	for _, user := range users {
-		if user.UserName == "" {
-			continue
-		}
-		or, err = r.createOrUpdateKeycloakAdmin(user, ctx, serverClient)
-		if err != nil {
-			return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to create/update the customer admin user: %w", err)
-		} else {
-			r.Logger.Infof("The operation result for keycloakuser %s was %s", user.UserName, or)
-		}
+	sync := userHelper.NewSync(integreatlyv1alpha1.ProductRHSSOUser, policy, r.Logger)
+	err = r.syncAdminUsersInMasterRealm(ctx, sync, policy, keycloakUsers, serverClient)
+	installation.SetUserSyncStatus(sync.Status())
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("failed to synchronize the users: %w", err)
 	}
 
 	return integreatlyv1alpha1.PhaseCompleted, nil
@@ -440,6 +452,10 @@ func (r *Reconciler) updateMasterRealm(ctx context.Context, serverClient k8sclie
 			return fmt.Errorf("failed to setup Openshift IDP for user-sso: %w", err)
 		}
 
+		if err := r.SetupIdentityProviders(ctx, serverClient, kcr); err != nil {
+			return fmt.Errorf("failed to setup identity providers for user-sso: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -479,20 +495,24 @@ func getMasterLabels() map[string]string {
 	}
 }
 
-func syncAdminUsersInMasterRealm(keycloakUsers []keycloak.KeycloakAPIUser, ctx context.Context, serverClient k8sclient.Client, ns string) ([]keycloak.KeycloakAPIUser, error) {
+// syncAdminUsersInMasterRealm adds the admins of the user SSO missing from the master realm, deletes the users removed
+// from OpenShift and promotes or demotes the users whose membership of the admin groups changed, through the sync
+func (r *Reconciler) syncAdminUsersInMasterRealm(ctx context.Context, sync *userHelper.Sync, policy *userHelper.Policy, keycloakUsers []keycloak.KeycloakAPIUser, serverClient k8sclient.Client) error {
 
 	openshiftUsers := &usersv1.UserList{}
 	err := serverClient.List(ctx, openshiftUsers)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	openshiftGroups := &usersv1.GroupList{}
 	err = serverClient.List(ctx, openshiftGroups)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	dedicatedAdminUsers := getDedicatedAdmins(*openshiftUsers, *openshiftGroups)
+	// the admin groups of the user SSO default to dedicated-admins, they are
+	// referred to as the dedicated-admins group below
+	dedicatedAdminUsers := policy.Admins(integreatlyv1alpha1.ProductRHSSOUser, openshiftUsers.Items, openshiftGroups)
 
 	// added => Newly added to dedicated-admins group and OS
 	// deleted => No longer exists in OS, remove from SSO
@@ -500,151 +520,132 @@ func syncAdminUsersInMasterRealm(keycloakUsers []keycloak.KeycloakAPIUser, ctx c
 	// demoted => existing KC user, removed from dedicated-admins group, demote KC privileges
 	added, deleted, promoted, demoted := getUserDiff(keycloakUsers, openshiftUsers.Items, dedicatedAdminUsers)
 
-	keycloakUsers, err = rhssocommon.DeleteKeycloakUsers(keycloakUsers, deleted, ns, ctx, serverClient)
-	if err != nil {
-		return nil, err
-	}
-
-	keycloakUsers = addKeycloakUsers(keycloakUsers, added)
-	keycloakUsers = promoteKeycloakUsers(keycloakUsers, promoted)
-	keycloakUsers = demoteKeycloakUsers(keycloakUsers, demoted)
-
-	return keycloakUsers, nil
-}
-
-func addKeycloakUsers(keycloakUsers []keycloak.KeycloakAPIUser, added []usersv1.User) []keycloak.KeycloakAPIUser {
-
+	changes := []userHelper.SyncChange{}
 	for _, osUser := range added {
-
-		keycloakUsers = append(keycloakUsers, keycloak.KeycloakAPIUser{
-			Enabled:       true,
-			UserName:      osUser.Name,
-			EmailVerified: true,
-			FederatedIdentities: []keycloak.FederatedIdentity{
-				{
-					IdentityProvider: idpAlias,
-					UserID:           string(osUser.UID),
-					UserName:         osUser.Name,
-				},
-			},
-			RealmRoles: []string{"offline_access", "uma_authorization", "create-realm"},
-			ClientRoles: map[string][]string{
-				"account": {
-					"manage-account",
-					"view-profile",
-				},
-				"master-realm": {
-					"view-clients",
-					"view-realm",
-					"manage-users",
-				},
+		changes = append(changes, r.adminChange(integreatlyv1alpha1.UserSyncAdd, newKeycloakAdmin(osUser), serverClient))
+	}
+	for _, kcUser := range deleted {
+		if kcUser.UserName == "" {
+			continue
+		}
+		kcUser := kcUser
+		changes = append(changes, userHelper.SyncChange{
+			Action: integreatlyv1alpha1.UserSyncDelete,
+			User:   kcUser.UserName,
+			Apply: func(ctx context.Context) error {
+				return rhssocommon.DeleteKeycloakUser(ctx, serverClient, kcUser, r.Config.GetNamespace())
 			},
-			Groups: []string{dedicatedAdminsGroupName, fullRealmManagersGroupPath},
 		})
 	}
-	return keycloakUsers
-}
-
-func promoteKeycloakUsers(allUsers []keycloak.KeycloakAPIUser, promoted []keycloak.KeycloakAPIUser) []keycloak.KeycloakAPIUser {
-
-	for _, promotedUser := range promoted {
-		for i, user := range allUsers {
-			// ID is not populated, have to use UserName. Should be unique on master Realm
-			if promotedUser.UserName == user.UserName {
-				allUsers[i].ClientRoles = map[string][]string{
-					"account": {
-						"manage-account",
-						"view-profile",
-					},
-					"master-realm": {
-						"view-clients",
-						"view-realm",
-						"manage-users",
-					}}
-				allUsers[i].RealmRoles = []string{"offline_access", "uma_authorization", "create-realm"}
-
-				// Add the "dedicated-admins" group if it's not there
-				hasDedicatedAdminGroup := false
-				hasRealmManagerGroup := false
-				for _, group := range allUsers[i].Groups {
-					if group == dedicatedAdminsGroupName {
-						hasDedicatedAdminGroup = true
-					}
-					if group == fullRealmManagersGroupPath {
-						hasRealmManagerGroup = true
-					}
-				}
-				if !hasDedicatedAdminGroup {
-					allUsers[i].Groups = append(allUsers[i].Groups, dedicatedAdminsGroupName)
-				}
-				if !hasRealmManagerGroup {
-					allUsers[i].Groups = append(allUsers[i].Groups, fullRealmManagersGroupPath)
-				}
-
-				break
-			}
-		}
+	for _, kcUser := range promoted {
+		changes = append(changes, r.adminChange(integreatlyv1alpha1.UserSyncPromote, promoteKeycloakUser(kcUser), serverClient))
 	}
+	for _, kcUser := range demoted {
+		changes = append(changes, r.adminChange(integreatlyv1alpha1.UserSyncDemote, demoteKeycloakUser(kcUser), serverClient))
+	}
+	sync.Apply(ctx, changes)
 
-	return allUsers
+	return nil
 }
 
-func demoteKeycloakUsers(allUsers []keycloak.KeycloakAPIUser, demoted []keycloak.KeycloakAPIUser) []keycloak.KeycloakAPIUser {
-
-	for _, demotedUser := range demoted {
-		for i, user := range allUsers {
-			// ID is not populated, have to use UserName. Should be unique on master Realm
-			if demotedUser.UserName == user.UserName { // ID is not set but UserName is
-				allUsers[i].ClientRoles = map[string][]string{
-					"account": {
-						"manage-account",
-						"manage-account-links",
-						"view-profile",
-					}}
-				allUsers[i].RealmRoles = []string{"offline_access", "uma_authorization"}
-				// Remove the dedicated-admins group from the user groups list
-				groups := []string{}
-				for _, group := range allUsers[i].Groups {
-					if (group != dedicatedAdminsGroupName) && (group != fullRealmManagersGroupPath) {
-						groups = append(groups, group)
-					}
-				}
-				allUsers[i].Groups = groups
-				break
+// adminChange is a change writing the user to its CR in the master realm
+func (r *Reconciler) adminChange(action integreatlyv1alpha1.UserSyncAction, user keycloak.KeycloakAPIUser, serverClient k8sclient.Client) userHelper.SyncChange {
+	return userHelper.SyncChange{
+		Action: action,
+		User:   user.UserName,
+		Apply: func(ctx context.Context) error {
+			or, err := r.createOrUpdateKeycloakAdmin(user, ctx, serverClient)
+			if err != nil {
+				return fmt.Errorf("failed to create/update the customer admin user: %w", err)
 			}
-		}
+			r.Logger.Infof("The operation result for keycloakuser %s was %s", user.UserName, or)
+			return nil
+		},
 	}
-
-	return allUsers
 }
 
-// NOTE: The users type has a Groups field on it but it does not seem to get populated
-// hence the need to check by name which is not ideal. However, this is the only field
-// available on the Group type
-func getDedicatedAdmins(osUsers usersv1.UserList, groups usersv1.GroupList) (dedicatedAdmins []usersv1.User) {
-
-	var osUsersInGroup = getOsUsersInAdminsGroup(groups)
+func newKeycloakAdmin(osUser usersv1.User) keycloak.KeycloakAPIUser {
+	return keycloak.KeycloakAPIUser{
+		Enabled:       true,
+		UserName:      osUser.Name,
+		EmailVerified: true,
+		FederatedIdentities: []keycloak.FederatedIdentity{
+			{
+				IdentityProvider: idpAlias,
+				UserID:           string(osUser.UID),
+				UserName:         osUser.Name,
+			},
+		},
+		RealmRoles: []string{"offline_access", "uma_authorization", "create-realm"},
+		ClientRoles: map[string][]string{
+			"account": {
+				"manage-account",
+				"view-profile",
+			},
+			"master-realm": {
+				"view-clients",
+				"view-realm",
+				"manage-users",
+			},
+		},
+		Groups: []string{dedicatedAdminsGroupName, fullRealmManagersGroupPath},
+	}
+}
 
-	for _, osUser := range osUsers.Items {
-		if contains(osUsersInGroup, osUser.Name) {
-			dedicatedAdmins = append(dedicatedAdmins, osUser)
+func promoteKeycloakUser(user keycloak.KeycloakAPIUser) keycloak.KeycloakAPIUser {
+	user.ClientRoles = map[string][]string{
+		"account": {
+			"manage-account",
+			"view-profile",
+		},
+		"master-realm": {
+			"view-clients",
+			"view-realm",
+			"manage-users",
+		}}
+	user.RealmRoles = []string{"offline_access", "uma_authorization", "create-realm"}
+
+	// Add the "dedicated-admins" group if it's not there
+	hasDedicatedAdminGroup := false
+	hasRealmManagerGroup := false
+	for _, group := range user.Groups {
+		if group == dedicatedAdminsGroupName {
+			hasDedicatedAdminGroup = true
+		}
+		if group == fullRealmManagersGroupPath {
+			hasRealmManagerGroup = true
 		}
 	}
-	return dedicatedAdmins
-}
+	groups := append([]string{}, user.Groups...)
+	if !hasDedicatedAdminGroup {
+		groups = append(groups, dedicatedAdminsGroupName)
+	}
+	if !hasRealmManagerGroup {
+		groups = append(groups, fullRealmManagersGroupPath)
+	}
+	user.Groups = groups
 
-func getOsUsersInAdminsGroup(groups usersv1.GroupList) (users []string) {
+	return user
+}
 
-	for _, group := range groups.Items {
-		if group.Name == "dedicated-admins" {
-			if group.Users != nil {
-				users = group.Users
-			}
-			break
+func demoteKeycloakUser(user keycloak.KeycloakAPIUser) keycloak.KeycloakAPIUser {
+	user.ClientRoles = map[string][]string{
+		"account": {
+			"manage-account",
+			"manage-account-links",
+			"view-profile",
+		}}
+	user.RealmRoles = []string{"offline_access", "uma_authorization"}
+	// Remove the dedicated-admins group from the user groups list
+	groups := []string{}
+	for _, group := range user.Groups {
+		if (group != dedicatedAdminsGroupName) && (group != fullRealmManagersGroupPath) {
+			groups = append(groups, group)
 		}
 	}
+	user.Groups = groups
 
-	return users
+	return user
 }
 
 // There are 3 conceptual user types
@@ -1198,3 +1199,9 @@ func (r *Reconciler) deleteConsoleLink(ctx context.Context, serverClient k8sclie
 
 	return nil
 }
+
+// GetBackupExecutor returns the backups of the product performed on demand through RHMIBackup resources. The product
+// data is not backed up on cluster storage, where the backups are reported as unsupported
+func (r *Reconciler) GetBackupExecutor() backup.BackupExecutor {
+	return r.BackupsExecutor(postgresResourceName)
+}
@@ -391,6 +391,61 @@ func TestReconciler_reconcileComponents(t *testing.T) {
 	}
 }
 
+func TestReconciler_updateMasterRealmIdentityProviders(t *testing.T) {
+	scheme, err := getBuildScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rhmi",
+			Namespace: defaultOperatorNamespace,
+		},
+		Spec: integreatlyv1alpha1.RHMISpec{
+			Authentication: &integreatlyv1alpha1.AuthenticationSpec{
+				IdentityProviders: []integreatlyv1alpha1.IdentityProviderSpec{
+					{
+						Alias:     "azure-ad",
+						Type:      integreatlyv1alpha1.IdentityProviderOIDC,
+						SecretRef: "azure-ad",
+						OIDC:      &integreatlyv1alpha1.OIDCIdentityProvider{ClientID: "rhmi"},
+					},
+					{
+						Alias:     "corp-ad",
+						Type:      integreatlyv1alpha1.IdentityProviderLDAP,
+						SecretRef: "corp-ad",
+						LDAP:      &integreatlyv1alpha1.LDAPIdentityProvider{ConnectionURL: "ldaps://ad.example.com"},
+					},
+				},
+			},
+		},
+	}
+	oauthClientSecrets := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "oauth-client-secrets", Namespace: defaultOperatorNamespace},
+		Data:       map[string][]byte{"rhssouser": []byte("test")},
+	}
+	oidcSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "azure-ad", Namespace: defaultOperatorNamespace},
+		Data:       map[string][]byte{"clientSecret": []byte("oidc-secret")},
+	}
+
+	reconciler, err := NewReconciler(basicConfigMock(), installation, fakeoauthClient.NewSimpleClientset().OauthV1(), nil, setupRecorder(), "https://serverurl", getMoqKeycloakClientFactory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverClient := fakeclient.NewFakeClientWithScheme(scheme, oauthClientSecrets, oidcSecret)
+
+	kcr, err := reconciler.updateMasterRealm(context.TODO(), serverClient, installation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	providers := kcr.Spec.Realm.IdentityProviders
+	if len(providers) != 2 || providers[0].Alias != idpAlias || providers[1].Alias != "azure-ad" || providers[1].Config["clientSecret"] != "oidc-secret" {
+		t.Fatalf("expected the oidc provider to be declared in the master realm next to the openshift provider, got %v", providers)
+	}
+}
+
 func TestReconciler_fullReconcile(t *testing.T) {
 	scheme, err := getBuildScheme()
 	if err != nil {
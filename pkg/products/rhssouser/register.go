@@ -0,0 +1,30 @@
+package rhssouser
+
+import (
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+	"github.com/integr8ly/integreatly-operator/pkg/products/rhssocommon"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+
+	keycloakCommon "github.com/integr8ly/keycloak-client/pkg/common"
+)
+
+func init() {
+	products.Register(integreatlyv1alpha1.ProductRHSSOUser, products.Registration{
+		Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+			oauthv1Client, err := products.NewOauthClient(deps.RestConfig)
+			if err != nil {
+				return nil, err
+			}
+			return NewReconciler(deps.ConfigManager, deps.Installation, oauthv1Client, deps.MPM, deps.Recorder, deps.RestConfig.Host, &keycloakCommon.LocalConfigKeycloakFactory{})
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewRHSSOUser(cfg)
+		},
+		PreflightObject: (&rhssocommon.Reconciler{}).GetPreflightObject,
+		Version:         integreatlyv1alpha1.VersionRHSSOUser,
+		OperatorVersion: integreatlyv1alpha1.OperatorVersionRHSSOUser,
+	})
+	grafana.RegisterProductDashboard(integreatlyv1alpha1.ProductRHSSOUser, GetDashboard)
+}
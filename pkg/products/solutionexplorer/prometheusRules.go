@@ -16,6 +16,7 @@ func (r *Reconciler) newAlertReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "solution-explorer-endpoint.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -34,6 +35,7 @@ func (r *Reconciler) newAlertReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "solution-explorer-operator-endpoint.rules",
 				Namespace: r.Config.GetOperatorNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -52,6 +54,7 @@ func (r *Reconciler) newAlertReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-solution-explorer-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "general.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -0,0 +1,27 @@
+package solutionexplorer
+
+import (
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+)
+
+func init() {
+	products.Register(integreatlyv1alpha1.ProductSolutionExplorer, products.Registration{
+		Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+			oauthv1Client, err := products.NewOauthClient(deps.RestConfig)
+			if err != nil {
+				return nil, err
+			}
+			return NewReconciler(deps.ConfigManager, deps.Installation, oauthv1Client, deps.MPM, deps.OauthResolver, deps.Recorder)
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewSolutionExplorer(cfg)
+		},
+		PreflightObject: (&Reconciler{}).GetPreflightObject,
+		Version:         integreatlyv1alpha1.VersionSolutionExplorer,
+		OperatorVersion: integreatlyv1alpha1.OperatorVersionSolutionExplorer,
+	})
+	grafana.RegisterProductDashboard(integreatlyv1alpha1.ProductSolutionExplorer, GetDashboard)
+}
@@ -16,6 +16,7 @@ func (r *Reconciler) newAlertReconciler() resources.AlertReconciler {
 		Alerts: []resources.AlertConfiguration{
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: " 3scale-endpoint.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -124,6 +125,7 @@ func (r *Reconciler) newAlertReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-endpoint-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: " 3scale-operator-endpoint.rules",
 				Namespace: r.Config.GetOperatorNamespace(),
 				Rules: []monitoringv1.Rule{
@@ -152,6 +154,7 @@ func (r *Reconciler) newAlertReconciler() resources.AlertReconciler {
 
 			{
 				AlertName: "ksm-3scale-alerts",
+				Product:   r.Config.GetProductName(),
 				GroupName: "general.rules",
 				Namespace: r.Config.GetNamespace(),
 				Rules: []monitoringv1.Rule{
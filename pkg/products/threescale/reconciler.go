@@ -206,6 +206,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, installation *integreatlyv1a
 		return phase, err
 	}
 
+	product.ObservedOperatorVersion, err = resources.GetInstalledOperatorVersion(ctx, serverClient, constants.ThreeScaleSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s operator version", constants.ThreeScaleSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	product.ObservedVersion, err = resources.GetInstalledProductVersion(ctx, serverClient, constants.ThreeScaleSubscriptionName, operatorNamespace)
+	if err != nil {
+		events.HandleError(r.recorder, installation, integreatlyv1alpha1.PhaseFailed, fmt.Sprintf("Failed to read the installed %s product version", constants.ThreeScaleSubscriptionName), err)
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	if r.installation.GetDeletionTimestamp() == nil {
 		phase, err = r.reconcileSMTPCredentials(ctx, serverClient)
 		if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
@@ -954,25 +966,67 @@ func (r *Reconciler) reconcileOpenshiftUsers(ctx context.Context, installation *
 		return integreatlyv1alpha1.PhaseInProgress, err
 	}
 
+	policy, err := userHelper.GetPolicy(ctx, serverClient)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseInProgress, err
+	}
+	sync := userHelper.NewSync(integreatlyv1alpha1.Product3Scale, policy, r.logger)
+	defer func() {
+		installation.SetUserSyncStatus(sync.Status())
+	}()
+
 	added, deleted := r.getUserDiff(kcu, tsUsers.Users)
+	changes := []userHelper.SyncChange{}
 	for _, kcUser := range added {
-		res, err := r.tsClient.AddUser(strings.ToLower(kcUser.UserName), strings.ToLower(kcUser.Email), "", *accessToken)
-		if err != nil || res.StatusCode != http.StatusCreated {
-			return integreatlyv1alpha1.PhaseInProgress, err
-		}
+		kcUser := kcUser
+		changes = append(changes, userHelper.SyncChange{
+			Action: integreatlyv1alpha1.UserSyncAdd,
+			User:   strings.ToLower(kcUser.UserName),
+			Apply: func(ctx context.Context) error {
+				res, err := r.tsClient.AddUser(strings.ToLower(kcUser.UserName), strings.ToLower(kcUser.Email), "", *accessToken)
+				if err != nil {
+					return err
+				}
+				if res.StatusCode != http.StatusCreated {
+					return fmt.Errorf("failed to add user to 3scale, status code %d", res.StatusCode)
+				}
+				return nil
+			},
+		})
 	}
 	for _, tsUser := range deleted {
-		if tsUser.UserDetails.Username != *systemAdminUsername {
-			res, err := r.tsClient.DeleteUser(tsUser.UserDetails.Id, *accessToken)
-			if err != nil || res.StatusCode != http.StatusOK {
-				return integreatlyv1alpha1.PhaseInProgress, err
-			}
+		if tsUser.UserDetails.Username == *systemAdminUsername {
+			continue
 		}
+		tsUser := tsUser
+		changes = append(changes, userHelper.SyncChange{
+			Action: integreatlyv1alpha1.UserSyncDelete,
+			User:   tsUser.UserDetails.Username,
+			Apply: func(ctx context.Context) error {
+				res, err := r.tsClient.DeleteUser(tsUser.UserDetails.Id, *accessToken)
+				if err != nil {
+					return err
+				}
+				if res.StatusCode != http.StatusOK {
+					return fmt.Errorf("failed to delete user from 3scale, status code %d", res.StatusCode)
+				}
+				return nil
+			},
+		})
+	}
+	sync.Apply(ctx, changes)
+
+	newTsUsers, err := r.tsClient.GetUsers(*accessToken)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseInProgress, err
 	}
 
 	// update KeycloakUser attribute after user is created in 3scale
 	userCreated3ScaleName := "3scale_user_created"
 	for _, user := range kcu {
+		if !tsContainsKc(newTsUsers.Users, user) {
+			continue
+		}
 		if user.Attributes == nil {
 			user.Attributes = map[string][]string{
 				userCreated3ScaleName: {"true"},
@@ -997,26 +1051,29 @@ func (r *Reconciler) reconcileOpenshiftUsers(ctx context.Context, installation *
 		}
 	}
 
-	openshiftAdminGroup := &usersv1.Group{}
-	err = serverClient.Get(ctx, k8sclient.ObjectKey{Name: "dedicated-admins"}, openshiftAdminGroup)
-	if err != nil && !k8serr.IsNotFound(err) {
-		return integreatlyv1alpha1.PhaseInProgress, err
-	}
-	newTsUsers, err := r.tsClient.GetUsers(*accessToken)
+	openshiftGroups := &usersv1.GroupList{}
+	err = serverClient.List(ctx, openshiftGroups)
 	if err != nil {
 		return integreatlyv1alpha1.PhaseInProgress, err
 	}
 
 	isWorkshop := installation.Spec.Type == string(integreatlyv1alpha1.InstallationTypeWorkshop)
 
-	err = syncOpenshiftAdminMembership(openshiftAdminGroup, newTsUsers, *systemAdminUsername, isWorkshop, r.tsClient, *accessToken)
-	if err != nil {
-		return integreatlyv1alpha1.PhaseInProgress, err
-	}
+	// the users added above are promoted by the same sync, so the plan is made from the updated users
+	sync.Apply(ctx, planOpenshiftAdminMembership(policy, openshiftGroups, newTsUsers, *systemAdminUsername, isWorkshop, r.tsClient, *accessToken))
 
 	return integreatlyv1alpha1.PhaseCompleted, nil
 }
 
+// GetBackupExecutor returns the backups of the product performed on demand through RHMIBackup resources. The product
+// data is not backed up on cluster storage, where the backups are reported as unsupported
+func (r *Reconciler) GetBackupExecutor() backup.BackupExecutor {
+	if r.installation.Spec.UseClusterStorage != "false" {
+		return backup.NewUnsupportedBackupExecutor(backup.ClusterStorageUnsupportedReason)
+	}
+	return r.preUpgradeBackupExecutor()
+}
+
 func (r *Reconciler) preUpgradeBackupExecutor() backup.BackupExecutor {
 	if r.installation.Spec.UseClusterStorage != "false" {
 		return backup.NewNoopBackupExecutor()
@@ -1041,7 +1098,10 @@ func (r *Reconciler) preUpgradeBackupExecutor() backup.BackupExecutor {
 	)
 }
 
-func syncOpenshiftAdminMembership(openshiftAdminGroup *usersv1.Group, newTsUsers *Users, systemAdminUsername string, isWorkshop bool, tsClient ThreeScaleInterface, accessToken string) error {
+// planOpenshiftAdminMembership promotes the users of the admin groups to admins of 3scale. Admins are not demoted, as
+// users can be made admins from 3scale
+func planOpenshiftAdminMembership(policy *userHelper.Policy, openshiftGroups *usersv1.GroupList, newTsUsers *Users, systemAdminUsername string, isWorkshop bool, tsClient ThreeScaleInterface, accessToken string) []userHelper.SyncChange {
+	changes := []userHelper.SyncChange{}
 	for _, tsUser := range newTsUsers.Users {
 		// skip if ts user is the system user admin
 		if tsUser.UserDetails.Username == systemAdminUsername {
@@ -1049,15 +1109,26 @@ func syncOpenshiftAdminMembership(openshiftAdminGroup *usersv1.Group, newTsUsers
 		}
 
 		// In workshop mode, developer users also get admin permissions in 3scale
-		if (userIsOpenshiftAdmin(tsUser, openshiftAdminGroup) || isWorkshop) && tsUser.UserDetails.Role != adminRole {
-			res, err := tsClient.SetUserAsAdmin(tsUser.UserDetails.Id, accessToken)
-			if err != nil || res.StatusCode != http.StatusOK {
-				return err
-			}
+		if (policy.IsAdmin(integreatlyv1alpha1.Product3Scale, tsUser.UserDetails.Username, openshiftGroups) || isWorkshop) && tsUser.UserDetails.Role != adminRole {
+			userID := tsUser.UserDetails.Id
+			changes = append(changes, userHelper.SyncChange{
+				Action: integreatlyv1alpha1.UserSyncPromote,
+				User:   tsUser.UserDetails.Username,
+				Apply: func(ctx context.Context) error {
+					res, err := tsClient.SetUserAsAdmin(userID, accessToken)
+					if err != nil {
+						return err
+					}
+					if res.StatusCode != http.StatusOK {
+						return fmt.Errorf("failed to set user as admin of 3scale, status code %d", res.StatusCode)
+					}
+					return nil
+				},
+			})
 		}
 	}
 
-	return nil
+	return changes
 }
 
 func (r *Reconciler) reconcileServiceDiscovery(ctx context.Context, serverClient k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
@@ -1290,16 +1361,6 @@ func tsContainsKc(tsusers []*User, kcUser keycloak.KeycloakAPIUser) bool {
 	return false
 }
 
-func userIsOpenshiftAdmin(tsUser *User, adminGroup *usersv1.Group) bool {
-	for _, userName := range adminGroup.Users {
-		if strings.EqualFold(tsUser.UserDetails.Username, userName) {
-			return true
-		}
-	}
-
-	return false
-}
-
 func (r *Reconciler) getKeycloakClientSpec(clientSecret string) keycloak.KeycloakClientSpec {
 	return keycloak.KeycloakClientSpec{
 		RealmSelector: &metav1.LabelSelector{
@@ -1457,7 +1518,12 @@ func (r *Reconciler) reconcileRouteEditRole(ctx context.Context, client k8sclien
 		return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("Failed reconciling edit routes role %v: %w", editRoutesRole, err)
 	}
 
-	// Bind the amq online service admin role to the dedicated-admins group
+	policy, err := userHelper.GetPolicy(ctx, client)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
+	// Bind the edit routes role to the admin groups of 3scale, dedicated-admins by default
 	editRoutesRoleBinding := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "dedicated-admins-edit-routes",
@@ -1472,12 +1538,7 @@ func (r *Reconciler) reconcileRouteEditRole(ctx context.Context, client k8sclien
 			Name: editRoutesRole.GetName(),
 			Kind: "Role",
 		}
-		editRoutesRoleBinding.Subjects = []rbacv1.Subject{
-			{
-				Name: "dedicated-admins",
-				Kind: "Group",
-			},
-		}
+		editRoutesRoleBinding.Subjects = policy.AdminSubjects(integreatlyv1alpha1.Product3Scale)
 
 		return nil
 	})
@@ -1489,15 +1550,18 @@ func (r *Reconciler) reconcileRouteEditRole(ctx context.Context, client k8sclien
 }
 
 func (r *Reconciler) reconcileSubscription(ctx context.Context, serverClient k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productNamespace string, operatorNamespace string) (integreatlyv1alpha1.StatusPhase, error) {
+	productSpec := inst.GetProductSpec(integreatlyv1alpha1.Product3Scale)
 	target := marketplace.Target{
-		Pkg:       constants.ThreeScaleSubscriptionName,
-		Namespace: operatorNamespace,
-		Channel:   marketplace.IntegreatlyChannel,
+		Pkg:             constants.ThreeScaleSubscriptionName,
+		Namespace:       operatorNamespace,
+		Channel:         productSpec.GetChannel(marketplace.IntegreatlyChannel),
+		OperatorVersion: string(productSpec.OperatorVersion),
 	}
-	catalogSourceReconciler := marketplace.NewConfigMapCatalogSourceReconciler(
+	catalogSourceReconciler, target := marketplace.NewCatalogSourceReconciler(
+		inst.GetCatalogSource(integreatlyv1alpha1.Product3Scale),
+		target,
 		manifestPackage,
 		serverClient,
-		operatorNamespace,
 		marketplace.CatalogSourceName,
 	)
 	return r.Reconciler.ReconcileSubscription(
@@ -18,6 +18,7 @@ import (
 	"github.com/integr8ly/integreatly-operator/pkg/config"
 	"github.com/integr8ly/integreatly-operator/pkg/resources"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/marketplace"
+	userHelper "github.com/integr8ly/integreatly-operator/pkg/resources/user"
 	keycloak "github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
 	rbacv1 "k8s.io/api/rbac/v1"
 
@@ -28,6 +29,7 @@ import (
 	appsv1Client "github.com/openshift/client-go/apps/clientset/versioned/typed/apps/v1"
 	fakeoauthClient "github.com/openshift/client-go/oauth/clientset/versioned/fake"
 	oauthClient "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+	"github.com/sirupsen/logrus"
 
 	coreosv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1"
 	operatorsv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
@@ -374,10 +376,17 @@ func TestReconciler_syncOpenshiftAdmimMembership(t *testing.T) {
 		},
 	}
 
-	openshiftAdminGroup := &usersv1.Group{
-		Users: usersv1.OptionalNames{
-			"user1",
-			"user2",
+	openshiftGroups := &usersv1.GroupList{
+		Items: []usersv1.Group{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "dedicated-admins",
+				},
+				Users: usersv1.OptionalNames{
+					"user1",
+					"user2",
+				},
+			},
 		},
 	}
 
@@ -412,10 +421,13 @@ func TestReconciler_syncOpenshiftAdmimMembership(t *testing.T) {
 		},
 	}
 
-	err := syncOpenshiftAdminMembership(openshiftAdminGroup, newTsUsers, "", false, &tsClientMock, "")
+	changes := planOpenshiftAdminMembership(userHelper.DefaultPolicy(), openshiftGroups, newTsUsers, "", false, &tsClientMock, "")
+	sync := userHelper.NewSync(integreatlyv1alpha1.Product3Scale, userHelper.DefaultPolicy(), logrus.NewEntry(logrus.New()))
 
-	if err != nil {
-		t.Fatalf("Unexpected error when reconcilling openshift admin membership: %s", err)
+	for _, result := range sync.Apply(context.TODO(), changes) {
+		if result.Result != integreatlyv1alpha1.UserSyncApplied {
+			t.Fatalf("Unexpected result when reconcilling openshift admin membership: %s %s", result.Result, result.Message)
+		}
 	}
 
 	if !calledSetUserAsAdmin {
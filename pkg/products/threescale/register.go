@@ -0,0 +1,52 @@
+package threescale
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/products"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/grafana"
+
+	appsv1Client "github.com/openshift/client-go/apps/clientset/versioned/typed/apps/v1"
+
+	"k8s.io/client-go/rest"
+)
+
+func init() {
+	products.Register(integreatlyv1alpha1.Product3Scale, products.Registration{
+		Reconciler: func(deps products.Dependencies) (products.Interface, error) {
+			client, err := appsv1Client.NewForConfig(deps.RestConfig)
+			if err != nil {
+				return nil, err
+			}
+			client.RESTClient().(*rest.RESTClient).Client.Timeout = 10 * time.Second
+
+			oauthv1Client, err := products.NewOauthClient(deps.RestConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			httpc := &http.Client{
+				Timeout: time.Second * 10,
+				Transport: &http.Transport{
+					DisableKeepAlives: true,
+					IdleConnTimeout:   time.Second * 10,
+					TLSClientConfig:   &tls.Config{InsecureSkipVerify: deps.Installation.Spec.SelfSignedCerts},
+				},
+			}
+			tsClient := NewThreeScaleClient(httpc, deps.Installation.Spec.RoutingSubdomain)
+
+			return NewReconciler(deps.ConfigManager, deps.Installation, client, oauthv1Client, tsClient, deps.MPM, deps.Recorder)
+		},
+		Config: func(cfg config.ProductConfig) config.ConfigReadable {
+			return config.NewThreeScale(cfg)
+		},
+		PreflightObject: (&Reconciler{}).GetPreflightObject,
+		Version:         integreatlyv1alpha1.Version3Scale,
+		OperatorVersion: integreatlyv1alpha1.OperatorVersion3Scale,
+	})
+	grafana.RegisterProductDashboard(integreatlyv1alpha1.Product3Scale, GetDashboard)
+}
@@ -35,6 +35,7 @@ func getBuildScheme() (*runtime.Scheme, error) {
 	err = routev1.AddToScheme(scheme)
 	err = crov1.SchemeBuilder.AddToScheme(scheme)
 	err = monitoringv1.AddToScheme(scheme)
+	err = integreatlyv1alpha1.SchemeBuilder.AddToScheme(scheme)
 	projectv1.AddToScheme(scheme)
 	return scheme, err
 }
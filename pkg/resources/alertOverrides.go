@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/alerts"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
+	"github.com/sirupsen/logrus"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// alertThreshold matches the number an alert expression ends comparing with
+var alertThreshold = regexp.MustCompile(`(<=|>=|==|!=|<|>)(\s*)-?[0-9]+(\.[0-9]+)?(\s*)$`)
+
+// GetAlertOverrides returns the valid alert overrides of the RHMIConfig keyed by alert name. No overrides are
+// returned when the RHMIConfig does not exist
+func GetAlertOverrides(ctx context.Context, client k8sclient.Client) (map[string]integreatlyv1alpha1.AlertOverride, error) {
+	config := &integreatlyv1alpha1.RHMIConfig{}
+	err := client.Get(ctx, k8sclient.ObjectKey{Name: RHMIConfigName, Namespace: global.NamespacePrefix + "operator"}, config)
+	if k8serr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve rhmi config: %w", err)
+	}
+
+	// the overrides are validated by the webhook, an invalid override written while it was not available is ignored
+	// rather than producing an invalid rule, the other overrides still apply. It is reported in the conditions of the
+	// RHMIConfig
+	overrides := make(map[string]integreatlyv1alpha1.AlertOverride, len(config.Spec.Alerts.Overrides))
+	for _, override := range config.Spec.Alerts.Overrides {
+		if err := integreatlyv1alpha1.ValidateAlertOverride(override); err != nil {
+			logrus.Warnf("ignoring invalid alert override: %v", err)
+			continue
+		}
+		if _, ok := overrides[override.Alert]; ok {
+			logrus.Warnf("ignoring alert override of %s, the alert is already overridden", override.Alert)
+			continue
+		}
+		overrides[override.Alert] = override
+	}
+	return overrides, nil
+}
+
+// applyAlertOverrides returns a copy of the rules with the overrides applied, leaving out the disabled alerts. An
+// alert missing from the catalogue of the built-in alerts is reported, as it can not be overridden
+func applyAlertOverrides(rules []monitoringv1.Rule, overrides map[string]integreatlyv1alpha1.AlertOverride) []monitoringv1.Rule {
+	result := make([]monitoringv1.Rule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Alert == "" {
+			result = append(result, rule)
+			continue
+		}
+		templated := strings.Contains(rule.Expr.String(), alerts.ThresholdPlaceholder)
+		if known, hasThreshold := alerts.Lookup(rule.Alert); !known || hasThreshold != (templated || alertThreshold.MatchString(rule.Expr.String())) {
+			logrus.Warnf("alert %s does not match the catalogue of the built-in alerts", rule.Alert)
+		}
+
+		override, ok := overrides[rule.Alert]
+		if !ok && !templated {
+			result = append(result, rule)
+			continue
+		}
+		if override.Disabled {
+			continue
+		}
+
+		rule = *rule.DeepCopy()
+		if override.For != "" {
+			rule.For = override.For
+		}
+		if override.Severity != "" {
+			if rule.Labels == nil {
+				rule.Labels = map[string]string{}
+			}
+			rule.Labels["severity"] = string(override.Severity)
+		}
+		rule.Expr = intstr.FromString(setAlertThreshold(rule.Alert, rule.Expr.String(), override.Threshold))
+		result = append(result, rule)
+	}
+	return result
+}
+
+// setAlertThreshold replaces the threshold placeholder of a templated expression by the threshold, or by the default
+// threshold of the alert when it is not overridden. The threshold of other expressions is the number they end
+// comparing with
+func setAlertThreshold(alert string, expr string, threshold string) string {
+	if strings.Contains(expr, alerts.ThresholdPlaceholder) {
+		if threshold == "" {
+			defaultThreshold, ok := alerts.DefaultThreshold(alert)
+			if !ok {
+				logrus.Warnf("alert %s has no default threshold", alert)
+			}
+			threshold = defaultThreshold
+		}
+		return strings.ReplaceAll(expr, alerts.ThresholdPlaceholder, threshold)
+	}
+	if threshold == "" {
+		return expr
+	}
+	return alertThreshold.ReplaceAllString(expr, "${1}${2}"+threshold+"${4}")
+}
@@ -0,0 +1,153 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/alerts"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApplyAlertOverrides(t *testing.T) {
+	alertRules := []monitoringv1.Rule{
+		{
+			Alert:  "RedisMemoryUsageHigh",
+			Expr:   intstr.FromString("cro_redis_memory_usage_percentage_average > 90"),
+			For:    "60m",
+			Labels: map[string]string{"severity": "critical"},
+		},
+		{
+			Alert:  "PostgresCPUHigh",
+			Expr:   intstr.FromString("cro_postgres_cpu_utilization_average > 90"),
+			For:    "15m",
+			Labels: map[string]string{"severity": "critical"},
+		},
+		{
+			Alert: "ThreeScaleApicastDown",
+			Expr:  intstr.FromString("absent(kube_pod_status_ready{namespace='3scale'})"),
+			For:   "5m",
+		},
+	}
+
+	result := applyAlertOverrides(alertRules, map[string]integreatlyv1alpha1.AlertOverride{
+		"RedisMemoryUsageHigh":  {Alert: "RedisMemoryUsageHigh", Threshold: "97.5", For: "2h", Severity: integreatlyv1alpha1.AlertSeverityWarning},
+		"PostgresCPUHigh":       {Alert: "PostgresCPUHigh", Disabled: true},
+		"ThreeScaleApicastDown": {Alert: "ThreeScaleApicastDown", Severity: integreatlyv1alpha1.AlertSeverityInfo},
+	})
+
+	if len(result) != 2 {
+		t.Fatalf("expected the disabled alert to be removed, got %v", result)
+	}
+	redis := result[0]
+	if redis.Expr.String() != "cro_redis_memory_usage_percentage_average > 97.5" || redis.For != "2h" || redis.Labels["severity"] != "warning" {
+		t.Fatalf("expected the redis alert to be overridden, got %v", redis)
+	}
+	if alertRules[0].Labels["severity"] != "critical" || alertRules[0].For != "60m" {
+		t.Fatalf("expected the built-in rules to be left unchanged, got %v", alertRules[0])
+	}
+	if result[1].Labels["severity"] != "info" {
+		t.Fatalf("expected the severity of an alert without labels to be set, got %v", result[1])
+	}
+
+}
+
+func TestApplyAlertOverridesTemplatedThreshold(t *testing.T) {
+	alertRules := []monitoringv1.Rule{
+		{
+			Alert: "PostgresStorageLow",
+			Expr:  intstr.FromString("cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100 ) * " + alerts.ThresholdPlaceholder + ")"),
+		},
+	}
+
+	result := applyAlertOverrides(alertRules, nil)
+	if result[0].Expr.String() != "cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100 ) * 10)" {
+		t.Fatalf("expected the default threshold, got %s", result[0].Expr.String())
+	}
+
+	result = applyAlertOverrides(alertRules, map[string]integreatlyv1alpha1.AlertOverride{
+		"PostgresStorageLow": {Alert: "PostgresStorageLow", Threshold: "5"},
+	})
+	if result[0].Expr.String() != "cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100 ) * 5)" {
+		t.Fatalf("expected the overridden threshold, got %s", result[0].Expr.String())
+	}
+	if !strings.Contains(alertRules[0].Expr.String(), alerts.ThresholdPlaceholder) {
+		t.Fatalf("expected the built-in rules to be left unchanged, got %v", alertRules[0])
+	}
+}
+
+func TestReconcileAlertsWithOverrides(t *testing.T) {
+	scheme, err := buildSchemePrometheusRules()
+	if err != nil {
+		t.Fatalf("error building scheme: %v", err)
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "rhmi",
+			Namespace: global.NamespacePrefix + "operator",
+		},
+	}
+	rhmiConfig := &integreatlyv1alpha1.RHMIConfig{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      RHMIConfigName,
+			Namespace: global.NamespacePrefix + "operator",
+		},
+		Spec: integreatlyv1alpha1.RHMIConfigSpec{
+			Alerts: integreatlyv1alpha1.Alerts{
+				Overrides: []integreatlyv1alpha1.AlertOverride{
+					{Alert: "RHMIOperatorInstallDelayed", For: "30m"},
+					// an invalid override is ignored without dropping the others
+					{Alert: "UnknownAlert", Disabled: true},
+				},
+			},
+		},
+	}
+	client := fake.NewFakeClientWithScheme(scheme, installation, rhmiConfig)
+
+	rules := []monitoringv1.Rule{
+		{
+			Alert:  "RHMIOperatorInstallDelayed",
+			Expr:   intstr.FromString("absent(rhmi_status{stage='complete'} == 1)"),
+			For:    "5m",
+			Labels: map[string]string{"severity": "critical"},
+		},
+	}
+
+	alertReconciler := &AlertReconcilerImpl{
+		ProductName:  "Test",
+		Installation: installation,
+		Logger:       logrus.NewEntry(logrus.New()),
+		Alerts: []AlertConfiguration{
+			{
+				AlertName: "test-alert",
+				GroupName: "test-group",
+				Namespace: global.NamespacePrefix + "test",
+				Rules:     rules,
+			},
+		},
+	}
+
+	phase, err := alertReconciler.ReconcileAlerts(context.TODO(), client)
+	if err != nil || phase != integreatlyv1alpha1.PhaseCompleted {
+		t.Fatalf("expected phase %s, got %s: %v", integreatlyv1alpha1.PhaseCompleted, phase, err)
+	}
+
+	rule := &monitoringv1.PrometheusRule{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "test-alert", Namespace: global.NamespacePrefix + "test"}, rule); err != nil {
+		t.Fatalf("error retrieving rule: %v", err)
+	}
+	if rule.Spec.Groups[0].Rules[0].For != "30m" {
+		t.Fatalf("expected the for duration to be overridden, got %s", rule.Spec.Groups[0].Rules[0].For)
+	}
+	if rules[0].For != "5m" {
+		t.Fatalf("expected the alert configuration to be left unchanged, got %s", rules[0].For)
+	}
+}
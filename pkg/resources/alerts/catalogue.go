@@ -0,0 +1,189 @@
+package alerts
+
+import "regexp"
+
+// builtInAlerts lists the alerts reconciled by the operator, and whether their threshold can be overridden: the
+// threshold of an alert whose expression ends comparing with a number. It is static so that the alert overrides of
+// the RHMIConfig can be validated before the products are reconciled, and must be updated along with the alerts
+var builtInAlerts = map[string]bool{
+	// installation
+	"RHMIInstallationControllerIsNotReconciling":   true,
+	"RHMIInstallationControllerStoppedReconciling": true,
+	"RHMIOperatorInstallDelayed":                   false,
+	"RHMIUpgradeExpectedDurationExceeded":          false,
+
+	// amqonline
+	"AMQOnlineConsoleAvailable":                              false,
+	"AMQOnlineKeycloakAvailable":                             false,
+	"AMQOnlineOperatorAvailable":                             false,
+	"RHMIAMQOnlineNoneAuthServiceEndpointDown":               true,
+	"RHMIAMQOnlineAddressSpaceControllerServiceEndpointDown": true,
+	"RHMIAMQOnlineConsoleServiceEndpointDown":                true,
+	"RHMIAMQOnlineRegistryCsServiceEndpointDown":             true,
+	"RHMIAMQOnlineStandardAuthServiceEndpointDown":           true,
+	"RHMIAMQOnlineEnmasseOperatorMetricsServiceEndpointDown": true,
+	"AMQOnlinePodCount":                                      true,
+	"AMQOnlineContainerHighMemory":                           true,
+
+	// apicurito
+	"ApicuritoPodCount":                                      true,
+	"RHMIApicuritoServiceEndpointDown":                       true,
+	"RHMIApicuritoFuseApicuritoGeneratorServiceEndpointDown": true,
+	"RHMIApicuritoOperatorRhmiRegistryCsServiceEndpointDown": true,
+
+	// cloudresources
+	"RHMICloudResourceOperatorMetricsServiceEndpointDown":        true,
+	"RHMICloudResourceOperatorRhmiRegistryCsServiceEndpointDown": true,
+
+	// codeready
+	"RHMICodeReadyCheHostServiceEndpointDown":                true,
+	"RHMICodeReadyDevfileRegistryServiceEndpointDown":        true,
+	"RHMICodeReadyPluginRegistryServiceEndpointDown":         true,
+	"RHMICodeReadyOperatorRhmiRegistryCsServiceEndpointDown": true,
+	"CodeReadyPodCount": true,
+
+	// fuse
+	"RHMIFuseOnlineBrokerAmqTcpServiceEndpointDown":                    true,
+	"RHMIFuseOnlineSyndesisMetaServiceEndpointDown":                    true,
+	"RHMIFuseOnlineSyndesisOauthproxyServiceEndpointDown":              true,
+	"RHMIFuseOnlineSyndesisPrometheusServiceEndpointDown":              true,
+	"RHMIFuseOnlineSyndesisServerServiceEndpointDown":                  true,
+	"RHMIFuseOnlineSyndesisUiServiceEndpointDown":                      true,
+	"RHMIFuseOnlineOperatorRhmiRegistryCsServiceEndpointDown":          true,
+	"RHMIFuseOnlineOperatorSyndesisOperatorMetricsServiceEndpointDown": true,
+	"FuseOnlineSyndesisServerInstanceDown":                             true,
+	"FuseOnlineSyndesisUIInstanceDown":                                 true,
+
+	// monitoring
+	"JobRunningTimeExceeded":        true,
+	"CronJobNotRunInThreshold":      false,
+	"CronJobsFailed":                true,
+	"KubePodCrashLooping":           true,
+	"KubePodNotReady":               true,
+	"KubePodImagePullBackOff":       true,
+	"KubePodBadConfig":              true,
+	"KubePodStuckCreating":          true,
+	"ClusterSchedulableMemoryLow":   true,
+	"ClusterSchedulableCPULow":      true,
+	"PVCStorageAvailable":           true,
+	"PVCStorageMetricsAvailable":    true,
+	"KubePersistentVolumeFillingUp": false,
+	"PersistentVolumeErrors":        true,
+	"MiddlewareMonitoringPodCount":  true,
+	"RHMIMiddlewareMonitoringOperatorAlertmanagerOperatedServiceEndpointDown":         true,
+	"RHMIMiddlewareMonitoringOperatorAlertmanagerServiceEndpointDown":                 true,
+	"RHMIMiddlewareMonitoringOperatorApplicationMonitoringMetricsServiceEndpointDown": true,
+	"RHMIMiddlewareMonitoringOperatorGrafanaServiceEndpointDown":                      true,
+	"RHMIMiddlewareMonitoringOperatorPrometheusOperatedServiceEndpointDown":           true,
+	"RHMIMiddlewareMonitoringOperatorPrometheusServiceEndpointDown":                   true,
+	"RHMIMiddlewareMonitoringOperatorRhmiRegistryCsServiceEndpointDown":               true,
+	"RHMICSVRequirementsNotMet": false,
+
+	// rhsso
+	"RHMIRhssoKeycloakServiceEndpointDown":                       true,
+	"RHMIRhssoKeycloakDiscoveryServiceEndpointDown":              true,
+	"RHMIRhssoKeycloakOperatorRhmiRegistryCsServiceEndpointDown": true,
+	"RHMIRhssoKeycloakOperatorMetricsServiceEndpointDown":        true,
+
+	// rhssouser
+	"RHMIUserRhssoKeycloakServiceEndpointDown":                      true,
+	"RHMIUserRhssoKeycloakDiscoveryServiceEndpointDown":             true,
+	"RHMIUserRhssoOperatorRhmiRegistryCsMetricsServiceEndpointDown": true,
+	"RHMIUserRhssoKeycloakOperatorMetricsServiceEndpointDown":       true,
+
+	// solutionexplorer
+	"RHMISolutionExplorerTutorialWebAppServiceEndpointDown":         true,
+	"RHMISolutionExplorerOperatorRhmiRegistryCsServiceEndpointDown": true,
+	"SolutionExplorerPodCount":                                      true,
+
+	// threescale
+	"RHMIThreeScaleApicastProductionServiceEndpointDown":      true,
+	"RHMIThreeScaleApicastStagingServiceEndpointDown":         true,
+	"RHMIThreeScaleBackendListenerServiceEndpointDown":        true,
+	"RHMIThreeScaleSystemDeveloperServiceEndpointDown":        true,
+	"RHMIThreeScaleSystemMasterServiceEndpointDown":           true,
+	"RHMIThreeScaleSystemMemcacheServiceEndpointDown":         true,
+	"RHMIThreeScaleSystemProviderServiceEndpointDown":         true,
+	"RHMIThreeScaleSystemSphinxServiceEndpointDown":           true,
+	"RHMIThreeScaleZyncServiceEndpointDown":                   true,
+	"RHMIThreeScaleZyncDatabaseServiceEndpointDown":           true,
+	"RHMIThreeScaleOperatorRhmiRegistryCsServiceEndpointDown": true,
+	"RHMIThreeScaleOperatorServiceEndpointDown":               true,
+	"ThreeScaleApicastStagingPod":                             true,
+	"ThreeScaleApicastProductionPod":                          true,
+	"ThreeScaleBackendWorkerPod":                              true,
+	"ThreeScaleBackendListenerPod":                            true,
+	"ThreeScaleSystemAppPod":                                  true,
+	"ThreeScaleAdminUIBBT":                                    false,
+	"ThreeScaleDeveloperUIBBT":                                false,
+	"ThreeScaleSystemAdminUIBBT":                              false,
+	"ThreeScaleZyncPodAvailability":                           true,
+	"ThreeScaleZyncDatabasePodAvailability":                   true,
+	"ThreeScaleContainerHighMemory":                           true,
+	"ThreeScaleContainerHighCPU":                              true,
+
+	// ups
+	"RHMIUPSUnifiedPushServiceEndpointDown":                        true,
+	"RHMIUPSUnifiedpushProxyServiceEndpointDown":                   true,
+	"RHMIUPSOperatorRhmiRegistryCsServiceEndpointDown":             true,
+	"RHMIUPSOperatorUnifiedPushOperatorMetricsServiceEndpointDown": true,
+
+	// cloud resources
+	"SendgridSmtpSecretExists":        false,
+	"PostgresStorageWillFillIn4Hours": true,
+	"PostgresStorageWillFillIn4Days":  true,
+	"PostgresStorageLow":              true,
+	"PostgresFreeableMemoryLow":       true,
+	"PostgresCPUHigh":                 true,
+	"RedisMemoryUsageHigh":            true,
+	"RedisMemoryUsageMaxIn4Hours":     true,
+	"RedisMemoryUsageMaxIn4Days":      true,
+	"RedisCpuUsageHigh":               true,
+}
+
+// ThresholdPlaceholder stands for the threshold in the expression of an alert that does not end comparing with it, it
+// is replaced by the overridden threshold or by the default threshold of the alert
+const ThresholdPlaceholder = "$threshold"
+
+// templatedThresholds are the default thresholds of the alerts whose expression has a ThresholdPlaceholder
+var templatedThresholds = map[string]string{
+	"PostgresStorageWillFillIn4Hours": "25",
+	"PostgresStorageWillFillIn4Days":  "25",
+	"PostgresStorageLow":              "10",
+	"PostgresFreeableMemoryLow":       "10",
+}
+
+// builtInAlertPatterns match the names of the alerts reconciled for each instance of a resource, such as the alerts of
+// every postgres instance or of every backup
+var builtInAlertPatterns = []struct {
+	name         *regexp.Regexp
+	hasThreshold bool
+}{
+	{name: regexp.MustCompile(`^.*Postgres(InstanceUnavailable|ConnectionFailed|ResourceStatusPhasePending|ResourceDeletionStatusPhaseFailed)$`)},
+	{name: regexp.MustCompile(`^.*PostgresResourceStatusPhaseFailed$`), hasThreshold: true},
+	{name: regexp.MustCompile(`^.*Redis(ResourceStatusPhasePending|ResourceDeletionStatusPhaseFailed|CacheUnavailable|CacheConnectionFailed)$`)},
+	{name: regexp.MustCompile(`^.*RedisResourceStatusPhaseFailed$`), hasThreshold: true},
+	{name: regexp.MustCompile(`^CronJobExists_.+$`)},
+	{name: regexp.MustCompile(`^BackupVerificationFailed_.+$`), hasThreshold: true},
+	{name: regexp.MustCompile(`^BackupVerificationStale_.+$`)},
+	{name: regexp.MustCompile(`^RHMI[A-Za-z0-9]+ErrorBudgetBurn(Fast|Slow)$`)},
+}
+
+// Lookup returns whether the alert is reconciled by the operator, and whether its threshold can be overridden
+func Lookup(alert string) (known bool, hasThreshold bool) {
+	if hasThreshold, ok := builtInAlerts[alert]; ok {
+		return true, hasThreshold
+	}
+	for _, pattern := range builtInAlertPatterns {
+		if pattern.name.MatchString(alert) {
+			return true, pattern.hasThreshold
+		}
+	}
+	return false, false
+}
+
+// DefaultThreshold returns the default threshold of an alert whose expression has a ThresholdPlaceholder
+func DefaultThreshold(alert string) (string, bool) {
+	threshold, ok := templatedThresholds[alert]
+	return threshold, ok
+}
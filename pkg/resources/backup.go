@@ -32,6 +32,9 @@ type BackupComponent struct {
 	Type     string
 	Secret   BackupSecretLocation
 	Schedule string
+	// VerificationSchedule is the schedule of the job restoring the latest backup of the component to verify it, the
+	// backups are not verified when it is empty or when the backup image does not support restores
+	VerificationSchedule string
 }
 
 type BackupSecretLocation struct {
@@ -43,12 +46,30 @@ var (
 	BackupServiceAccountName = "rhmi-backupjob"
 	BackupRoleName           = "rhmi-backupjob"
 	BackupRoleBindingName    = "rhmi-backupjob"
+
+	BackupEncryptionSecretName = "backups-encryption-key"
 )
 
 func ReconcileBackup(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, configManager productsConfig.ConfigReadWriter) error {
 	logrus.Infof("reconciling backups: %s", config.Name)
 
-	err := reconcileBackendSecret(ctx, serverClient, config, configManager.GetBackupsSecretName(), configManager.GetOperatorNamespace())
+	backupConfig, err := configManager.ReadBackup()
+	if err != nil {
+		return fmt.Errorf("could not read backup config: %w", err)
+	}
+	config.Components = getVerifiableComponents(config.Components, backupConfig)
+
+	backend, err := NewBackupBackend(backupConfig, configManager)
+	if err != nil {
+		return err
+	}
+
+	storage, err := backend.ReconcileStorage(ctx, serverClient, config)
+	if err != nil {
+		return err
+	}
+
+	err = reconcileEncryptionSecret(ctx, serverClient, &config, backupConfig.GetEncryptionSecretName(), configManager.GetOperatorNamespace())
 	if err != nil {
 		return err
 	}
@@ -68,7 +89,17 @@ func ReconcileBackup(ctx context.Context, serverClient k8sclient.Client, config
 		return err
 	}
 
-	err = reconcileCronjobs(ctx, serverClient, config)
+	err = reconcileVerificationServiceAccount(ctx, serverClient, config)
+	if err != nil {
+		return err
+	}
+
+	err = reconcileCronjobs(ctx, serverClient, config, storage, backupConfig)
+	if err != nil {
+		return err
+	}
+
+	err = observeBackupVerifications(ctx, serverClient, config)
 	if err != nil {
 		return err
 	}
@@ -80,37 +111,33 @@ func ReconcileBackup(ctx context.Context, serverClient k8sclient.Client, config
 	return nil
 }
 
-func reconcileBackendSecret(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, secretName string, secretNamespace string) error {
-	sourceSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: secretNamespace,
-		},
+// reconcileEncryptionSecret copies the GPG key of the backup configuration to the namespace of the backups, unless the
+// encryption secret of the backups is set by the product
+func reconcileEncryptionSecret(ctx context.Context, serverClient k8sclient.Client, config *BackupConfig, secretName string, secretNamespace string) error {
+	if config.EncryptionSecret.Name != "" || secretName == "" {
+		return nil
 	}
-	err := serverClient.Get(ctx, k8sclient.ObjectKey{Namespace: sourceSecret.Namespace, Name: sourceSecret.Name}, sourceSecret)
+
+	sourceSecret := &corev1.Secret{}
+	err := serverClient.Get(ctx, k8sclient.ObjectKey{Namespace: secretNamespace, Name: secretName}, sourceSecret)
 	if err != nil {
-		return fmt.Errorf("Could not get secret that contains S3 credentials for backup CronJobs - %s Secret from %s namespace: %w", sourceSecret.Name, sourceSecret.Namespace, err)
+		return fmt.Errorf("Could not get secret that contains the encryption key for backup CronJobs - %s Secret from %s namespace: %w", secretName, secretNamespace, err)
 	}
 
+	config.EncryptionSecret = BackupSecretLocation{Name: BackupEncryptionSecretName, Namespace: config.Namespace}
 	destinationSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      config.BackendSecret.Name,
-			Namespace: config.BackendSecret.Namespace,
+			Name:      config.EncryptionSecret.Name,
+			Namespace: config.EncryptionSecret.Namespace,
 		},
 	}
 	or, err := controllerutil.CreateOrUpdate(ctx, serverClient, destinationSecret, func() error {
-		// Transforming from Secret field names of CRO to the names consumed by our scripts:
-		// https://github.com/integr8ly/backup-container-image/blob/master/image/tools/lib/backend/s3.sh#L10-L20
-		destinationSecret.Data = map[string][]byte{
-			"AWS_ACCESS_KEY_ID":     sourceSecret.Data["credentialKeyID"],
-			"AWS_SECRET_ACCESS_KEY": sourceSecret.Data["credentialSecretKey"],
-			"AWS_S3_BUCKET_NAME":    sourceSecret.Data["bucketName"],
-			"AWS_S3_REGION":         sourceSecret.Data["bucketRegion"],
-		}
+		// https://github.com/integr8ly/backup-container-image/blob/master/image/tools/lib/encryption/gpg.sh
+		destinationSecret.Data = sourceSecret.Data
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Could not %s backup Secret %s in %s namespace: %w", or, destinationSecret.Name, destinationSecret.Namespace, err)
+		return fmt.Errorf("Could not %s backup encryption Secret %s in %s namespace: %w", or, destinationSecret.Name, destinationSecret.Namespace, err)
 	}
 
 	return nil
@@ -190,19 +217,29 @@ func reconcileRoleBinding(ctx context.Context, serverClient k8sclient.Client, co
 	return err
 }
 
-func reconcileCronjobs(ctx context.Context, serverClient k8sclient.Client, config BackupConfig) error {
+func reconcileCronjobs(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, storage BackupStorage, backupConfig *productsConfig.Backup) error {
 	for _, component := range config.Components {
-		err := reconcileCronjob(ctx, serverClient, config, component)
+		err := reconcileCronjob(ctx, serverClient, config, component, storage, backupConfig.GetImage())
 		if err != nil {
 			return fmt.Errorf("error reconciling backup job %s, for component %s: %w", config.Name, component, err)
 		}
+		err = reconcileVerificationCronjob(ctx, serverClient, config, component, storage, backupConfig)
+		if err != nil {
+			return fmt.Errorf("error reconciling backup verification job %s, for component %s: %w", config.Name, component, err)
+		}
 	}
 	return nil
 }
 
-func reconcileCronjob(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, component BackupComponent) error {
+func reconcileCronjob(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, component BackupComponent, storage BackupStorage, image string) error {
 	monitoringConfig := productsConfig.NewMonitoring(productsConfig.ProductConfig{})
 
+	// the backups are encrypted when an encryption secret is set
+	encryption := ""
+	if config.EncryptionSecret.Name != "" {
+		encryption = "gpg"
+	}
+
 	cronjob := &batchv1beta1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      component.Name,
@@ -228,53 +265,20 @@ func reconcileCronjob(ctx context.Context, serverClient k8sclient.Client, config
 							Containers: []corev1.Container{
 								{
 									Name:            "backup-cronjob",
-									Image:           "quay.io/integreatly/backup-container:1.0.15",
+									Image:           image,
 									ImagePullPolicy: "Always",
 									Command: []string{
 										"/opt/intly/tools/entrypoint.sh",
 										"-c",
 										component.Type,
 										"-b",
-										"s3",
+										storage.Name(),
 										"-e",
-										"",
+										encryption,
 										"-d",
 										"",
 									},
-									Env: []corev1.EnvVar{
-										{
-											Name:  "BACKEND_SECRET_NAME",
-											Value: config.BackendSecret.Name,
-										},
-										{
-											Name:  "BACKEND_SECRET_NAMESPACE",
-											Value: config.BackendSecret.Namespace,
-										},
-										{
-											Name:  "ENCRYPTION_SECRET_NAME",
-											Value: config.EncryptionSecret.Name,
-										},
-										{
-											Name:  "ENCRYPTION_SECRET_NAMESPACE",
-											Value: config.EncryptionSecret.Namespace,
-										},
-										{
-											Name:  "COMPONENT_SECRET_NAME",
-											Value: component.Secret.Name,
-										},
-										{
-											Name:  "COMPONENT_SECRET_NAMESPACE",
-											Value: component.Secret.Namespace,
-										},
-										{
-											Name:  "PRODUCT_NAME",
-											Value: config.Name,
-										},
-										{
-											Name:  "PRODUCT_NAMESPACE",
-											Value: config.Namespace,
-										},
-									},
+									Env: getBackupEnv(config, component),
 								},
 							},
 						},
@@ -282,11 +286,50 @@ func reconcileCronjob(ctx context.Context, serverClient k8sclient.Client, config
 				},
 			},
 		}
+		storage.ConfigurePod(config, component, &cronjob.Spec.JobTemplate.Spec.Template.Spec)
 		return nil
 	})
 	return err
 }
 
+// getBackupEnv returns the environment of the backup container, locating the secrets and the product of the backups
+func getBackupEnv(config BackupConfig, component BackupComponent) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name:  "BACKEND_SECRET_NAME",
+			Value: config.BackendSecret.Name,
+		},
+		{
+			Name:  "BACKEND_SECRET_NAMESPACE",
+			Value: config.BackendSecret.Namespace,
+		},
+		{
+			Name:  "ENCRYPTION_SECRET_NAME",
+			Value: config.EncryptionSecret.Name,
+		},
+		{
+			Name:  "ENCRYPTION_SECRET_NAMESPACE",
+			Value: config.EncryptionSecret.Namespace,
+		},
+		{
+			Name:  "COMPONENT_SECRET_NAME",
+			Value: component.Secret.Name,
+		},
+		{
+			Name:  "COMPONENT_SECRET_NAMESPACE",
+			Value: component.Secret.Namespace,
+		},
+		{
+			Name:  "PRODUCT_NAME",
+			Value: config.Name,
+		},
+		{
+			Name:  "PRODUCT_NAMESPACE",
+			Value: config.Namespace,
+		},
+	}
+}
+
 func reconcileCronjobAlerts(ctx context.Context, serverClient k8sclient.Client, config BackupConfig) error {
 	monitoringConfig := productsConfig.NewMonitoring(productsConfig.ProductConfig{})
 
@@ -302,6 +345,30 @@ func reconcileCronjobAlerts(ctx context.Context, serverClient k8sclient.Client,
 			For:    "5m",
 			Labels: map[string]string{"severity": "warning"},
 		})
+
+		if component.VerificationSchedule == "" {
+			continue
+		}
+		selector := fmt.Sprintf("product_namespace=\"%s\", component=\"%s\"", config.Namespace, component.Name)
+		rules = append(rules, monitoringv1.Rule{
+			Alert: "BackupVerificationFailed_" + config.Namespace + "_" + component.Name,
+			Annotations: map[string]string{
+				"sop_url": SopUrlAlertsAndTroubleshooting,
+				"message": "The latest backup of {{ $labels.product_namespace }}/{{ $labels.component }} could not be restored by its verification job",
+			},
+			Expr:   intstr.FromString(fmt.Sprintf("rhmi_backup_verification_success{%s} == 0", selector)),
+			For:    "5m",
+			Labels: map[string]string{"severity": "warning"},
+		}, monitoringv1.Rule{
+			Alert: "BackupVerificationStale_" + config.Namespace + "_" + component.Name,
+			Annotations: map[string]string{
+				"sop_url": SopUrlAlertsAndTroubleshooting,
+				"message": "The backups of {{ $labels.product_namespace }}/{{ $labels.component }} have not been verified in the last 48 hours",
+			},
+			Expr:   intstr.FromString(fmt.Sprintf("time() - rhmi_backup_last_verified_timestamp{%s} > %d", selector, BackupVerificationStaleSeconds)),
+			For:    "5m",
+			Labels: map[string]string{"severity": "warning"},
+		})
 	}
 
 	rule := &monitoringv1.PrometheusRule{
@@ -3,6 +3,7 @@ package backup
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/integr8ly/cloud-resource-operator/pkg/apis/integreatly/v1alpha1"
@@ -20,6 +21,7 @@ type AWSBackupExecutor struct {
 	SnapshotNamespace string          // Namespace where the snapshot CR is created
 	ResourceName      string          // AWS Resource name
 	SnapshotType      AWSSnapshotType // Type of snapshot CR to create
+	Origin            string          // Origin label of the snapshot CR, defaults to PreUpgradeBackupOrigin
 }
 
 func NewAWSBackupExecutor(snapshotNamespace, resourceName string, snapshotType AWSSnapshotType) BackupExecutor {
@@ -40,18 +42,49 @@ const (
 	RedisSnapshotType AWSSnapshotType = "RedisSnapshot"
 )
 
+const (
+	// BackupOriginLabel is set on the snapshots with the origin of the backup, only the pre-upgrade snapshots are
+	// removed by the retention policy
+	BackupOriginLabel = "integreatly.org/backup-origin"
+	// PreUpgradeBackupOrigin is the origin of the snapshots taken before the upgrades of the products
+	PreUpgradeBackupOrigin = "pre-upgrade"
+	// OnDemandBackupOrigin is the origin of the snapshots taken through RHMIBackup resources
+	OnDemandBackupOrigin = "on-demand"
+)
+
+// preUpgradeSnapshotInfix separates the name of the resource from the time of the snapshot in the name of the
+// snapshots created before upgrades
+const preUpgradeSnapshotInfix = "-preupgrade-snapshot-"
+
 // PerformBackup creates a snapshot CR and waits until the status of the CR
-// is `complete`
-func (e *AWSBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) error {
+// is `complete`, returning the name of the snapshot
+func (e *AWSBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error) {
+	backups, err := e.StartBackup(client)
+	if err != nil {
+		return nil, err
+	}
+	if err := waitForBackups(client, e, backups, timeout); err != nil {
+		return nil, err
+	}
+	return backups, nil
+}
+
+// StartBackup creates a snapshot CR and returns its name
+func (e *AWSBackupExecutor) StartBackup(client k8sclient.Client) ([]string, error) {
 	logrus.Infof("Performing backup by creating %s for AWS resource %s", e.SnapshotType, e.ResourceName)
 
-	snapshotName := fmt.Sprintf("%s-preupgrade-snapshot-%s", e.ResourceName, time.Now().Format("2006-01-02-150405"))
+	snapshotName := fmt.Sprintf("%s%s%s", e.ResourceName, preUpgradeSnapshotInfix, time.Now().Format("2006-01-02-150405"))
 
 	// Initialize the snapshot CR based on the snapshot type
 	var snapshotCR runtime.Object
+	origin := e.Origin
+	if origin == "" {
+		origin = PreUpgradeBackupOrigin
+	}
 	commonObjectMeta := v1.ObjectMeta{
 		Namespace: e.SnapshotNamespace,
 		Name:      snapshotName,
+		Labels:    map[string]string{BackupOriginLabel: origin},
 	}
 
 	switch e.SnapshotType {
@@ -70,41 +103,42 @@ func (e *AWSBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.
 			},
 		}
 	default:
-		return fmt.Errorf("Unsupported value for AWSShapshotType. Expected %s or %s, got %s",
+		return nil, fmt.Errorf("Unsupported value for AWSShapshotType. Expected %s or %s, got %s",
 			PostgresSnapshotType, RedisSnapshotType, e.SnapshotType)
 	}
 
 	// Create the CR
 	err := client.Create(context.TODO(), snapshotCR)
 	if err != nil {
-		return fmt.Errorf("Error creating %s for backup of resource %s: %v",
+		return nil, fmt.Errorf("Error creating %s for backup of resource %s: %v",
 			e.SnapshotType, e.ResourceName, err)
 	}
+	return []string{snapshotName}, nil
+}
 
-	// Initialize the CR to query it's completion
-	var queryCR runtime.Object
-	switch e.SnapshotType {
-	case PostgresSnapshotType:
-		queryCR = &v1alpha1.PostgresSnapshot{}
-	case RedisSnapshotType:
-		queryCR = &v1alpha1.RedisSnapshot{}
-	}
-
-	// Request the CR status until it's complete or it times out
-	started := time.Now()
-	for {
-		// If it times out, return an error
-		if time.Now().After(started.Add(timeout)) {
-			return fmt.Errorf("Snapshot of %s %s timed out", e.ResourceName, e.SnapshotType)
+// CheckBackup returns whether the status of the snapshot CRs is `complete`, or the message of a snapshot that failed
+func (e *AWSBackupExecutor) CheckBackup(client k8sclient.Client, backups []string) (bool, error) {
+	completed := true
+	for _, snapshotName := range backups {
+		// Initialize the CR to query it's completion
+		var queryCR runtime.Object
+		switch e.SnapshotType {
+		case PostgresSnapshotType:
+			queryCR = &v1alpha1.PostgresSnapshot{}
+		case RedisSnapshotType:
+			queryCR = &v1alpha1.RedisSnapshot{}
+		default:
+			return false, fmt.Errorf("Unsupported value for AWSShapshotType. Expected %s or %s, got %s",
+				PostgresSnapshotType, RedisSnapshotType, e.SnapshotType)
 		}
 
 		// Get the CR
-		err = client.Get(context.TODO(), types.NamespacedName{
+		err := client.Get(context.TODO(), types.NamespacedName{
 			Name:      snapshotName,
 			Namespace: e.SnapshotNamespace,
 		}, queryCR)
 		if err != nil {
-			return fmt.Errorf("Error occurred querying snapshot for backup %s", e.ResourceName)
+			return false, fmt.Errorf("Error occurred querying snapshot for backup %s", e.ResourceName)
 		}
 
 		// Get the phase
@@ -123,14 +157,13 @@ func (e *AWSBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.
 
 		// If the snapshot failed, return an error with the message
 		if phase == crotypes.PhaseFailed {
-			return fmt.Errorf("Snapshot failed: %s", message)
-		}
-
-		// If it's complete, break the loop
-		if phase == crotypes.PhaseComplete {
-			break
+			return false, fmt.Errorf("Snapshot failed: %s", message)
 		}
+		completed = completed && phase == crotypes.PhaseComplete
 	}
+	return completed, nil
+}
 
-	return nil
+func (e *AWSBackupExecutor) ownsBackup(backup string) bool {
+	return strings.HasPrefix(backup, e.ResourceName+preUpgradeSnapshotInfix)
 }
@@ -58,10 +58,21 @@ func TestAWSSnapshotPostgres(t *testing.T) {
 		client.Status().Update(context.TODO(), postgresSnapshot)
 	}()
 
-	err = executor.PerformBackup(client, time.Second*10)
+	backups, err := executor.PerformBackup(client, time.Second*10)
 	if err != nil {
 		t.Errorf("Unexpected error performing postgres backup: %w", err)
 	}
+	if len(backups) != 1 || !strings.HasPrefix(backups[0], fmt.Sprintf("%s-preupgrade-snapshot", resourceName)) {
+		t.Errorf("Expected the name of the snapshot to be returned, got %v", backups)
+	}
+
+	snapshot := &v1alpha1.PostgresSnapshot{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: backups[0], Namespace: namespace}, snapshot); err != nil {
+		t.Fatalf("Unexpected error getting the snapshot: %v", err)
+	}
+	if snapshot.Labels[BackupOriginLabel] != PreUpgradeBackupOrigin {
+		t.Errorf("Expected the snapshot to be labelled as taken before an upgrade, got %v", snapshot.Labels)
+	}
 }
 
 // TestAWSSnapshotRedis tests that the AWSBackupExecutor succesfully creates
@@ -78,6 +89,7 @@ func TestAWSSnapshotRedis(t *testing.T) {
 
 	client := fake.NewFakeClientWithScheme(scheme)
 	executor := NewAWSBackupExecutor(namespace, resourceName, RedisSnapshotType)
+	SetBackupOrigin(NewConcurrentBackupExecutor(executor), OnDemandBackupOrigin)
 
 	go func() {
 		var redisSnapshot *v1alpha1.RedisSnapshot
@@ -106,10 +118,21 @@ func TestAWSSnapshotRedis(t *testing.T) {
 		client.Status().Update(context.TODO(), redisSnapshot)
 	}()
 
-	err = executor.PerformBackup(client, time.Second*10)
+	backups, err := executor.PerformBackup(client, time.Second*10)
 	if err != nil {
 		t.Errorf("Unexpected error performing postgres backup: %w", err)
 	}
+	if len(backups) != 1 || !strings.HasPrefix(backups[0], fmt.Sprintf("%s-preupgrade-snapshot", resourceName)) {
+		t.Errorf("Expected the name of the snapshot to be returned, got %v", backups)
+	}
+
+	snapshot := &v1alpha1.RedisSnapshot{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: backups[0], Namespace: namespace}, snapshot); err != nil {
+		t.Fatalf("Unexpected error getting the snapshot: %v", err)
+	}
+	if snapshot.Labels[BackupOriginLabel] != OnDemandBackupOrigin {
+		t.Errorf("Expected the origin set on the concurrent executor to label the snapshot, got %v", snapshot.Labels)
+	}
 }
 
 // TestAWSSnapshotPostgres_FailedJob tests that the AWSBackupExecutor returns
@@ -156,7 +179,7 @@ func TestAWSSnapshotPostgres_FailedJob(t *testing.T) {
 		client.Status().Update(context.TODO(), postgresSnapshot)
 	}()
 
-	err = executor.PerformBackup(client, time.Second*10)
+	_, err = executor.PerformBackup(client, time.Second*10)
 	if err == nil {
 		t.Fatal("Expected error when performing fail backup")
 		return
@@ -212,7 +235,7 @@ func TestAWSSnapshotRedis_FailedJob(t *testing.T) {
 		client.Status().Update(context.TODO(), redisSnapshot)
 	}()
 
-	err = executor.PerformBackup(client, time.Second*10)
+	_, err = executor.PerformBackup(client, time.Second*10)
 	if err == nil {
 		t.Fatal("Expected error when performing fail backup")
 		return
@@ -1,7 +1,9 @@
 package backup
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -11,9 +13,40 @@ import (
 )
 
 // BackupExecutor knows how to perform backups and wait for their successful
-// completion
+// completion. The names of the created backups are returned so they can be
+// recorded in the upgrade history
 type BackupExecutor interface {
-	PerformBackup(client k8sclient.Client, timeout time.Duration) error
+	PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error)
+}
+
+// AsyncBackupExecutor starts backups without waiting for their completion, which is checked later on with the names
+// of the started backups, so that a controller does not block while the backups run
+type AsyncBackupExecutor interface {
+	BackupExecutor
+	// StartBackup starts the backups and returns their names
+	StartBackup(client k8sclient.Client) ([]string, error)
+	// CheckBackup returns whether the started backups completed, or an error when one of them failed
+	CheckBackup(client k8sclient.Client, backups []string) (bool, error)
+}
+
+// backupOwner is implemented by the executors that can tell the backups they started from the backups of other
+// executors, so that the concurrent executor checks each backup with the executor that started it
+type backupOwner interface {
+	ownsBackup(backup string) bool
+}
+
+// waitForBackups checks the started backups until they complete or the timeout is reached
+func waitForBackups(client k8sclient.Client, executor AsyncBackupExecutor, backups []string, timeout time.Duration) error {
+	started := time.Now()
+	for {
+		if time.Now().After(started.Add(timeout)) {
+			return fmt.Errorf("timed out waiting for backups %v", backups)
+		}
+		completed, err := executor.CheckBackup(client, backups)
+		if err != nil || completed {
+			return err
+		}
+	}
 }
 
 // NoopBackupExecutor does nothing. For components that do not require backups
@@ -24,9 +57,73 @@ func NewNoopBackupExecutor() BackupExecutor {
 }
 
 // PerformBackup simply returns a `nil` error
-func (e *NoopBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) error {
+func (e *NoopBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error) {
 	logrus.Infof("No backup to perform")
-	return nil
+	return nil, nil
+}
+
+// StartBackup starts no backup
+func (e *NoopBackupExecutor) StartBackup(client k8sclient.Client) ([]string, error) {
+	return nil, nil
+}
+
+// CheckBackup returns that there is nothing left to back up
+func (e *NoopBackupExecutor) CheckBackup(client k8sclient.Client, backups []string) (bool, error) {
+	return true, nil
+}
+
+func (e *NoopBackupExecutor) ownsBackup(backup string) bool {
+	return false
+}
+
+// ErrBackupUnsupported is returned by the backups of components whose data can not be backed up
+var ErrBackupUnsupported = errors.New("backup is not supported")
+
+// ClusterStorageUnsupportedReason is the reason the data of the products on cluster storage is not backed up, there
+// are no snapshots of cluster storage
+const ClusterStorageUnsupportedReason = "the data of the product is on cluster storage"
+
+// UnsupportedBackupExecutor fails the backups of components whose data can not be backed up, such as the products
+// on cluster storage, so that they are not reported as backed up
+type UnsupportedBackupExecutor struct {
+	Reason string
+}
+
+func NewUnsupportedBackupExecutor(reason string) BackupExecutor {
+	return &UnsupportedBackupExecutor{
+		Reason: reason,
+	}
+}
+
+// PerformBackup returns an error wrapping ErrBackupUnsupported
+func (e *UnsupportedBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error) {
+	return nil, fmt.Errorf("%w: %s", ErrBackupUnsupported, e.Reason)
+}
+
+// StartBackup returns an error wrapping ErrBackupUnsupported
+func (e *UnsupportedBackupExecutor) StartBackup(client k8sclient.Client) ([]string, error) {
+	return nil, fmt.Errorf("%w: %s", ErrBackupUnsupported, e.Reason)
+}
+
+// CheckBackup returns an error wrapping ErrBackupUnsupported
+func (e *UnsupportedBackupExecutor) CheckBackup(client k8sclient.Client, backups []string) (bool, error) {
+	return false, fmt.Errorf("%w: %s", ErrBackupUnsupported, e.Reason)
+}
+
+func (e *UnsupportedBackupExecutor) ownsBackup(backup string) bool {
+	return false
+}
+
+// SetBackupOrigin sets the origin of the snapshots created by the executor, and by the executors it delegates to
+func SetBackupOrigin(executor BackupExecutor, origin string) {
+	switch e := executor.(type) {
+	case *AWSBackupExecutor:
+		e.Origin = origin
+	case *ConcurrentBackupExecutor:
+		for _, each := range e.Executors {
+			SetBackupOrigin(each, origin)
+		}
+	}
 }
 
 // ConcurrentBackupExecutor performs backups by delegating the operation into
@@ -42,10 +139,12 @@ func NewConcurrentBackupExecutor(executors ...BackupExecutor) BackupExecutor {
 	}
 }
 
-func (e *ConcurrentBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) error {
+func (e *ConcurrentBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error) {
 	logrus.Infof("Concurrently performing %d backups", len(e.Executors))
 
 	var g errgroup.Group
+	var lock sync.Mutex
+	backups := []string{}
 
 	for _, backup := range e.Executors {
 		// We need to re-assign the BackupExecutor instance in the scope of the
@@ -53,13 +152,70 @@ func (e *ConcurrentBackupExecutor) PerformBackup(client k8sclient.Client, timeou
 		// the value pointed by the `backup` variable will have changed
 		each := backup
 		g.Go(func() error {
-			return each.PerformBackup(client, timeout)
+			names, err := each.PerformBackup(client, timeout)
+			lock.Lock()
+			defer lock.Unlock()
+			backups = append(backups, names...)
+			return err
 		})
 	}
 
 	if err := g.Wait(); err != nil {
-		return fmt.Errorf("Error occurred when performing concurrent backups: %v", err)
+		return backups, fmt.Errorf("Error occurred when performing concurrent backups: %v", err)
+	}
+
+	return backups, nil
+}
+
+// StartBackup starts the backups of every executor, it fails when one of the executors can not start its backups
+// without waiting for them
+func (e *ConcurrentBackupExecutor) StartBackup(client k8sclient.Client) ([]string, error) {
+	backups := []string{}
+	for _, executor := range e.Executors {
+		asyncExecutor, ok := executor.(AsyncBackupExecutor)
+		if !ok {
+			return backups, fmt.Errorf("backup executor %T can not start backups without waiting for them", executor)
+		}
+		names, err := asyncExecutor.StartBackup(client)
+		backups = append(backups, names...)
+		if err != nil {
+			return backups, err
+		}
 	}
+	return backups, nil
+}
 
-	return nil
+// CheckBackup checks the backups started by each executor, and returns whether all of them completed
+func (e *ConcurrentBackupExecutor) CheckBackup(client k8sclient.Client, backups []string) (bool, error) {
+	completed := true
+	for _, executor := range e.Executors {
+		asyncExecutor, ok := executor.(AsyncBackupExecutor)
+		if !ok {
+			return false, fmt.Errorf("backup executor %T can not check backups without waiting for them", executor)
+		}
+		owned := backups
+		if owner, ok := executor.(backupOwner); ok {
+			owned = []string{}
+			for _, backup := range backups {
+				if owner.ownsBackup(backup) {
+					owned = append(owned, backup)
+				}
+			}
+		}
+		executorCompleted, err := asyncExecutor.CheckBackup(client, owned)
+		if err != nil {
+			return false, err
+		}
+		completed = completed && executorCompleted
+	}
+	return completed, nil
+}
+
+func (e *ConcurrentBackupExecutor) ownsBackup(backup string) bool {
+	for _, executor := range e.Executors {
+		if owner, ok := executor.(backupOwner); ok && owner.ownsBackup(backup) {
+			return true
+		}
+	}
+	return false
 }
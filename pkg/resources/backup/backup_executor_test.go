@@ -2,6 +2,8 @@ package backup
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -17,17 +19,17 @@ func TestConcurrentBackup(t *testing.T) {
 	// 7 concurrent backups that take 1 second each. Should still take approximately
 	// 1 second as they're concurrent
 	executor := NewConcurrentBackupExecutor(
-		mockBackupExecutor{1 * time.Second},
-		mockBackupExecutor{1 * time.Second},
-		mockBackupExecutor{1 * time.Second},
-		mockBackupExecutor{1 * time.Second},
-		mockBackupExecutor{1 * time.Second},
-		mockBackupExecutor{1 * time.Second},
-		mockBackupExecutor{1 * time.Second},
+		mockBackupExecutor{"backup-1", 1 * time.Second},
+		mockBackupExecutor{"backup-2", 1 * time.Second},
+		mockBackupExecutor{"backup-3", 1 * time.Second},
+		mockBackupExecutor{"backup-4", 1 * time.Second},
+		mockBackupExecutor{"backup-5", 1 * time.Second},
+		mockBackupExecutor{"backup-6", 1 * time.Second},
+		mockBackupExecutor{"backup-7", 1 * time.Second},
 	)
 
 	timeStarted := time.Now()
-	err := executor.PerformBackup(client, time.Second*3)
+	backups, err := executor.PerformBackup(client, time.Second*3)
 	timeFinished := time.Now()
 
 	if err != nil {
@@ -40,16 +42,23 @@ func TestConcurrentBackup(t *testing.T) {
 	if elapsed > time.Second*3 {
 		t.Errorf("Concurrent backups took too long: %v", elapsed)
 	}
+
+	sort.Strings(backups)
+	expectedBackups := []string{"backup-1", "backup-2", "backup-3", "backup-4", "backup-5", "backup-6", "backup-7"}
+	if !reflect.DeepEqual(backups, expectedBackups) {
+		t.Errorf("Expected the names of all the backups, got %v", backups)
+	}
 }
 
 type mockBackupExecutor struct {
+	Name      string
 	SleepTime time.Duration
 }
 
-func (e mockBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) error {
+func (e mockBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error) {
 	if e.SleepTime > timeout {
-		return fmt.Errorf("SleepTime %v for mock is greater than given timeout %v", e.SleepTime, timeout)
+		return nil, fmt.Errorf("SleepTime %v for mock is greater than given timeout %v", e.SleepTime, timeout)
 	}
 	time.Sleep(e.SleepTime)
-	return nil
+	return []string{e.Name}, nil
 }
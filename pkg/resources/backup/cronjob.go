@@ -3,6 +3,7 @@ package backup
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -30,7 +31,19 @@ func NewCronJobBackupExecutor(cronJobName, namespace, jobGenerateName string) Ba
 	}
 }
 
-func (e *CronJobBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) error {
+func (e *CronJobBackupExecutor) PerformBackup(client k8sclient.Client, timeout time.Duration) ([]string, error) {
+	backups, err := e.StartBackup(client)
+	if err != nil {
+		return nil, err
+	}
+	if err := waitForBackups(client, e, backups, timeout); err != nil {
+		return nil, err
+	}
+	return backups, nil
+}
+
+// StartBackup creates a Job from the CronJob and returns its name
+func (e *CronJobBackupExecutor) StartBackup(client k8sclient.Client) ([]string, error) {
 	logrus.Infof("Performing backup by creating Job from CronJob %s in namespace %s", e.CronJobName, e.Namespace)
 
 	// Generate the job name
@@ -43,7 +56,7 @@ func (e *CronJobBackupExecutor) PerformBackup(client k8sclient.Client, timeout t
 		Namespace: e.Namespace,
 	}, cronJob)
 	if err != nil {
-		return fmt.Errorf("Error obtaining CronJob %s in namespace %s: %v", e.CronJobName, e.Namespace, err)
+		return nil, fmt.Errorf("Error obtaining CronJob %s in namespace %s: %v", e.CronJobName, e.Namespace, err)
 	}
 
 	// Create the Job based on the CronJob spec
@@ -56,33 +69,35 @@ func (e *CronJobBackupExecutor) PerformBackup(client k8sclient.Client, timeout t
 		Spec: jobTemplate.Spec,
 	}
 	if err := client.Create(context.TODO(), job); err != nil {
-		return fmt.Errorf("Error creating Job from CronJob %s in namespace %s: %v",
+		return nil, fmt.Errorf("Error creating Job from CronJob %s in namespace %s: %v",
 			e.CronJobName, e.Namespace, err)
 	}
+	return []string{jobName}, nil
+}
 
-	// Query the newly created job until either it finishes, or it times out
-	timeStarted := time.Now()
-	for {
-		if time.Now().After(timeStarted.Add(timeout)) {
-			return fmt.Errorf("Timed out when waiting for Job %s to finish", "")
-		}
-
+// CheckBackup returns whether the Jobs completed, or the error of a Job that failed
+func (e *CronJobBackupExecutor) CheckBackup(client k8sclient.Client, backups []string) (bool, error) {
+	completed := true
+	for _, jobName := range backups {
 		queryJob := &batchv1.Job{}
-		err = client.Get(context.TODO(), types.NamespacedName{Name: jobName, Namespace: e.Namespace}, queryJob)
+		err := client.Get(context.TODO(), types.NamespacedName{Name: jobName, Namespace: e.Namespace}, queryJob)
 		if err != nil {
-			return fmt.Errorf("Error querying newly created Job %s in namespace %s: %v", "", e.Namespace, err)
-		}
-
-		// If the completion time field is set, the job finished succesfully
-		if queryJob.Status.CompletionTime != nil {
-			return nil
+			return false, fmt.Errorf("Error querying Job %s in namespace %s: %v", jobName, e.Namespace, err)
 		}
 
 		// Check if the job finished with errors, if it did, return the error
 		if err := getJobError(queryJob); err != nil {
-			return fmt.Errorf("Error performing backup job: %w", err)
+			return false, fmt.Errorf("Error performing backup job: %w", err)
 		}
+
+		// If the completion time field is set, the job finished succesfully
+		completed = completed && queryJob.Status.CompletionTime != nil
 	}
+	return completed, nil
+}
+
+func (e *CronJobBackupExecutor) ownsBackup(backup string) bool {
+	return strings.HasPrefix(backup, e.JobGenerateName+"-")
 }
 
 func getJobError(job *batchv1.Job) error {
@@ -74,10 +74,13 @@ func TestCronJob(t *testing.T) {
 	}()
 
 	// Call `PerformBackup` and assert that no error is returned
-	err := executor.PerformBackup(client, time.Second*10)
+	backups, err := executor.PerformBackup(client, time.Second*10)
 	if err != nil {
 		t.Errorf("Unexpected error running backup from CronJob: %w", err)
 	}
+	if len(backups) != 1 || !strings.HasPrefix(backups[0], generateJobName) {
+		t.Errorf("Expected the name of the job to be returned, got %v", backups)
+	}
 }
 
 func TestCronJob_NoCronJob(t *testing.T) {
@@ -90,7 +93,7 @@ func TestCronJob_NoCronJob(t *testing.T) {
 	client := createMockClientForCronJob(t)
 	executor := NewCronJobBackupExecutor(cronJobName, namespace, generateJobName)
 
-	err := executor.PerformBackup(client, time.Second*1)
+	_, err := executor.PerformBackup(client, time.Second*1)
 	if err == nil {
 		t.Errorf("Expected backup to fail as no CronJob is found")
 	}
@@ -161,7 +164,7 @@ func TestCronJob_FailedJob(t *testing.T) {
 	}()
 
 	// Call `PerformBackup` and assert that no error is returned
-	err := executor.PerformBackup(client, time.Second*10)
+	_, err := executor.PerformBackup(client, time.Second*10)
 	if err == nil {
 		t.Error("Expected backup to fail as Job failed")
 	}
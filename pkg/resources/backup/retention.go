@@ -0,0 +1,179 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/apis/integreatly/v1alpha1"
+	crotypes "github.com/integr8ly/cloud-resource-operator/pkg/apis/integreatly/v1alpha1/types"
+	"github.com/sirupsen/logrus"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RetentionPolicy defines the pre-upgrade snapshots kept for each resource. A zero value of a field disables it
+type RetentionPolicy struct {
+	// KeepLast is the number of snapshots kept for each resource
+	KeepLast int
+	// OlderThan is the age of the snapshots removed
+	OlderThan time.Duration
+}
+
+// PruneResult counts the pre-upgrade snapshots of a resource kept and removed by the pruner. The snapshots whose
+// deletion waits for the removal of the AWS snapshot are pending, they are neither retained nor pruned
+type PruneResult struct {
+	SnapshotType AWSSnapshotType
+	ResourceName string
+	Retained     int
+	Pruned       int
+	Pending      int
+}
+
+type snapshot struct {
+	object       runtime.Object
+	name         string
+	snapshotType AWSSnapshotType
+	resourceName string
+	createdAt    time.Time
+	deleting     bool
+	phase        crotypes.StatusPhase
+}
+
+// PruneSnapshots removes the pre-upgrade snapshots of the namespace that are not retained by the policy. The most
+// recent complete snapshot of each resource and the snapshots in progress are never removed. Snapshots created by
+// other means than the pre-upgrade backups, such as the RHMIBackup resources, are ignored
+func PruneSnapshots(ctx context.Context, client k8sclient.Client, namespace string, policy RetentionPolicy, now time.Time) ([]PruneResult, error) {
+	snapshots, err := listPreUpgradeSnapshots(ctx, client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	byResource := map[string][]snapshot{}
+	keys := []string{}
+	for _, s := range snapshots {
+		key := fmt.Sprintf("%s/%s", s.snapshotType, s.resourceName)
+		if _, ok := byResource[key]; !ok {
+			keys = append(keys, key)
+		}
+		byResource[key] = append(byResource[key], s)
+	}
+	sort.Strings(keys)
+
+	results := make([]PruneResult, 0, len(keys))
+	for _, key := range keys {
+		result := PruneResult{SnapshotType: byResource[key][0].snapshotType, ResourceName: byResource[key][0].resourceName}
+		resourceSnapshots := []snapshot{}
+		for _, s := range byResource[key] {
+			if s.deleting {
+				result.Pending++
+				continue
+			}
+			resourceSnapshots = append(resourceSnapshots, s)
+		}
+
+		for _, s := range expiredSnapshots(resourceSnapshots, policy, now) {
+			logrus.Infof("Removing %s %s, it is not retained by the backup retention policy", s.snapshotType, s.name)
+			err := client.Delete(ctx, s.object)
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete %s %s: %w", s.snapshotType, s.name, err)
+			}
+			// the deletion of a snapshot with a finalizer completes once the AWS snapshot is removed
+			err = client.Get(ctx, k8sclient.ObjectKey{Name: s.name, Namespace: namespace}, s.object)
+			if k8serr.IsNotFound(err) {
+				result.Pruned++
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get %s %s: %w", s.snapshotType, s.name, err)
+			}
+			result.Pending++
+		}
+		result.Retained = len(byResource[key]) - result.Pruned - result.Pending
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// expiredSnapshots returns the snapshots of a resource not retained by the policy
+func expiredSnapshots(snapshots []snapshot, policy RetentionPolicy, now time.Time) []snapshot {
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].createdAt.After(snapshots[j].createdAt) })
+
+	latestComplete := -1
+	for i, s := range snapshots {
+		if s.phase == crotypes.PhaseComplete {
+			latestComplete = i
+			break
+		}
+	}
+
+	expired := []snapshot{}
+	for i, s := range snapshots {
+		if i == latestComplete || (s.phase != crotypes.PhaseComplete && s.phase != crotypes.PhaseFailed) {
+			continue
+		}
+		if (policy.KeepLast > 0 && i >= policy.KeepLast) || (policy.OlderThan > 0 && now.Sub(s.createdAt) > policy.OlderThan) {
+			expired = append(expired, s)
+		}
+	}
+	return expired
+}
+
+func listPreUpgradeSnapshots(ctx context.Context, client k8sclient.Client, namespace string) ([]snapshot, error) {
+	snapshots := []snapshot{}
+
+	postgresSnapshots := &v1alpha1.PostgresSnapshotList{}
+	if err := client.List(ctx, postgresSnapshots, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", PostgresSnapshotType, err)
+	}
+	for i := range postgresSnapshots.Items {
+		s := &postgresSnapshots.Items[i]
+		if isPreUpgradeSnapshot(s.Labels, s.Name, s.Spec.ResourceName) {
+			snapshots = append(snapshots, snapshot{
+				object:       s,
+				name:         s.Name,
+				snapshotType: PostgresSnapshotType,
+				resourceName: s.Spec.ResourceName,
+				createdAt:    s.CreationTimestamp.Time,
+				deleting:     s.DeletionTimestamp != nil,
+				phase:        s.Status.Phase,
+			})
+		}
+	}
+
+	redisSnapshots := &v1alpha1.RedisSnapshotList{}
+	if err := client.List(ctx, redisSnapshots, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", RedisSnapshotType, err)
+	}
+	for i := range redisSnapshots.Items {
+		s := &redisSnapshots.Items[i]
+		if isPreUpgradeSnapshot(s.Labels, s.Name, s.Spec.ResourceName) {
+			snapshots = append(snapshots, snapshot{
+				object:       s,
+				name:         s.Name,
+				snapshotType: RedisSnapshotType,
+				resourceName: s.Spec.ResourceName,
+				createdAt:    s.CreationTimestamp.Time,
+				deleting:     s.DeletionTimestamp != nil,
+				phase:        s.Status.Phase,
+			})
+		}
+	}
+
+	return snapshots, nil
+}
+
+// isPreUpgradeSnapshot checks the origin label of the snapshot. The snapshots created before the origin was labelled
+// were all taken before upgrades, they are recognised by their name
+func isPreUpgradeSnapshot(labels map[string]string, name, resourceName string) bool {
+	if origin, ok := labels[BackupOriginLabel]; ok {
+		return origin == PreUpgradeBackupOrigin
+	}
+	return strings.HasPrefix(name, resourceName+preUpgradeSnapshotInfix)
+}
@@ -0,0 +1,166 @@
+package backup
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/apis/integreatly/v1alpha1"
+	crotypes "github.com/integr8ly/cloud-resource-operator/pkg/apis/integreatly/v1alpha1/types"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func postgresSnapshot(name string, age time.Duration, phase crotypes.StatusPhase, now time.Time) *v1alpha1.PostgresSnapshot {
+	return &v1alpha1.PostgresSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "redhat-rhmi-operator",
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		},
+		Spec:   v1alpha1.PostgresSnapshotSpec{ResourceName: "threescale-postgres-rhmi"},
+		Status: v1alpha1.PostgresSnapshotStatus{Phase: phase},
+	}
+}
+
+func withOrigin(s *v1alpha1.PostgresSnapshot, origin string) *v1alpha1.PostgresSnapshot {
+	s.Labels = map[string]string{BackupOriginLabel: origin}
+	return s
+}
+
+// finalizedClient keeps the deleted snapshots, as the finalizer of the cloud resource operator does until the AWS
+// snapshot is removed
+type finalizedClient struct {
+	k8sclient.Client
+	deleteErr error
+}
+
+func (c *finalizedClient) Delete(ctx context.Context, obj runtime.Object, opts ...k8sclient.DeleteOption) error {
+	return c.deleteErr
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	now := time.Now()
+	day := 24 * time.Hour
+
+	cases := []struct {
+		Name           string
+		Policy         RetentionPolicy
+		Snapshots      []runtime.Object
+		ExpectRetained []string
+	}{
+		{
+			Name:   "test snapshots over the number kept are removed",
+			Policy: RetentionPolicy{KeepLast: 2},
+			Snapshots: []runtime.Object{
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-1", 3*day, crotypes.PhaseComplete, now),
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-2", 2*day, crotypes.PhaseComplete, now),
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-3", day, crotypes.PhaseComplete, now),
+			},
+			ExpectRetained: []string{"threescale-postgres-rhmi-preupgrade-snapshot-2", "threescale-postgres-rhmi-preupgrade-snapshot-3"},
+		},
+		{
+			Name:   "test most recent complete snapshot is never removed",
+			Policy: RetentionPolicy{KeepLast: 1, OlderThan: day},
+			Snapshots: []runtime.Object{
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-1", 3*day, crotypes.PhaseComplete, now),
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-2", 2*day, crotypes.PhaseComplete, now),
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-3", time.Hour, crotypes.PhaseFailed, now),
+			},
+			ExpectRetained: []string{"threescale-postgres-rhmi-preupgrade-snapshot-2", "threescale-postgres-rhmi-preupgrade-snapshot-3"},
+		},
+		{
+			Name:   "test snapshots in progress and not created before upgrades are kept",
+			Policy: RetentionPolicy{OlderThan: day},
+			Snapshots: []runtime.Object{
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-1", 3*day, crotypes.PhaseInProgress, now),
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-2", 2*day, crotypes.PhaseComplete, now),
+				postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-3", 2*day-time.Hour, crotypes.PhaseComplete, now),
+				postgresSnapshot("threescale-postgres-manual", 5*day, crotypes.PhaseComplete, now),
+			},
+			ExpectRetained: []string{"threescale-postgres-manual", "threescale-postgres-rhmi-preupgrade-snapshot-1", "threescale-postgres-rhmi-preupgrade-snapshot-3"},
+		},
+		{
+			Name:   "test snapshots of on-demand backups are kept",
+			Policy: RetentionPolicy{KeepLast: 1},
+			Snapshots: []runtime.Object{
+				withOrigin(postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-1", 3*day, crotypes.PhaseComplete, now), OnDemandBackupOrigin),
+				withOrigin(postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-2", 2*day, crotypes.PhaseComplete, now), PreUpgradeBackupOrigin),
+				withOrigin(postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-3", day, crotypes.PhaseComplete, now), PreUpgradeBackupOrigin),
+			},
+			ExpectRetained: []string{"threescale-postgres-rhmi-preupgrade-snapshot-1", "threescale-postgres-rhmi-preupgrade-snapshot-3"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = v1alpha1.SchemeBuilder.AddToScheme(scheme)
+			client := fake.NewFakeClientWithScheme(scheme, tc.Snapshots...)
+
+			results, err := PruneSnapshots(context.TODO(), client, "redhat-rhmi-operator", tc.Policy, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			snapshots := &v1alpha1.PostgresSnapshotList{}
+			if err := client.List(context.TODO(), snapshots, k8sclient.InNamespace("redhat-rhmi-operator")); err != nil {
+				t.Fatalf("failed to list snapshots: %v", err)
+			}
+			retained := []string{}
+			for _, s := range snapshots.Items {
+				retained = append(retained, s.Name)
+			}
+			sort.Strings(retained)
+			if len(retained) != len(tc.ExpectRetained) {
+				t.Fatalf("expected snapshots %v to be retained, got %v", tc.ExpectRetained, retained)
+			}
+			for i := range retained {
+				if retained[i] != tc.ExpectRetained[i] {
+					t.Fatalf("expected snapshots %v to be retained, got %v", tc.ExpectRetained, retained)
+				}
+			}
+
+			if len(results) != 1 || results[0].Pruned != len(tc.Snapshots)-len(tc.ExpectRetained) {
+				t.Fatalf("expected the pruned snapshots to be counted, got %v", results)
+			}
+		})
+	}
+}
+
+func TestPruneSnapshotsPending(t *testing.T) {
+	now := time.Now()
+	day := 24 * time.Hour
+
+	deleting := postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-1", 4*day, crotypes.PhaseComplete, now)
+	deletionTimestamp := metav1.NewTime(now)
+	deleting.DeletionTimestamp = &deletionTimestamp
+	scheme := runtime.NewScheme()
+	_ = v1alpha1.SchemeBuilder.AddToScheme(scheme)
+	client := &finalizedClient{Client: fake.NewFakeClientWithScheme(scheme,
+		deleting,
+		postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-2", 3*day, crotypes.PhaseComplete, now),
+		postgresSnapshot("threescale-postgres-rhmi-preupgrade-snapshot-3", 2*day, crotypes.PhaseComplete, now),
+	)}
+
+	results, err := PruneSnapshots(context.TODO(), client, "redhat-rhmi-operator", RetentionPolicy{KeepLast: 1}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || results[0].Pruned != 0 || results[0].Pending != 2 || results[0].Retained != 1 {
+		t.Fatalf("expected the snapshots waiting for their finalizer to be pending, got %v", results)
+	}
+
+	client.deleteErr = k8serr.NewNotFound(v1alpha1.SchemeGroupVersion.WithResource("postgressnapshots").GroupResource(), "threescale-postgres-rhmi-preupgrade-snapshot-2")
+	results, err = PruneSnapshots(context.TODO(), client, "redhat-rhmi-operator", RetentionPolicy{KeepLast: 1}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || results[0].Pruned != 0 {
+		t.Fatalf("expected the snapshots removed by others not to be counted, got %v", results)
+	}
+}
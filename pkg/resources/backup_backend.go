@@ -0,0 +1,203 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	productsConfig "github.com/integr8ly/integreatly-operator/pkg/config"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	backupPVCName       = "rhmi-backups"
+	backupPVCVolumeName = "backups"
+	backupPVCMountPath  = "/var/lib/rhmi-backups"
+
+	backupCAVolumeName = "backend-ca"
+	backupCAMountPath  = "/var/run/rhmi-backups"
+	// backupCAKey is the field of the backend secrets with the CA of an S3 compatible endpoint
+	backupCAKey = "ca.crt"
+)
+
+// BackupBackend stores the backups of the backup CronJobs
+type BackupBackend interface {
+	// ReconcileStorage creates the objects the backup jobs of the config need to store the backups, and returns the
+	// storage the backup containers access
+	ReconcileStorage(ctx context.Context, serverClient k8sclient.Client, config BackupConfig) (BackupStorage, error)
+}
+
+// BackupStorage is the storage reconciled by a backend, as accessed by the backup containers
+type BackupStorage interface {
+	// Name is the storage backend of the backup container
+	Name() string
+	// ConfigurePod sets the volumes and the environment the backup containers of a component need to access the
+	// storage
+	ConfigurePod(config BackupConfig, component BackupComponent, podSpec *corev1.PodSpec)
+}
+
+// NewBackupBackend returns the storage backend of the backup configuration. The S3 credentials are read from the
+// secret of the backup configuration, or from the secret of the bucket provisioned by the cloud resource operator
+func NewBackupBackend(backupConfig *productsConfig.Backup, configManager productsConfig.ConfigReadWriter) (BackupBackend, error) {
+	switch backupConfig.GetBackend() {
+	case productsConfig.BackupBackendS3:
+		secretName := backupConfig.GetBackendSecretName()
+		if secretName == "" {
+			secretName = configManager.GetBackupsSecretName()
+		}
+		return &s3BackupBackend{
+			sourceSecret: BackupSecretLocation{Name: secretName, Namespace: configManager.GetOperatorNamespace()},
+			endpoint:     backupConfig.GetS3Endpoint(),
+		}, nil
+	case productsConfig.BackupBackendPVC:
+		if !backupConfig.SupportsLocalBackend() {
+			return nil, fmt.Errorf("backup image %s does not support the local backend of the %s backend", backupConfig.GetImage(), productsConfig.BackupBackendPVC)
+		}
+		size, err := resource.ParseQuantity(backupConfig.GetPVCSize())
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %s of the backup persistent volume claim: %w", backupConfig.GetPVCSize(), err)
+		}
+		return &pvcBackupBackend{size: size, storageClass: backupConfig.GetPVCStorageClass()}, nil
+	}
+	return nil, fmt.Errorf("unsupported backup backend %s, expected %s or %s", backupConfig.GetBackend(), productsConfig.BackupBackendS3, productsConfig.BackupBackendPVC)
+}
+
+// s3BackupBackend stores the backups in an AWS S3 bucket, or in a bucket of an S3 compatible endpoint such as MinIO
+type s3BackupBackend struct {
+	sourceSecret BackupSecretLocation
+	endpoint     string
+}
+
+func (b *s3BackupBackend) ReconcileStorage(ctx context.Context, serverClient k8sclient.Client, config BackupConfig) (BackupStorage, error) {
+	sourceSecret := &corev1.Secret{}
+	err := serverClient.Get(ctx, k8sclient.ObjectKey{Namespace: b.sourceSecret.Namespace, Name: b.sourceSecret.Name}, sourceSecret)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get secret that contains S3 credentials for backup CronJobs - %s Secret from %s namespace: %w", b.sourceSecret.Name, b.sourceSecret.Namespace, err)
+	}
+
+	destinationSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.BackendSecret.Name,
+			Namespace: config.BackendSecret.Namespace,
+		},
+	}
+	_, hasCA := sourceSecret.Data[backupCAKey]
+	or, err := controllerutil.CreateOrUpdate(ctx, serverClient, destinationSecret, func() error {
+		// Transforming from Secret field names of CRO to the names consumed by our scripts:
+		// https://github.com/integr8ly/backup-container-image/blob/master/image/tools/lib/backend/s3.sh#L10-L20
+		destinationSecret.Data = map[string][]byte{
+			"AWS_ACCESS_KEY_ID":     sourceSecret.Data["credentialKeyID"],
+			"AWS_SECRET_ACCESS_KEY": sourceSecret.Data["credentialSecretKey"],
+			"AWS_S3_BUCKET_NAME":    sourceSecret.Data["bucketName"],
+			"AWS_S3_REGION":         sourceSecret.Data["bucketRegion"],
+		}
+		// S3 compatible endpoints may be served with a certificate signed by a custom CA, set in the ca.crt field of
+		// the source secret. The CA is mounted as a file in the backup containers by ConfigurePod
+		if b.endpoint != "" {
+			destinationSecret.Data["AWS_S3_ENDPOINT_URL"] = []byte(b.endpoint)
+		}
+		if hasCA {
+			destinationSecret.Data[backupCAKey] = sourceSecret.Data[backupCAKey]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not %s backup Secret %s in %s namespace: %w", or, destinationSecret.Name, destinationSecret.Namespace, err)
+	}
+
+	return &s3BackupStorage{hasCA: hasCA}, nil
+}
+
+// s3BackupStorage is the bucket of the S3 backend, hasCA is set when the backend secret has the CA of the endpoint
+type s3BackupStorage struct {
+	hasCA bool
+}
+
+func (s *s3BackupStorage) Name() string {
+	return "s3"
+}
+
+// ConfigurePod mounts the CA of the endpoint, the AWS CLI of the backup container reads it from the file set in
+// AWS_CA_BUNDLE
+func (s *s3BackupStorage) ConfigurePod(config BackupConfig, component BackupComponent, podSpec *corev1.PodSpec) {
+	if !s.hasCA {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: backupCAVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: config.BackendSecret.Name,
+				Items:      []corev1.KeyToPath{{Key: backupCAKey, Path: backupCAKey}},
+			},
+		},
+	})
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: backupCAVolumeName, MountPath: backupCAMountPath, ReadOnly: true})
+		container.Env = append(container.Env, corev1.EnvVar{Name: "AWS_CA_BUNDLE", Value: backupCAMountPath + "/" + backupCAKey})
+	}
+}
+
+// pvcBackupBackend stores the backups in persistent volume claims of the namespace of the backups, for clusters
+// without an object storage. Each component has its own claim, as the claims can only be mounted by the pods of a
+// single node and the backup jobs of the components may be scheduled on different nodes
+type pvcBackupBackend struct {
+	size         resource.Quantity
+	storageClass string
+}
+
+func (b *pvcBackupBackend) ReconcileStorage(ctx context.Context, serverClient k8sclient.Client, config BackupConfig) (BackupStorage, error) {
+	for _, component := range config.Components {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      getBackupPVCName(component),
+				Namespace: config.Namespace,
+			},
+		}
+		or, err := controllerutil.CreateOrUpdate(ctx, serverClient, pvc, func() error {
+			// the spec of a bound claim is immutable, apart from its size
+			if pvc.CreationTimestamp.IsZero() {
+				pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+				if b.storageClass != "" {
+					pvc.Spec.StorageClassName = &b.storageClass
+				}
+			}
+			pvc.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: b.size}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Could not %s backup PersistentVolumeClaim %s in %s namespace: %w", or, pvc.Name, pvc.Namespace, err)
+		}
+	}
+	return &pvcBackupStorage{}, nil
+}
+
+// pvcBackupStorage is the claims of the pvc backend, which the backup container writes to through its local backend
+type pvcBackupStorage struct{}
+
+func (s *pvcBackupStorage) Name() string {
+	return "local"
+}
+
+func (s *pvcBackupStorage) ConfigurePod(config BackupConfig, component BackupComponent, podSpec *corev1.PodSpec) {
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: backupPVCVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: getBackupPVCName(component)},
+		},
+	})
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: backupPVCVolumeName, MountPath: backupPVCMountPath})
+		container.Env = append(container.Env, corev1.EnvVar{Name: "BACKUP_DIRECTORY", Value: backupPVCMountPath})
+	}
+}
+
+func getBackupPVCName(component BackupComponent) string {
+	return backupPVCName + "-" + component.Name
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	productsConfig "github.com/integr8ly/integreatly-operator/pkg/config"
+
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetBackupRestoreJob returns the job restoring the backups taken by the backup CronJobs of the components. A container
+// per component runs the backup container of its CronJob in restore mode, with the environment and volumes of the
+// CronJob. The backup image must support restores, which the default image does not
+func GetBackupRestoreJob(ctx context.Context, serverClient k8sclient.Client, configManager productsConfig.ConfigReadWriter, namespace string, components ...string) (*batchv1.JobSpec, error) {
+	backupConfig, err := configManager.ReadBackup()
+	if err != nil {
+		return nil, fmt.Errorf("could not read backup config: %w", err)
+	}
+	if !backupConfig.SupportsRestore() {
+		return nil, fmt.Errorf("backup image %s does not support restores", backupConfig.GetImage())
+	}
+
+	// a failed restore is reported, not retried on a product that may be partially restored
+	backoffLimit := int32(0)
+	job := &batchv1.JobSpec{BackoffLimit: &backoffLimit}
+	for i, component := range components {
+		cronjob := &batchv1beta1.CronJob{}
+		if err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: component, Namespace: namespace}, cronjob); err != nil {
+			return nil, fmt.Errorf("error getting backup job %s: %w", component, err)
+		}
+		podSpec := cronjob.Spec.JobTemplate.Spec.Template.Spec.DeepCopy()
+		if len(podSpec.Containers) != 1 {
+			return nil, fmt.Errorf("expected a container in backup job %s, found %d", component, len(podSpec.Containers))
+		}
+
+		// the volumes of each component are renamed, the components may use the same volume names for other claims
+		container := podSpec.Containers[0]
+		container.Name = component
+		container.Command = append(container.Command, "-m", "restore")
+		for j := range container.VolumeMounts {
+			container.VolumeMounts[j].Name = fmt.Sprintf("%s-%d", container.VolumeMounts[j].Name, i)
+		}
+		for _, volume := range podSpec.Volumes {
+			volume.Name = fmt.Sprintf("%s-%d", volume.Name, i)
+			job.Template.Spec.Volumes = append(job.Template.Spec.Volumes, volume)
+		}
+
+		job.Template.Labels = map[string]string{"integreatly": "yes"}
+		job.Template.Spec.ServiceAccountName = podSpec.ServiceAccountName
+		job.Template.Spec.Containers = append(job.Template.Spec.Containers, container)
+	}
+	job.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	return job, nil
+}
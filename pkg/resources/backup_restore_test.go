@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetBackupRestoreJob(t *testing.T) {
+	backupConfig := BackupConfig{
+		Name:      "test-backups",
+		Namespace: "backups",
+		Components: []BackupComponent{
+			{Name: "postgres-backup", Schedule: "3 20 * * *", Type: "postgres"},
+			{Name: "pv-backup", Schedule: "3 20 * * *", Type: "pv"},
+		},
+		BackendSecret: BackupSecretLocation{Name: "backend-secret", Namespace: "backups"},
+	}
+	client := basicClient()
+	configManager := getMockConfigManager()
+	configManager.ReadBackupFunc = func() (*config.Backup, error) {
+		return config.NewBackup(config.ProductConfig{"BACKEND": config.BackupBackendPVC, "LOCAL_BACKEND_SUPPORTED": "true"}), nil
+	}
+	if err := ReconcileBackup(context.TODO(), client, backupConfig, configManager); err != nil {
+		t.Fatalf("expected no error, but got: %v", err)
+	}
+
+	if _, err := GetBackupRestoreJob(context.TODO(), client, configManager, "backups", "postgres-backup", "pv-backup"); err == nil {
+		t.Fatal("expected an error when the backup image does not support restores")
+	}
+
+	configManager.ReadBackupFunc = func() (*config.Backup, error) {
+		return config.NewBackup(config.ProductConfig{"BACKEND": config.BackupBackendPVC, "LOCAL_BACKEND_SUPPORTED": "true", "RESTORE_SUPPORTED": "true"}), nil
+	}
+	job, err := GetBackupRestoreJob(context.TODO(), client, configManager, "backups", "postgres-backup", "pv-backup")
+	if err != nil {
+		t.Fatalf("expected no error, but got: %v", err)
+	}
+
+	podSpec := job.Template.Spec
+	if podSpec.RestartPolicy != corev1.RestartPolicyNever || podSpec.ServiceAccountName != BackupServiceAccountName || *job.BackoffLimit != 0 {
+		t.Fatalf("expected a job run once with the backup service account, got %v", job)
+	}
+	if len(podSpec.Containers) != 2 || podSpec.Containers[0].Name != "postgres-backup" || podSpec.Containers[1].Name != "pv-backup" {
+		t.Fatalf("expected a container per component, got %v", podSpec.Containers)
+	}
+	volumes := map[string]bool{}
+	for _, volume := range podSpec.Volumes {
+		if volumes[volume.Name] {
+			t.Fatalf("expected the volumes of the components to have distinct names, got %v", podSpec.Volumes)
+		}
+		volumes[volume.Name] = true
+	}
+	for _, container := range podSpec.Containers {
+		command := container.Command
+		if command[len(command)-2] != "-m" || command[len(command)-1] != "restore" {
+			t.Fatalf("expected the backup container to run in restore mode, got %v", command)
+		}
+		for _, mount := range container.VolumeMounts {
+			if !volumes[mount.Name] {
+				t.Fatalf("expected the volume %s mounted by %s to be declared, got %v", mount.Name, container.Name, podSpec.Volumes)
+			}
+		}
+	}
+
+	if _, err := GetBackupRestoreJob(context.TODO(), client, configManager, "backups", "missing-backup"); err == nil {
+		t.Fatal("expected an error when the backup job of a component does not exist")
+	}
+}
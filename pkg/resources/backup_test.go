@@ -110,6 +110,126 @@ func TestBackups(t *testing.T) {
 	}
 }
 
+func TestBackupBackends(t *testing.T) {
+	backupConfig := BackupConfig{
+		Name:      "test-backups",
+		Namespace: "backups",
+		Components: []BackupComponent{
+			{
+				Name:     "component",
+				Schedule: "3 20 * * *",
+				Type:     "test",
+			},
+		},
+		BackendSecret: BackupSecretLocation{Name: "backend-secret", Namespace: "backups"},
+	}
+
+	encryptionSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpg-key", Namespace: "integreatly-operator"},
+		Data:       map[string][]byte{"GPG_PUBLIC_KEY": []byte("key"), "GPG_RECIPIENT": []byte("backups@example.com")},
+	}
+	minioSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "minio-credentials", Namespace: "integreatly-operator"},
+		Data:       map[string][]byte{"credentialKeyID": []byte("minio"), "bucketName": []byte("backups"), "ca.crt": []byte("ca")},
+	}
+
+	scenarios := []struct {
+		Name       string
+		Config     config.ProductConfig
+		Validation func(client k8sclient.Client, t *testing.T)
+	}{
+		{
+			Name:   "test s3 compatible backend with a custom CA",
+			Config: config.ProductConfig{"BACKEND_SECRET_NAME": "minio-credentials", "S3_ENDPOINT": "https://minio.example.com", "IMAGE": "registry.example.com/backup-container:1.0.16"},
+			Validation: func(client k8sclient.Client, t *testing.T) {
+				secret := &corev1.Secret{}
+				if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "backend-secret", Namespace: "backups"}, secret); err != nil {
+					t.Fatalf("expected the backend secret to be created: %v", err)
+				}
+				if string(secret.Data["AWS_S3_ENDPOINT_URL"]) != "https://minio.example.com" || string(secret.Data["ca.crt"]) != "ca" {
+					t.Fatalf("expected the endpoint and the CA to be set in the backend secret, got %v", secret.Data)
+				}
+				container := getBackupContainer(client, t)
+				if len(container.VolumeMounts) != 1 || container.VolumeMounts[0].MountPath != backupCAMountPath {
+					t.Fatalf("expected the CA to be mounted, got %v", container.VolumeMounts)
+				}
+				caBundle := ""
+				for _, env := range container.Env {
+					if env.Name == "AWS_CA_BUNDLE" {
+						caBundle = env.Value
+					}
+				}
+				if caBundle != backupCAMountPath+"/ca.crt" {
+					t.Fatalf("expected the CA bundle to be the path of the mounted CA, got %s", caBundle)
+				}
+				if container.Image != "registry.example.com/backup-container:1.0.16" {
+					t.Fatalf("expected the configured image, got %s", container.Image)
+				}
+				if container.Command[4] != "s3" || container.Command[6] != "" {
+					t.Fatalf("expected unencrypted backups to s3, got %v", container.Command)
+				}
+			},
+		},
+		{
+			Name:   "test pvc backend with encryption",
+			Config: config.ProductConfig{"BACKEND": config.BackupBackendPVC, "LOCAL_BACKEND_SUPPORTED": "true", "PVC_SIZE": "5Gi", "ENCRYPTION_SECRET_NAME": "gpg-key"},
+			Validation: func(client k8sclient.Client, t *testing.T) {
+				pvc := &corev1.PersistentVolumeClaim{}
+				if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "rhmi-backups-component", Namespace: "backups"}, pvc); err != nil {
+					t.Fatalf("expected the backup claim to be created: %v", err)
+				}
+				if size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; size.String() != "5Gi" {
+					t.Fatalf("expected a claim of 5Gi, got %s", size.String())
+				}
+				secret := &corev1.Secret{}
+				if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: BackupEncryptionSecretName, Namespace: "backups"}, secret); err != nil {
+					t.Fatalf("expected the encryption secret to be copied: %v", err)
+				}
+				container := getBackupContainer(client, t)
+				if container.Command[4] != "local" || container.Command[6] != "gpg" {
+					t.Fatalf("expected encrypted backups to the local backend, got %v", container.Command)
+				}
+				if len(container.VolumeMounts) != 1 || container.VolumeMounts[0].MountPath != backupPVCMountPath {
+					t.Fatalf("expected the backup claim to be mounted, got %v", container.VolumeMounts)
+				}
+			},
+		},
+	}
+
+	t.Run("test pvc backend without local backend support", func(t *testing.T) {
+		configManager := getMockConfigManager()
+		configManager.ReadBackupFunc = func() (*config.Backup, error) {
+			return config.NewBackup(config.ProductConfig{"BACKEND": config.BackupBackendPVC}), nil
+		}
+		if err := ReconcileBackup(context.TODO(), basicClient(), backupConfig, configManager); err == nil {
+			t.Fatal("expected an error when the backup image does not support the local backend")
+		}
+	})
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			client := basicClient(encryptionSecret.DeepCopy(), minioSecret.DeepCopy())
+			configManager := getMockConfigManager()
+			configManager.ReadBackupFunc = func() (*config.Backup, error) {
+				return config.NewBackup(scenario.Config), nil
+			}
+
+			if err := ReconcileBackup(context.TODO(), client, backupConfig, configManager); err != nil {
+				t.Fatalf("expected no error, but got: %v", err)
+			}
+			scenario.Validation(client, t)
+		})
+	}
+}
+
+func getBackupContainer(client k8sclient.Client, t *testing.T) corev1.Container {
+	cronjob := &batchv1beta1.CronJob{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "component", Namespace: "backups"}, cronjob); err != nil {
+		t.Fatalf("expected the backup cronjob to be created: %v", err)
+	}
+	return cronjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+}
+
 func getMockConfigManager() *config.ConfigReadWriterMock {
 	return &config.ConfigReadWriterMock{
 		GetOperatorNamespaceFunc: func() string {
@@ -118,6 +238,9 @@ func getMockConfigManager() *config.ConfigReadWriterMock {
 		GetBackupsSecretNameFunc: func() string {
 			return "backups-s3-credentials"
 		},
+		ReadBackupFunc: func() (*config.Backup, error) {
+			return config.NewBackup(config.ProductConfig{}), nil
+		},
 	}
 }
 
@@ -0,0 +1,399 @@
+package resources
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	productsConfig "github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/metrics"
+	"github.com/sirupsen/logrus"
+
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// backupVerificationLabel is set on the jobs of the verification CronJobs, with the name of the component verified
+	backupVerificationLabel = "backup-verification"
+
+	backupVerificationVolumeName = "verify"
+	backupVerificationMountPath  = "/var/run/verify"
+	backupVerificationDoneFile   = backupVerificationMountPath + "/done"
+	// backupVerificationScratchDir is where the backups of the components other than postgres are restored to
+	backupVerificationScratchDir = backupVerificationMountPath + "/scratch"
+
+	// backupLastVerifiedAnnotation is set on the verification CronJobs with the time the backups were last verified
+	// successfully, as the jobs the time is read from are garbage collected
+	backupLastVerifiedAnnotation = "integreatly.org/backup-last-verified"
+
+	backupVerificationDatabaseUser = "verify"
+	backupVerificationDatabaseName = "verify"
+	backupVerificationPasswordKey  = "password"
+
+	// BackupVerificationStaleSeconds is the age of the last successful verification of a backup after which the
+	// verification is reported as stale
+	BackupVerificationStaleSeconds = 60 * 60 * 48
+)
+
+var (
+	// BackupVerificationServiceAccountName is the service account of the verification jobs, which can only read the
+	// secrets of the backups so that restoring a backup can not change the product
+	BackupVerificationServiceAccountName = "rhmi-backup-verify"
+	BackupVerificationRoleName           = "rhmi-backup-verify"
+	BackupVerificationRoleBindingName    = "rhmi-backup-verify"
+)
+
+func getVerificationCronjobName(component BackupComponent) string {
+	return component.Name + "-verify"
+}
+
+func getVerificationSecretName(component BackupComponent) string {
+	return component.Name + "-verify"
+}
+
+// getVerifiableComponents returns a copy of the components without their verification schedules when the backup image
+// does not support restores, the verification jobs restore the latest backup with the restore mode of the image
+func getVerifiableComponents(components []BackupComponent, backupConfig *productsConfig.Backup) []BackupComponent {
+	verifiable := make([]BackupComponent, len(components))
+	copy(verifiable, components)
+	if backupConfig.SupportsRestore() {
+		return verifiable
+	}
+	for i := range verifiable {
+		if verifiable[i].VerificationSchedule != "" {
+			logrus.Warnf("Backups of %s are not verified, backup image %s does not support restores", verifiable[i].Name, backupConfig.GetImage())
+			verifiable[i].VerificationSchedule = ""
+		}
+	}
+	return verifiable
+}
+
+// reconcileVerificationServiceAccount creates the service account of the verification jobs, with read access to the
+// secrets of the backups only. The verification jobs do not run as the service account of the backup jobs, which can
+// exec into the pods of the product
+func reconcileVerificationServiceAccount(ctx context.Context, serverClient k8sclient.Client, config BackupConfig) error {
+	secretNames := []string{}
+	for _, secret := range []BackupSecretLocation{config.BackendSecret, config.EncryptionSecret} {
+		if secret.Name != "" && secret.Namespace == config.Namespace {
+			secretNames = append(secretNames, secret.Name)
+		}
+	}
+	for _, component := range config.Components {
+		if component.Secret.Name != "" && component.Secret.Namespace == config.Namespace {
+			secretNames = append(secretNames, component.Secret.Name)
+		}
+	}
+
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackupVerificationRoleName,
+			Namespace: config.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, serverClient, role, func() error {
+		// a rule without resource names would grant access to all the secrets of the namespace
+		role.Rules = nil
+		if len(secretNames) == 0 {
+			return nil
+		}
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{""},
+				Resources:     []string{"secrets"},
+				ResourceNames: secretNames,
+				Verbs:         []string{"get"},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reconciling backup verification role: %w", err)
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackupVerificationServiceAccountName,
+			Namespace: config.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, serverClient, serviceAccount, func() error {
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reconciling backup verification service account: %w", err)
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BackupVerificationRoleBindingName,
+			Namespace: config.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, serverClient, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			Name: BackupVerificationRoleName,
+			Kind: "Role",
+		}
+		roleBinding.Subjects = []rbacv1.Subject{
+			{
+				Name:      BackupVerificationServiceAccountName,
+				Kind:      "ServiceAccount",
+				Namespace: config.Namespace,
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reconciling backup verification role binding: %w", err)
+	}
+	return nil
+}
+
+// reconcileVerificationSecret creates the secret with the password of the scratch database of a component, the
+// password is generated once and kept
+func reconcileVerificationSecret(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, component BackupComponent) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getVerificationSecretName(component),
+			Namespace: config.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, serverClient, secret, func() error {
+		if len(secret.Data[backupVerificationPasswordKey]) > 0 {
+			return nil
+		}
+		password := make([]byte, 16)
+		if _, err := rand.Read(password); err != nil {
+			return fmt.Errorf("error generating the scratch database password: %w", err)
+		}
+		secret.Data = map[string][]byte{backupVerificationPasswordKey: []byte(hex.EncodeToString(password))}
+		return nil
+	})
+	return err
+}
+
+// reconcileVerificationCronjob creates the CronJob verifying that the latest backup of the component can be restored,
+// or removes it when the component has no verification schedule. Postgres backups are restored into a scratch database
+// running in the pod of the job, and the backups of the other components into a scratch directory of the pod, both
+// torn down with the pod once the verification completed. Nothing is restored into the namespace of the product
+func reconcileVerificationCronjob(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, component BackupComponent, storage BackupStorage, backupConfig *productsConfig.Backup) error {
+	cronjob := &batchv1beta1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getVerificationCronjobName(component),
+			Namespace: config.Namespace,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getVerificationSecretName(component),
+			Namespace: config.Namespace,
+		},
+	}
+
+	if component.VerificationSchedule == "" {
+		if err := serverClient.Delete(ctx, cronjob); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("error removing backup verification job %s: %w", cronjob.Name, err)
+		}
+		if err := serverClient.Delete(ctx, secret); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("error removing backup verification secret %s: %w", secret.Name, err)
+		}
+		return nil
+	}
+
+	if component.Type == "postgres" {
+		if err := reconcileVerificationSecret(ctx, serverClient, config, component); err != nil {
+			return fmt.Errorf("error reconciling backup verification secret %s: %w", secret.Name, err)
+		}
+	}
+	passwordEnv := &corev1.EnvVarSource{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+			Key:                  backupVerificationPasswordKey,
+		},
+	}
+
+	monitoringConfig := productsConfig.NewMonitoring(productsConfig.ProductConfig{})
+	encryption := ""
+	if config.EncryptionSecret.Name != "" {
+		encryption = "gpg"
+	}
+	backoffLimit := int32(0)
+
+	_, err := controllerutil.CreateOrUpdate(ctx, serverClient, cronjob, func() error {
+		cronjob.Labels = map[string]string{"integreatly": "yes", monitoringConfig.GetLabelSelectorKey(): monitoringConfig.GetLabelSelector()}
+
+		containers := []corev1.Container{
+			{
+				Name:            "backup-verify",
+				Image:           backupConfig.GetImage(),
+				ImagePullPolicy: "Always",
+				// the scratch database stops once the done file is written, whatever the outcome of the verification
+				Command: []string{
+					"/bin/sh",
+					"-c",
+					fmt.Sprintf("/opt/intly/tools/entrypoint.sh -c %s -b %s -e '%s' -m verify; status=$?; touch %s; exit $status",
+						component.Type, storage.Name(), encryption, backupVerificationDoneFile),
+				},
+				Env:          append(getBackupEnv(config, component), corev1.EnvVar{Name: "SCRATCH_DIRECTORY", Value: backupVerificationScratchDir}),
+				VolumeMounts: []corev1.VolumeMount{{Name: backupVerificationVolumeName, MountPath: backupVerificationMountPath}},
+			},
+		}
+		if component.Type == "postgres" {
+			containers[0].Env = append(containers[0].Env,
+				corev1.EnvVar{Name: "SCRATCH_DATABASE_HOST", Value: "localhost"},
+				corev1.EnvVar{Name: "SCRATCH_DATABASE_USER", Value: backupVerificationDatabaseUser},
+				corev1.EnvVar{Name: "SCRATCH_DATABASE_PASSWORD", ValueFrom: passwordEnv},
+				corev1.EnvVar{Name: "SCRATCH_DATABASE_NAME", Value: backupVerificationDatabaseName},
+			)
+			containers = append(containers, corev1.Container{
+				Name:  "scratch-database",
+				Image: backupConfig.GetVerificationDatabaseImage(),
+				Command: []string{
+					"/bin/sh",
+					"-c",
+					fmt.Sprintf("run-postgresql & while [ ! -f %s ]; do sleep 5; done", backupVerificationDoneFile),
+				},
+				Env: []corev1.EnvVar{
+					{Name: "POSTGRESQL_USER", Value: backupVerificationDatabaseUser},
+					{Name: "POSTGRESQL_PASSWORD", ValueFrom: passwordEnv},
+					{Name: "POSTGRESQL_DATABASE", Value: backupVerificationDatabaseName},
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: backupVerificationVolumeName, MountPath: backupVerificationMountPath}},
+			})
+		}
+
+		cronjob.Spec = batchv1beta1.CronJobSpec{
+			Schedule:          component.VerificationSchedule,
+			ConcurrencyPolicy: "Forbid",
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{backupVerificationLabel: component.Name},
+				},
+				Spec: batchv1.JobSpec{
+					// a failed verification is reported, not retried
+					BackoffLimit: &backoffLimit,
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   config.Name,
+							Labels: map[string]string{"integreatly": "yes", "cronjob-name": cronjob.Name},
+						},
+						Spec: corev1.PodSpec{
+							ServiceAccountName: BackupVerificationServiceAccountName,
+							RestartPolicy:      corev1.RestartPolicyNever,
+							Containers:         containers,
+							Volumes: []corev1.Volume{
+								{
+									Name:         backupVerificationVolumeName,
+									VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		storage.ConfigurePod(config, component, &cronjob.Spec.JobTemplate.Spec.Template.Spec)
+		return nil
+	})
+	return err
+}
+
+// observeBackupVerifications exposes the outcome of the latest verification of the backups of each component. The time
+// of the last successful verification is kept on the verification CronJob, so that it is still exposed after the
+// operator restarted or the verification jobs were garbage collected
+func observeBackupVerifications(ctx context.Context, serverClient k8sclient.Client, config BackupConfig) error {
+	for _, component := range config.Components {
+		if component.VerificationSchedule == "" {
+			continue
+		}
+
+		jobs := &batchv1.JobList{}
+		err := serverClient.List(ctx, jobs, k8sclient.InNamespace(config.Namespace), k8sclient.MatchingLabels{backupVerificationLabel: component.Name})
+		if err != nil {
+			return fmt.Errorf("error listing backup verification jobs of %s: %w", component.Name, err)
+		}
+
+		var lastFinished, lastSucceeded *metav1.Time
+		succeeded := false
+		for _, job := range jobs.Items {
+			finishedAt, jobSucceeded := getJobOutcome(job)
+			if finishedAt == nil {
+				continue
+			}
+			if lastFinished == nil || finishedAt.After(lastFinished.Time) {
+				lastFinished = finishedAt
+				succeeded = jobSucceeded
+			}
+			if jobSucceeded && (lastSucceeded == nil || finishedAt.After(lastSucceeded.Time)) {
+				lastSucceeded = finishedAt
+			}
+		}
+
+		if lastFinished != nil {
+			metrics.SetBackupVerification(config.Namespace, component.Name, succeeded)
+		}
+
+		lastVerified, err := persistLastVerified(ctx, serverClient, config, component, lastSucceeded)
+		if err != nil {
+			return err
+		}
+		if lastVerified != nil {
+			metrics.SetBackupLastVerified(config.Namespace, component.Name, *lastVerified)
+		}
+	}
+	return nil
+}
+
+// persistLastVerified stores the time of the last successful verification of the component on its verification
+// CronJob when it is later than the stored one, and returns the latest of both
+func persistLastVerified(ctx context.Context, serverClient k8sclient.Client, config BackupConfig, component BackupComponent, lastSucceeded *metav1.Time) (*time.Time, error) {
+	cronjob := &batchv1beta1.CronJob{}
+	err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: getVerificationCronjobName(component), Namespace: config.Namespace}, cronjob)
+	if err != nil {
+		return nil, fmt.Errorf("error getting backup verification job of %s: %w", component.Name, err)
+	}
+
+	var lastVerified *time.Time
+	if stored, ok := cronjob.Annotations[backupLastVerifiedAnnotation]; ok {
+		storedTime, err := time.Parse(time.RFC3339, stored)
+		if err != nil {
+			logrus.Warnf("Ignoring invalid %s annotation of %s: %v", backupLastVerifiedAnnotation, cronjob.Name, err)
+		} else {
+			lastVerified = &storedTime
+		}
+	}
+	if lastSucceeded == nil || (lastVerified != nil && !lastSucceeded.Time.After(*lastVerified)) {
+		return lastVerified, nil
+	}
+
+	if cronjob.Annotations == nil {
+		cronjob.Annotations = map[string]string{}
+	}
+	cronjob.Annotations[backupLastVerifiedAnnotation] = lastSucceeded.UTC().Format(time.RFC3339)
+	if err := serverClient.Update(ctx, cronjob); err != nil {
+		return nil, fmt.Errorf("error storing the last verification time of %s: %w", component.Name, err)
+	}
+	return &lastSucceeded.Time, nil
+}
+
+// getJobOutcome returns when a job finished and if it succeeded, or nil if it has not finished
+func getJobOutcome(job batchv1.Job) (*metav1.Time, bool) {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime, true
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return &condition.LastTransitionTime, false
+		}
+	}
+	return nil, false
+}
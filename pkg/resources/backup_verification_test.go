@@ -0,0 +1,197 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/integr8ly/integreatly-operator/pkg/config"
+	"github.com/integr8ly/integreatly-operator/pkg/metrics"
+	dto "github.com/prometheus/client_model/go"
+
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func verificationJob(name string, component string, finishedAt time.Time, succeeded bool) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "backups",
+			Labels:    map[string]string{backupVerificationLabel: component},
+		},
+	}
+	if succeeded {
+		completionTime := metav1.NewTime(finishedAt)
+		job.Status.CompletionTime = &completionTime
+	} else {
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(finishedAt)},
+		}
+	}
+	return job
+}
+
+func getGaugeValue(t *testing.T, gauge interface{ Write(*dto.Metric) error }) float64 {
+	metric := &dto.Metric{}
+	if err := gauge.Write(metric); err != nil {
+		t.Fatalf("failed to read metric: %v", err)
+	}
+	return metric.GetGauge().GetValue()
+}
+
+func TestBackupVerification(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	backupConfig := BackupConfig{
+		Name:          "test-backups",
+		Namespace:     "backups",
+		BackendSecret: BackupSecretLocation{Name: "backend-secret", Namespace: "backups"},
+		Components: []BackupComponent{
+			{
+				Name:                 "postgres-backup",
+				Type:                 "postgres",
+				Schedule:             "30 2 * * *",
+				VerificationSchedule: "30 4 * * *",
+			},
+			{
+				Name:                 "pv-backup",
+				Type:                 "codeready_pv",
+				Schedule:             "30 2 * * *",
+				VerificationSchedule: "30 4 * * *",
+			},
+			{
+				Name:     "resources-backup",
+				Type:     "amq_online_resources",
+				Schedule: "30 2 * * *",
+			},
+		},
+	}
+
+	client := basicClient(
+		backupsSecretMock(),
+		&batchv1beta1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "resources-backup-verify", Namespace: "backups"}},
+		verificationJob("postgres-backup-verify-1", "postgres-backup", now.Add(-2*time.Hour), true),
+		verificationJob("postgres-backup-verify-2", "postgres-backup", now.Add(-time.Hour), false),
+	)
+	configManager := getMockConfigManager()
+	configManager.ReadBackupFunc = func() (*config.Backup, error) {
+		return config.NewBackup(config.ProductConfig{"RESTORE_SUPPORTED": "true"}), nil
+	}
+
+	if err := ReconcileBackup(context.TODO(), client, backupConfig, configManager); err != nil {
+		t.Fatalf("expected no error, but got: %v", err)
+	}
+
+	cronjob := &batchv1beta1.CronJob{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "postgres-backup-verify", Namespace: "backups"}, cronjob); err != nil {
+		t.Fatalf("expected the verification cronjob to be created: %v", err)
+	}
+	if cronjob.Spec.Schedule != "30 4 * * *" {
+		t.Fatalf("expected the verification schedule, got %s", cronjob.Spec.Schedule)
+	}
+	if cronjob.Spec.JobTemplate.Spec.Template.Spec.ServiceAccountName != BackupVerificationServiceAccountName {
+		t.Fatalf("expected the verification to run as %s, got %s", BackupVerificationServiceAccountName, cronjob.Spec.JobTemplate.Spec.Template.Spec.ServiceAccountName)
+	}
+	containers := cronjob.Spec.JobTemplate.Spec.Template.Spec.Containers
+	if len(containers) != 2 || containers[1].Image != config.DefaultBackupVerificationDatabaseImage {
+		t.Fatalf("expected the postgres backup to be restored into a scratch database, got %v", containers)
+	}
+	for _, env := range append(containers[0].Env, containers[1].Env...) {
+		if strings.HasSuffix(env.Name, "_PASSWORD") && (env.ValueFrom == nil || env.ValueFrom.SecretKeyRef.Name != "postgres-backup-verify") {
+			t.Fatalf("expected the scratch database password to be read from its secret, got %v", env)
+		}
+	}
+	secret := &corev1.Secret{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "postgres-backup-verify", Namespace: "backups"}, secret); err != nil {
+		t.Fatalf("expected the scratch database secret to be created: %v", err)
+	}
+	password := string(secret.Data[backupVerificationPasswordKey])
+	if len(password) != 32 {
+		t.Fatalf("expected a generated scratch database password, got %q", password)
+	}
+
+	role := &rbacv1.Role{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: BackupVerificationRoleName, Namespace: "backups"}, role); err != nil {
+		t.Fatalf("expected the verification role to be created: %v", err)
+	}
+	if len(role.Rules) != 1 || len(role.Rules[0].ResourceNames) == 0 || role.Rules[0].Resources[0] != "secrets" {
+		t.Fatalf("expected the verification role to only read the secrets of the backups, got %v", role.Rules)
+	}
+
+	pvCronjob := &batchv1beta1.CronJob{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "pv-backup-verify", Namespace: "backups"}, pvCronjob); err != nil {
+		t.Fatalf("expected the verification cronjob of the pv backups to be created: %v", err)
+	}
+	pvContainers := pvCronjob.Spec.JobTemplate.Spec.Template.Spec.Containers
+	scratchDir := false
+	for _, env := range pvContainers[0].Env {
+		scratchDir = scratchDir || env.Name == "SCRATCH_DIRECTORY" && env.Value == backupVerificationScratchDir
+	}
+	if len(pvContainers) != 1 || !scratchDir {
+		t.Fatalf("expected the pv backup to be restored into a scratch directory, got %v", pvContainers)
+	}
+
+	err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "resources-backup-verify", Namespace: "backups"}, &batchv1beta1.CronJob{})
+	if err == nil {
+		t.Fatalf("expected the verification cronjob of a component without verification schedule to be removed")
+	}
+
+	if value := getGaugeValue(t, metrics.BackupVerificationSuccess.WithLabelValues("backups", "postgres-backup")); value != 0 {
+		t.Fatalf("expected the failed verification to be reported, got %v", value)
+	}
+	if value := getGaugeValue(t, metrics.BackupLastVerified.WithLabelValues("backups", "postgres-backup")); value != float64(now.Add(-2*time.Hour).Unix()) {
+		t.Fatalf("expected the time of the last successful verification, got %v", value)
+	}
+
+	rule := &monitoringv1.PrometheusRule{}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "backupjobs-exist-alerts", Namespace: "backups"}, rule); err != nil {
+		t.Fatalf("expected the backup alerts to be created: %v", err)
+	}
+	staleAlert := false
+	for _, alert := range rule.Spec.Groups[0].Rules {
+		if alert.Alert == "BackupVerificationStale_backups_postgres-backup" {
+			staleAlert = !strings.Contains(alert.Expr.String(), "absent(")
+		}
+	}
+	if !staleAlert {
+		t.Fatalf("expected the stale alert not to fire for a missing timestamp, got %v", rule.Spec.Groups[0].Rules)
+	}
+
+	// the time of the last verification is kept once the jobs are garbage collected and the operator restarted
+	for _, name := range []string{"postgres-backup-verify-1", "postgres-backup-verify-2"} {
+		if err := client.Delete(context.TODO(), &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "backups"}}); err != nil {
+			t.Fatalf("failed to delete job: %v", err)
+		}
+	}
+	metrics.BackupLastVerified.Reset()
+	if err := ReconcileBackup(context.TODO(), client, backupConfig, configManager); err != nil {
+		t.Fatalf("expected no error, but got: %v", err)
+	}
+	if value := getGaugeValue(t, metrics.BackupLastVerified.WithLabelValues("backups", "postgres-backup")); value != float64(now.Add(-2*time.Hour).Unix()) {
+		t.Fatalf("expected the stored time of the last successful verification, got %v", value)
+	}
+	if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: "postgres-backup-verify", Namespace: "backups"}, secret); err != nil || string(secret.Data[backupVerificationPasswordKey]) != password {
+		t.Fatalf("expected the scratch database password to be kept: %v", err)
+	}
+
+	// the verification is removed when the backup image can not restore the backups
+	configManager.ReadBackupFunc = func() (*config.Backup, error) {
+		return config.NewBackup(config.ProductConfig{}), nil
+	}
+	if err := ReconcileBackup(context.TODO(), client, backupConfig, configManager); err != nil {
+		t.Fatalf("expected no error, but got: %v", err)
+	}
+	err = client.Get(context.TODO(), k8sclient.ObjectKey{Name: "postgres-backup-verify", Namespace: "backups"}, &batchv1beta1.CronJob{})
+	if err == nil {
+		t.Fatalf("expected the verification cronjob to be removed when the backup image does not support restores")
+	}
+	if backupConfig.Components[0].VerificationSchedule == "" {
+		t.Fatalf("expected the verification schedule of the backup config not to be changed")
+	}
+}
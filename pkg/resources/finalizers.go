@@ -16,6 +16,11 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// GetProductFinalizer returns the name of the finalizer a product reconciler adds to the installation
+func GetProductFinalizer(productName string) string {
+	return "finalizer." + productName + ".integreatly.org"
+}
+
 // AddFinalizer adds a finalizer to the custom resource. This allows us to clean up oauth clients
 // and other cluster level objects owned by the installation before the cr is deleted
 func AddFinalizer(ctx context.Context, inst *integreatlyv1alpha1.RHMI, client k8sclient.Client, finalizer string) error {
@@ -0,0 +1,767 @@
+package grafana
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultDatasource is the prometheus datasource of the monitoring stack
+const DefaultDatasource = "Prometheus"
+
+const (
+	// gridWidth is the number of columns of the grid grafana lays panels out on
+	gridWidth = 24
+
+	defaultRefresh  = "1m"
+	defaultTimeFrom = "now-1h"
+	schemaVersion   = 18
+)
+
+// Dashboard is a grafana dashboard made of rows of panels. Panels are laid out from left to right, each panel
+// placed as high as it fits, so that ids and positions don't need to be maintained by hand
+type Dashboard struct {
+	Title   string
+	UID     string
+	Tags    []string
+	Refresh string
+	// From is the start of the time range of the dashboard, such as now-7d
+	From        string
+	Annotations []*Annotation
+	Variables   []*Variable
+	Rows        []*Row
+}
+
+// Annotation marks the times a prometheus query has values at on the graphs of a dashboard
+type Annotation struct {
+	Name string
+	Expr string
+	// TagKeys are the labels of the series of the query shown as the tags of the annotation
+	TagKeys   []string
+	IconColor string
+}
+
+// NewAnnotation returns an annotation of the series of the query, tagged with their labels
+func NewAnnotation(name, expr string, tagKeys ...string) *Annotation {
+	return &Annotation{Name: name, Expr: expr, TagKeys: tagKeys, IconColor: "#FADE2A"}
+}
+
+func (a *Annotation) toJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"datasource":      DefaultDatasource,
+		"enable":          true,
+		"expr":            a.Expr,
+		"hide":            false,
+		"iconColor":       a.IconColor,
+		"limit":           100,
+		"name":            a.Name,
+		"showIn":          0,
+		"step":            "",
+		"tagKeys":         strings.Join(a.TagKeys, ","),
+		"tags":            "",
+		"titleFormat":     a.Name,
+		"type":            "tags",
+		"useValueForTime": false,
+	}
+}
+
+// NewDashboard returns an empty dashboard refreshed every minute and showing the last hour
+func NewDashboard(title string) *Dashboard {
+	return &Dashboard{
+		Title:   title,
+		Refresh: defaultRefresh,
+		From:    defaultTimeFrom,
+	}
+}
+
+// AddAnnotations adds annotations to the graphs of the dashboard
+func (d *Dashboard) AddAnnotations(annotations ...*Annotation) *Dashboard {
+	d.Annotations = append(d.Annotations, annotations...)
+	return d
+}
+
+// AddVariables adds templated variables to the dashboard
+func (d *Dashboard) AddVariables(variables ...*Variable) *Dashboard {
+	d.Variables = append(d.Variables, variables...)
+	return d
+}
+
+// AddRows adds rows at the bottom of the dashboard
+func (d *Dashboard) AddRows(rows ...*Row) *Dashboard {
+	d.Rows = append(d.Rows, rows...)
+	return d
+}
+
+// JSON returns the dashboard in the format of the json field of GrafanaDashboard resources
+func (d *Dashboard) JSON() (string, error) {
+	if err := d.validate(); err != nil {
+		return "", err
+	}
+
+	templating := make([]map[string]interface{}, 0, len(d.Variables))
+	for _, variable := range d.Variables {
+		templating = append(templating, variable.toJSON())
+	}
+	tags := d.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	annotations := []map[string]interface{}{{
+		"builtIn":    1,
+		"datasource": "-- Grafana --",
+		"enable":     true,
+		"hide":       true,
+		"iconColor":  "rgba(0, 211, 255, 1)",
+		"name":       "Annotations & Alerts",
+		"type":       "dashboard",
+	}}
+	for _, annotation := range d.Annotations {
+		annotations = append(annotations, annotation.toJSON())
+	}
+
+	out, err := json.Marshal(map[string]interface{}{
+		"annotations":   map[string]interface{}{"list": annotations},
+		"editable":      true,
+		"gnetId":        nil,
+		"graphTooltip":  0,
+		"links":         []interface{}{},
+		"panels":        d.layout(),
+		"refresh":       d.Refresh,
+		"schemaVersion": schemaVersion,
+		"style":         "dark",
+		"tags":          tags,
+		"templating":    map[string]interface{}{"list": templating},
+		"time":          map[string]string{"from": d.From, "to": "now"},
+		"timepicker": map[string]interface{}{
+			"refresh_intervals": []string{"10s", "30s", "1m", "5m", "15m", "30m", "1h", "2h", "1d"},
+		},
+		"timezone": "",
+		"title":    d.Title,
+		"uid":      d.UID,
+		"version":  1,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal dashboard %s: %w", d.Title, err)
+	}
+	return string(out), nil
+}
+
+func (d *Dashboard) validate() error {
+	variables := map[string]bool{}
+	for _, variable := range d.Variables {
+		if variables[variable.Name] {
+			return fmt.Errorf("dashboard %s has more than one variable named %s", d.Title, variable.Name)
+		}
+		variables[variable.Name] = true
+	}
+	for _, row := range d.Rows {
+		if row.Repeat != "" && !variables[row.Repeat] {
+			return fmt.Errorf("row %s of dashboard %s repeats for unknown variable %s", row.Title, d.Title, row.Repeat)
+		}
+		for _, panel := range row.Panels {
+			if panel.Width <= 0 || panel.Width > gridWidth || panel.Height <= 0 {
+				return fmt.Errorf("panel %s of dashboard %s must be between 1 and %d wide and at least 1 high", panel.Title, d.Title, gridWidth)
+			}
+			if len(panel.Targets) == 0 {
+				return fmt.Errorf("panel %s of dashboard %s has no targets", panel.Title, d.Title)
+			}
+			for _, column := range panel.Columns {
+				if (column.Expr == "") == (column.Label == "") {
+					return fmt.Errorf("column %s of table %s of dashboard %s must show either a query or a label", column.Title, panel.Title, d.Title)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// layout assigns ids and grid positions to the rows and panels, in order
+func (d *Dashboard) layout() []map[string]interface{} {
+	panels := []map[string]interface{}{}
+	id := 0
+	// bottom is the height of the panels of the current row in each column of the grid
+	bottom := make([]int, gridWidth)
+	top := 0
+
+	for _, row := range d.Rows {
+		top = highest(bottom)
+		if row.Title != "" {
+			id++
+			panels = append(panels, row.toJSON(id, top))
+			top++
+		}
+		for i := range bottom {
+			bottom[i] = top
+		}
+
+		for _, panel := range row.Panels {
+			x, y := place(bottom, panel.Width)
+			for i := x; i < x+panel.Width; i++ {
+				bottom[i] = y + panel.Height
+			}
+			id++
+			panels = append(panels, panel.toJSON(id, gridPos{X: x, Y: y, W: panel.Width, H: panel.Height}))
+		}
+	}
+	return panels
+}
+
+// place returns the left most of the highest positions a panel of the width fits at
+func place(bottom []int, width int) (int, int) {
+	bestX, bestY := 0, -1
+	for x := 0; x+width <= len(bottom); x++ {
+		y := highest(bottom[x : x+width])
+		if bestY == -1 || y < bestY {
+			bestX, bestY = x, y
+		}
+	}
+	return bestX, bestY
+}
+
+func highest(values []int) int {
+	result := 0
+	for _, value := range values {
+		if value > result {
+			result = value
+		}
+	}
+	return result
+}
+
+type gridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// Row is a titled group of panels. A row without title lays its panels out under the previous row
+type Row struct {
+	Title string
+	// Repeat is the name of the variable the row is repeated for, once per selected value
+	Repeat string
+	Panels []*Panel
+}
+
+// NewRow returns a row of panels
+func NewRow(title string, panels ...*Panel) *Row {
+	return &Row{Title: title, Panels: panels}
+}
+
+// RepeatFor repeats the row for each selected value of the variable
+func (r *Row) RepeatFor(variable string) *Row {
+	r.Repeat = variable
+	return r
+}
+
+// AddPanels adds panels at the end of the row
+func (r *Row) AddPanels(panels ...*Panel) *Row {
+	r.Panels = append(r.Panels, panels...)
+	return r
+}
+
+func (r *Row) toJSON(id, y int) map[string]interface{} {
+	row := map[string]interface{}{
+		"collapsed": false,
+		"gridPos":   gridPos{X: 0, Y: y, W: gridWidth, H: 1},
+		"id":        id,
+		"panels":    []interface{}{},
+		"title":     r.Title,
+		"type":      "row",
+	}
+	if r.Repeat != "" {
+		row["repeat"] = r.Repeat
+	}
+	return row
+}
+
+// PanelType is the grafana type of a panel
+type PanelType string
+
+const (
+	PanelTypeSingleStat PanelType = "singlestat"
+	PanelTypeGraph      PanelType = "graph"
+	PanelTypeTable      PanelType = "table"
+	// PanelTypeDiscrete is the timeline of the natel-discrete-panel plugin, which must be installed
+	PanelTypeDiscrete PanelType = "natel-discrete-panel"
+)
+
+var (
+	// ColorsGood are the colors of single stats with the higher values being the better ones
+	ColorsGood = []string{"#d44a3a", "rgba(237, 129, 40, 0.89)", "#299c46"}
+	// ColorsBad are the colors of single stats with the higher values being the worse ones
+	ColorsBad = []string{"#299c46", "rgba(237, 129, 40, 0.89)", "#d44a3a"}
+)
+
+// Target is a prometheus query of a panel
+type Target struct {
+	Expr string
+	// Legend is the legend format of the series of the query
+	Legend string
+	// Instant queries the last value only, for single stats and tables
+	Instant bool
+}
+
+// Column is a column of a table. Columns either show the value of a query, one row per series, or a label of the
+// series, which can link to another dashboard with the value of the cell as $__cell
+type Column struct {
+	Title string
+	Expr  string
+	Label string
+	Unit  string
+	Link  string
+}
+
+// ValueMap is the text single stats show for a value, null being the value of empty query results
+type ValueMap struct {
+	Value string
+	Text  string
+}
+
+// Link is a link from the title of a panel
+type Link struct {
+	Title string
+	URL   string
+	// IncludeVars passes the values of the variables of the dashboard to the linked one
+	IncludeVars bool
+}
+
+// Panel is a single stat, a graph, a table or a discrete timeline
+type Panel struct {
+	Type        PanelType
+	Title       string
+	Description string
+	Datasource  string
+	Width       int
+	Height      int
+	Targets     []Target
+	Columns     []Column
+	Links       []Link
+	// Interval is the minimum step of the queries, such as $interval
+	Interval string
+	// Unit is the grafana format of the values, such as percentunit or ms
+	Unit     string
+	Decimals *int
+	// Thresholds of single stats, the values are shown with the Colors of the range they fall in
+	Thresholds      []float64
+	Colors          []string
+	ColorBackground bool
+	// ValueMaps replace the values shown by single stats, empty query results are shown as 0 by default
+	ValueMaps []ValueMap
+	// ValueName is the value of the series shown by single stats, current by default
+	ValueName string
+	// Gauge shows single stats as a gauge between Min and Max
+	Gauge bool
+	// Min and Max bound the y axis of graphs and the gauge of single stats
+	Min *float64
+	Max *float64
+	// Stack stacks and fills the series of graphs
+	Stack bool
+	// RightAxisSeries are the legends of the series of graphs drawn on a second y axis between RightMin and RightMax
+	RightAxisSeries []string
+	RightMin        float64
+	RightMax        float64
+	// TimeFrom overrides the time range of the dashboard for the panel, such as 28d
+	TimeFrom string
+}
+
+// NewSingleStat returns a single stat showing the current value of the query
+func NewSingleStat(title, expr string) *Panel {
+	return &Panel{
+		Type:       PanelTypeSingleStat,
+		Title:      title,
+		Datasource: DefaultDatasource,
+		Width:      6,
+		Height:     5,
+		Targets:    []Target{{Expr: expr, Instant: true}},
+	}
+}
+
+// NewGraph returns a graph of the queries over time
+func NewGraph(title string, targets ...Target) *Panel {
+	return &Panel{
+		Type:       PanelTypeGraph,
+		Title:      title,
+		Datasource: DefaultDatasource,
+		Width:      12,
+		Height:     8,
+		Targets:    targets,
+	}
+}
+
+// NewTable returns a table of the current values of the queries of the columns, joined on the labels of their series
+func NewTable(title string, columns ...Column) *Panel {
+	panel := &Panel{
+		Type:       PanelTypeTable,
+		Title:      title,
+		Datasource: DefaultDatasource,
+		Width:      24,
+		Height:     7,
+		Columns:    columns,
+	}
+	for _, column := range columns {
+		if column.Expr != "" {
+			panel.Targets = append(panel.Targets, Target{Expr: column.Expr, Instant: true})
+		}
+	}
+	return panel
+}
+
+// NewDiscrete returns a timeline of the values of the queries over time
+func NewDiscrete(title string, targets ...Target) *Panel {
+	return &Panel{
+		Type:       PanelTypeDiscrete,
+		Title:      title,
+		Datasource: DefaultDatasource,
+		Width:      12,
+		Height:     3,
+		Targets:    targets,
+	}
+}
+
+// WithSize sets the width, in columns of a grid of 24, and the height of the panel
+func (p *Panel) WithSize(width, height int) *Panel {
+	p.Width = width
+	p.Height = height
+	return p
+}
+
+// WithDescription sets the description shown in the tooltip of the title
+func (p *Panel) WithDescription(description string) *Panel {
+	p.Description = description
+	return p
+}
+
+// WithUnit sets the unit of the values and the number of decimals shown
+func (p *Panel) WithUnit(unit string, decimals int) *Panel {
+	p.Unit = unit
+	p.Decimals = &decimals
+	return p
+}
+
+// WithThresholds colors the values of a single stat, the background is colored instead of the value when
+// background is true
+func (p *Panel) WithThresholds(colors []string, background bool, thresholds ...float64) *Panel {
+	p.Colors = colors
+	p.ColorBackground = background
+	p.Thresholds = thresholds
+	return p
+}
+
+// WithValueMaps shows the values of a single stat as text, such as UP for 1
+func (p *Panel) WithValueMaps(valueMaps ...ValueMap) *Panel {
+	p.ValueMaps = valueMaps
+	return p
+}
+
+// WithAverage shows the average of a single stat over the time range of the dashboard instead of its current value
+func (p *Panel) WithAverage() *Panel {
+	p.ValueName = "avg"
+	for i := range p.Targets {
+		p.Targets[i].Instant = false
+	}
+	return p
+}
+
+// WithInterval sets the minimum step of the queries of the panel, such as $interval
+func (p *Panel) WithInterval(interval string) *Panel {
+	p.Interval = interval
+	return p
+}
+
+// WithLink links the title of the panel to another dashboard
+func (p *Panel) WithLink(title, url string, includeVars bool) *Panel {
+	p.Links = append(p.Links, Link{Title: title, URL: url, IncludeVars: includeVars})
+	return p
+}
+
+// WithRange bounds the y axis of a graph, or shows a single stat as a gauge
+func (p *Panel) WithRange(min, max float64) *Panel {
+	p.Min = &min
+	p.Max = &max
+	p.Gauge = p.Type == PanelTypeSingleStat
+	return p
+}
+
+// WithStack stacks the series of a graph
+func (p *Panel) WithStack() *Panel {
+	p.Stack = true
+	return p
+}
+
+// WithRightAxis draws the series of a graph with the legends on a second y axis between min and max
+func (p *Panel) WithRightAxis(min, max float64, legends ...string) *Panel {
+	p.RightAxisSeries = legends
+	p.RightMin = min
+	p.RightMax = max
+	return p
+}
+
+// WithTimeFrom shows the panel over the last period, such as 28d, whatever the time range of the dashboard
+func (p *Panel) WithTimeFrom(period string) *Panel {
+	p.TimeFrom = period
+	return p
+}
+
+func (p *Panel) toJSON(id int, pos gridPos) map[string]interface{} {
+	targets := make([]map[string]interface{}, 0, len(p.Targets))
+	for i, target := range p.Targets {
+		t := map[string]interface{}{
+			"expr":           target.Expr,
+			"format":         "time_series",
+			"intervalFactor": 1,
+			"refId":          string(rune('A' + i)),
+		}
+		if p.Type == PanelTypeTable {
+			t["format"] = "table"
+		}
+		if target.Legend != "" {
+			t["legendFormat"] = target.Legend
+		}
+		if target.Instant {
+			t["instant"] = true
+		}
+		targets = append(targets, t)
+	}
+	links := make([]map[string]interface{}, 0, len(p.Links))
+	for _, link := range p.Links {
+		links = append(links, map[string]interface{}{
+			"includeVars": link.IncludeVars,
+			"title":       link.Title,
+			"type":        "absolute",
+			"url":         link.URL,
+		})
+	}
+
+	panel := map[string]interface{}{
+		"datasource": p.Datasource,
+		"gridPos":    pos,
+		"id":         id,
+		"links":      links,
+		"targets":    targets,
+		"title":      p.Title,
+		"type":       p.Type,
+	}
+	if p.Description != "" {
+		panel["description"] = p.Description
+	}
+	if p.Interval != "" {
+		panel["interval"] = p.Interval
+	}
+	if p.TimeFrom != "" {
+		panel["timeFrom"] = p.TimeFrom
+		panel["hideTimeOverride"] = true
+	}
+	if p.Decimals != nil {
+		panel["decimals"] = *p.Decimals
+	}
+
+	switch p.Type {
+	case PanelTypeSingleStat:
+		p.singleStatJSON(panel)
+	case PanelTypeGraph:
+		p.graphJSON(panel)
+	case PanelTypeTable:
+		p.tableJSON(panel)
+	case PanelTypeDiscrete:
+		p.discreteJSON(panel)
+	}
+	return panel
+}
+
+func (p *Panel) singleStatJSON(panel map[string]interface{}) {
+	thresholds := make([]string, 0, len(p.Thresholds))
+	for _, threshold := range p.Thresholds {
+		thresholds = append(thresholds, strconv.FormatFloat(threshold, 'f', -1, 64))
+	}
+	colors := p.Colors
+	if colors == nil {
+		colors = ColorsBad
+	}
+	gauge := map[string]interface{}{
+		"show":             p.Gauge,
+		"thresholdLabels":  false,
+		"thresholdMarkers": true,
+		"minValue":         0,
+		"maxValue":         100,
+	}
+	if p.Min != nil {
+		gauge["minValue"] = *p.Min
+	}
+	if p.Max != nil {
+		gauge["maxValue"] = *p.Max
+	}
+	// an empty query result, such as the sum of no firing alerts, is shown as 0 unless mapped otherwise
+	valueMaps := []map[string]string{{"op": "=", "text": "0", "value": "null"}}
+	if p.ValueMaps != nil {
+		valueMaps = make([]map[string]string, 0, len(p.ValueMaps))
+		for _, valueMap := range p.ValueMaps {
+			valueMaps = append(valueMaps, map[string]string{"op": "=", "text": valueMap.Text, "value": valueMap.Value})
+		}
+	}
+
+	panel["cacheTimeout"] = nil
+	panel["colorBackground"] = p.ColorBackground
+	panel["colorValue"] = !p.ColorBackground && len(thresholds) > 0
+	panel["colors"] = colors
+	panel["format"] = unitOrDefault(p.Unit, "none")
+	panel["gauge"] = gauge
+	panel["mappingType"] = 1
+	panel["valueMaps"] = valueMaps
+	panel["nullPointMode"] = "connected"
+	panel["nullText"] = nil
+	panel["postfix"] = ""
+	panel["prefix"] = ""
+	panel["sparkline"] = map[string]bool{"show": false}
+	panel["tableColumn"] = ""
+	panel["thresholds"] = strings.Join(thresholds, ",")
+	panel["valueFontSize"] = "80%"
+	panel["valueName"] = unitOrDefault(p.ValueName, "current")
+}
+
+func (p *Panel) graphJSON(panel map[string]interface{}) {
+	yaxis := map[string]interface{}{
+		"format":  unitOrDefault(p.Unit, "short"),
+		"label":   nil,
+		"logBase": 1,
+		"max":     nil,
+		"min":     nil,
+		"show":    true,
+	}
+	if p.Decimals != nil {
+		yaxis["decimals"] = *p.Decimals
+	}
+	if p.Min != nil {
+		yaxis["min"] = strconv.FormatFloat(*p.Min, 'f', -1, 64)
+	}
+	if p.Max != nil {
+		yaxis["max"] = strconv.FormatFloat(*p.Max, 'f', -1, 64)
+	}
+	rightAxis := map[string]interface{}{"format": "short", "label": nil, "logBase": 1, "max": nil, "min": nil, "show": false}
+	seriesOverrides := make([]map[string]interface{}, 0, len(p.RightAxisSeries))
+	for _, legend := range p.RightAxisSeries {
+		seriesOverrides = append(seriesOverrides, map[string]interface{}{"alias": legend, "yaxis": 2})
+	}
+	if len(seriesOverrides) > 0 {
+		rightAxis["decimals"] = 0
+		rightAxis["min"] = strconv.FormatFloat(p.RightMin, 'f', -1, 64)
+		rightAxis["max"] = strconv.FormatFloat(p.RightMax, 'f', -1, 64)
+		rightAxis["show"] = true
+	}
+
+	panel["aliasColors"] = map[string]string{}
+	panel["bars"] = false
+	panel["dashLength"] = 10
+	panel["dashes"] = false
+	panel["fill"] = 1
+	panel["legend"] = map[string]bool{
+		"avg":     false,
+		"current": true,
+		"max":     false,
+		"min":     false,
+		"show":    true,
+		"total":   false,
+		"values":  true,
+	}
+	panel["lines"] = true
+	panel["linewidth"] = 1
+	panel["nullPointMode"] = "null"
+	panel["percentage"] = false
+	panel["pointradius"] = 2
+	panel["points"] = false
+	panel["renderer"] = "flot"
+	panel["seriesOverrides"] = seriesOverrides
+	panel["spaceLength"] = 10
+	panel["stack"] = p.Stack
+	panel["steppedLine"] = false
+	panel["thresholds"] = []interface{}{}
+	panel["tooltip"] = map[string]interface{}{"shared": true, "sort": 0, "value_type": "individual"}
+	panel["xaxis"] = map[string]interface{}{"buckets": nil, "mode": "time", "name": nil, "show": true, "values": []interface{}{}}
+	panel["yaxes"] = []map[string]interface{}{yaxis, rightAxis}
+	if p.Stack {
+		// stacked series with gaps would be drawn on top of the wrong series
+		panel["fill"] = 10
+		panel["nullPointMode"] = "null as zero"
+	}
+}
+
+func (p *Panel) tableJSON(panel map[string]interface{}) {
+	styles := []map[string]interface{}{{"alias": "Time", "pattern": "Time", "type": "hidden"}}
+	values := 0
+	for _, column := range p.Columns {
+		style := map[string]interface{}{
+			"alias":      column.Title,
+			"colorMode":  nil,
+			"colors":     []string{},
+			"dateFormat": "YYYY-MM-DD HH:mm:ss",
+			"decimals":   2,
+			"link":       column.Link != "",
+			"linkUrl":    column.Link,
+			"pattern":    column.Label,
+			"thresholds": []string{},
+			"type":       "number",
+			"unit":       unitOrDefault(column.Unit, "short"),
+		}
+		if column.Link != "" {
+			style["linkTooltip"] = "Drill down"
+		}
+		if column.Expr != "" {
+			// the values of the queries of tables are named after their ref id
+			style["pattern"] = fmt.Sprintf("Value #%c", rune('A'+values))
+			values++
+		}
+		styles = append(styles, style)
+	}
+	styles = append(styles, map[string]interface{}{"alias": "", "decimals": 2, "pattern": "/.*/", "type": "string", "unit": "short"})
+
+	panel["columns"] = []interface{}{}
+	panel["fontSize"] = "100%"
+	panel["pageSize"] = nil
+	panel["scroll"] = true
+	panel["showHeader"] = true
+	panel["sort"] = map[string]interface{}{"col": 0, "desc": true}
+	panel["styles"] = styles
+	panel["transform"] = "table"
+}
+
+func (p *Panel) discreteJSON(panel map[string]interface{}) {
+	panel["backgroundColor"] = "rgba(128,128,128,0.1)"
+	panel["colorMaps"] = []map[string]string{{"color": "#CCC", "text": "N/A"}}
+	panel["crosshairColor"] = "#8F070C"
+	panel["display"] = "timeline"
+	panel["expandFromQueryS"] = 0
+	panel["extendLastValue"] = true
+	panel["highlightOnMouseover"] = true
+	panel["legendSortBy"] = "-ms"
+	panel["lineColor"] = "rgba(0,0,0,0.1)"
+	panel["metricNameColor"] = "#000000"
+	panel["rangeMaps"] = []map[string]string{{"from": "null", "text": "N/A", "to": "null"}}
+	panel["rowHeight"] = 25
+	panel["showDistinctCount"] = true
+	panel["showLegend"] = true
+	panel["showLegendCounts"] = true
+	panel["showLegendNames"] = false
+	panel["showLegendPercent"] = true
+	panel["showLegendValues"] = true
+	panel["showTimeAxis"] = true
+	panel["showTransitionCount"] = true
+	panel["textSize"] = 14
+	panel["textSizeTime"] = 12
+	panel["timePrecision"] = map[string]string{"name": "Minutes", "value": "minutes"}
+	panel["timeTextColor"] = "#d8d9da"
+	panel["units"] = unitOrDefault(p.Unit, "short")
+	panel["useTimePrecision"] = false
+	panel["valueMaps"] = []map[string]string{{"op": "=", "text": "N/A", "value": "null"}}
+	panel["valueTextColor"] = "#000000"
+	panel["writeAllValues"] = true
+	panel["writeLastValue"] = false
+	panel["writeMetricNames"] = false
+}
+
+func unitOrDefault(unit, defaultUnit string) string {
+	if unit == "" {
+		return defaultUnit
+	}
+	return unit
+}
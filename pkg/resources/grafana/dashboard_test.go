@@ -0,0 +1,281 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+)
+
+type testDashboard struct {
+	Panels []struct {
+		ID      int     `json:"id"`
+		Type    string  `json:"type"`
+		Title   string  `json:"title"`
+		Repeat  string  `json:"repeat"`
+		GridPos gridPos `json:"gridPos"`
+		Targets []struct {
+			Expr  string `json:"expr"`
+			RefID string `json:"refId"`
+		} `json:"targets"`
+		Thresholds interface{} `json:"thresholds"`
+	} `json:"panels"`
+	Templating struct {
+		List []struct {
+			Name    string `json:"name"`
+			Type    string `json:"type"`
+			Query   string `json:"query"`
+			Hide    int    `json:"hide"`
+			Options []struct {
+				Value string `json:"value"`
+			} `json:"options"`
+		} `json:"list"`
+	} `json:"templating"`
+}
+
+func parseDashboard(t *testing.T, dashboard *Dashboard) testDashboard {
+	specJSON, err := dashboard.JSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result testDashboard
+	if err := json.Unmarshal([]byte(specJSON), &result); err != nil {
+		t.Fatalf("dashboard is not valid json: %v", err)
+	}
+	return result
+}
+
+func TestDashboard_JSON(t *testing.T) {
+	dashboard := NewDashboard("test").
+		AddVariables(
+			NewConstantVariable("days", "28"),
+			NewCustomVariable("namespace", "Namespace", "a", "b").WithAll(),
+		).
+		AddRows(
+			NewRow("summary",
+				NewSingleStat("firing", "sum(ALERTS)").WithSize(3, 4).WithThresholds(ColorsBad, true, 1, 1),
+				NewSingleStat("slo", "vector(1)").WithSize(3, 4),
+				NewGraph("alerts", Target{Expr: "sum(ALERTS)"}, Target{Expr: "vector(0)"}).WithSize(18, 8),
+				NewSingleStat("budget", "vector(1)").WithSize(3, 4),
+			),
+			NewRow("$namespace", NewGraph("usage", Target{Expr: "up"})).RepeatFor("namespace"),
+		)
+
+	result := parseDashboard(t, dashboard)
+
+	expected := []struct {
+		title string
+		pos   gridPos
+	}{
+		{"summary", gridPos{X: 0, Y: 0, W: 24, H: 1}},
+		{"firing", gridPos{X: 0, Y: 1, W: 3, H: 4}},
+		{"slo", gridPos{X: 3, Y: 1, W: 3, H: 4}},
+		{"alerts", gridPos{X: 6, Y: 1, W: 18, H: 8}},
+		{"budget", gridPos{X: 0, Y: 5, W: 3, H: 4}},
+		{"$namespace", gridPos{X: 0, Y: 9, W: 24, H: 1}},
+		{"usage", gridPos{X: 0, Y: 10, W: 12, H: 8}},
+	}
+	if len(result.Panels) != len(expected) {
+		t.Fatalf("expected %d panels, got %d", len(expected), len(result.Panels))
+	}
+	for i, panel := range result.Panels {
+		if panel.ID != i+1 || panel.Title != expected[i].title || panel.GridPos != expected[i].pos {
+			t.Fatalf("expected panel %d to be %s at %v, got %s at %v", i+1, expected[i].title, expected[i].pos, panel.Title, panel.GridPos)
+		}
+	}
+	if result.Panels[1].Thresholds != "1,1" {
+		t.Fatalf("expected the thresholds of single stats to be joined, got %v", result.Panels[1].Thresholds)
+	}
+	if targets := result.Panels[3].Targets; len(targets) != 2 || targets[1].RefID != "B" {
+		t.Fatalf("expected the targets to be referenced in order, got %v", targets)
+	}
+	if result.Panels[5].Repeat != "namespace" {
+		t.Fatalf("expected the row to repeat for the namespace variable")
+	}
+
+	variables := result.Templating.List
+	if len(variables) != 2 || variables[0].Type != "constant" || variables[0].Hide != 2 {
+		t.Fatalf("expected a hidden constant variable, got %v", variables)
+	}
+	if variables[1].Query != "a, b" || len(variables[1].Options) != 3 || variables[1].Options[0].Value != "$__all" {
+		t.Fatalf("expected the custom variable to list its values after all, got %v", variables[1])
+	}
+}
+
+func TestDashboard_JSONPanels(t *testing.T) {
+	dashboard := NewDashboard("test").
+		AddAnnotations(NewAnnotation("Upgrade", "rhmi_version", "version")).
+		AddVariables(NewIntervalVariable("interval", "Interval", "30s", "10s", "30s")).
+		AddRows(NewRow("row",
+			NewTable("quota",
+				Column{Title: "Usage", Expr: "usage"},
+				Column{Title: "Pod", Label: "pod", Link: "/d/pods?var-pod=$__cell"},
+				Column{Title: "Requests", Expr: "requests", Unit: "decbytes"},
+			),
+			NewSingleStat("up", "probe_success").
+				WithValueMaps(ValueMap{Value: "1", Text: "UP"}).
+				WithLink("Drill Down", "/d/detailed", true).
+				WithAverage(),
+			NewGraph("duration", Target{Expr: "probe_duration_seconds"}, Target{Expr: "probe_success", Legend: "UP/DOWN"}).
+				WithInterval("$interval").
+				WithRightAxis(0, 1, "UP/DOWN"),
+		))
+
+	specJSON, err := dashboard.JSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result struct {
+		Annotations struct {
+			List []struct {
+				Name    string `json:"name"`
+				TagKeys string `json:"tagKeys"`
+			} `json:"list"`
+		} `json:"annotations"`
+		Panels []struct {
+			Interval string `json:"interval"`
+			Targets  []struct {
+				Format  string `json:"format"`
+				Instant bool   `json:"instant"`
+			} `json:"targets"`
+			Styles []struct {
+				Alias   string `json:"alias"`
+				Pattern string `json:"pattern"`
+				Unit    string `json:"unit"`
+				LinkURL string `json:"linkUrl"`
+			} `json:"styles"`
+			Links []struct {
+				URL         string `json:"url"`
+				IncludeVars bool   `json:"includeVars"`
+			} `json:"links"`
+			ValueMaps []struct {
+				Value string `json:"value"`
+				Text  string `json:"text"`
+			} `json:"valueMaps"`
+			ValueName       string `json:"valueName"`
+			SeriesOverrides []struct {
+				Alias string `json:"alias"`
+				YAxis int    `json:"yaxis"`
+			} `json:"seriesOverrides"`
+		} `json:"panels"`
+		Templating struct {
+			List []struct {
+				Type    string `json:"type"`
+				Options []struct {
+					Value    string `json:"value"`
+					Selected bool   `json:"selected"`
+				} `json:"options"`
+			} `json:"list"`
+		} `json:"templating"`
+	}
+	if err := json.Unmarshal([]byte(specJSON), &result); err != nil {
+		t.Fatalf("dashboard is not valid json: %v", err)
+	}
+
+	if annotations := result.Annotations.List; len(annotations) != 2 || annotations[1].Name != "Upgrade" || annotations[1].TagKeys != "version" {
+		t.Fatalf("expected the annotation to follow the built-in one, got %v", annotations)
+	}
+	if options := result.Templating.List[0].Options; len(options) != 3 || options[0].Value != "$__auto_interval_interval" || !options[2].Selected {
+		t.Fatalf("expected the interval variable to list auto and its intervals, got %v", options)
+	}
+
+	table := result.Panels[1]
+	if len(table.Targets) != 2 || table.Targets[1].Format != "table" || !table.Targets[1].Instant {
+		t.Fatalf("expected an instant table query per value column, got %v", table.Targets)
+	}
+	styles := table.Styles
+	if len(styles) != 5 || styles[1].Pattern != "Value #A" || styles[2].Pattern != "pod" || styles[2].LinkURL != "/d/pods?var-pod=$__cell" || styles[3].Pattern != "Value #B" || styles[3].Unit != "decbytes" {
+		t.Fatalf("expected the value columns to be named after the ref ids of their queries, got %v", styles)
+	}
+
+	stat := result.Panels[2]
+	if len(stat.ValueMaps) != 1 || stat.ValueMaps[0].Text != "UP" {
+		t.Fatalf("expected the value maps to replace the default one, got %v", stat.ValueMaps)
+	}
+	if len(stat.Links) != 1 || stat.Links[0].URL != "/d/detailed" || !stat.Links[0].IncludeVars {
+		t.Fatalf("expected the panel to link to the dashboard, got %v", stat.Links)
+	}
+	if stat.ValueName != "avg" || stat.Targets[0].Instant {
+		t.Fatalf("expected the average over the time range to be shown")
+	}
+
+	graph := result.Panels[3]
+	if graph.Interval != "$interval" || len(graph.SeriesOverrides) != 1 || graph.SeriesOverrides[0].YAxis != 2 {
+		t.Fatalf("expected the series to be drawn on the right axis, got %v", graph.SeriesOverrides)
+	}
+}
+
+func TestDashboard_JSONValidation(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Dashboard *Dashboard
+	}{
+		{
+			Name:      "test duplicate variables",
+			Dashboard: NewDashboard("test").AddVariables(NewConstantVariable("a", "1"), NewConstantVariable("a", "2")),
+		},
+		{
+			Name:      "test row repeated for unknown variable",
+			Dashboard: NewDashboard("test").AddRows(NewRow("$product").RepeatFor("product")),
+		},
+		{
+			Name:      "test panel wider than the grid",
+			Dashboard: NewDashboard("test").AddRows(NewRow("row", NewGraph("graph", Target{Expr: "up"}).WithSize(25, 8))),
+		},
+		{
+			Name:      "test table column without query or label",
+			Dashboard: NewDashboard("test").AddRows(NewRow("row", NewTable("table", Column{Title: "Usage", Expr: "usage"}, Column{Title: "Pod"}))),
+		},
+		{
+			Name:      "test panel without targets",
+			Dashboard: NewDashboard("test").AddRows(NewRow("row", NewGraph("graph"))),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := tc.Dashboard.JSON(); err == nil {
+				t.Fatalf("expected the dashboard to be invalid")
+			}
+		})
+	}
+}
+
+func TestGetProductDashboards(t *testing.T) {
+	RegisterProductDashboard("test-product", func(installation *integreatlyv1alpha1.RHMI) ProductDashboard {
+		return ProductDashboard{Namespace: installation.Spec.NamespacePrefix + "test", AlertPrefix: "Test"}
+	})
+	defer UnregisterProductDashboard("test-product")
+	RegisterProductDashboard("test-disabled", func(installation *integreatlyv1alpha1.RHMI) ProductDashboard {
+		return ProductDashboard{Namespace: installation.Spec.NamespacePrefix + "disabled"}
+	})
+	defer UnregisterProductDashboard("test-disabled")
+
+	disabled := false
+	installation := &integreatlyv1alpha1.RHMI{
+		Spec: integreatlyv1alpha1.RHMISpec{
+			NamespacePrefix: "prefix-",
+			Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.ProductSpec{
+				"test-disabled": {Enabled: &disabled},
+			},
+		},
+		Status: integreatlyv1alpha1.RHMIStatus{
+			Stages: map[integreatlyv1alpha1.StageName]integreatlyv1alpha1.RHMIStageStatus{
+				integreatlyv1alpha1.ProductsStage: {
+					Products: map[integreatlyv1alpha1.ProductName]integreatlyv1alpha1.RHMIProductStatus{
+						"test-product":  {Name: "test-product"},
+						"test-disabled": {Name: "test-disabled"},
+						"unregistered":  {Name: "unregistered"},
+					},
+				},
+			},
+		},
+	}
+
+	dashboards := GetProductDashboards(installation)
+	if len(dashboards) != 1 {
+		t.Fatalf("expected the dashboard of the enabled registered product only, got %v", dashboards)
+	}
+	if dashboards[0].Title != "test-product" || dashboards[0].Namespace != "prefix-test" {
+		t.Fatalf("expected the dashboard to follow the namespace prefix and default to the product title, got %v", dashboards[0])
+	}
+}
@@ -0,0 +1,78 @@
+package grafana
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+)
+
+// ProductDashboard is what a product contributes to the dashboards of the monitoring stack
+type ProductDashboard struct {
+	// Title of the rows of the product, the name of the product when empty
+	Title string
+	// Namespace of the product, its alerts are counted in the critical SLO summary
+	Namespace string
+	// AlertPrefix is the prefix of the names of the alerts of the product, counted in the critical SLO summary
+	// whatever their namespace
+	AlertPrefix string
+	// Panels are added to the row of the product in the critical SLO summary
+	Panels []*Panel
+}
+
+// ProductDashboardFactory builds the contribution of a product for an installation, so that namespaces follow
+// the prefix of the installation
+type ProductDashboardFactory func(installation *integreatlyv1alpha1.RHMI) ProductDashboard
+
+var (
+	productDashboardsLock sync.RWMutex
+	productDashboards     = map[integreatlyv1alpha1.ProductName]ProductDashboardFactory{}
+)
+
+// RegisterProductDashboard adds the panels of a product to the dashboards of the monitoring stack. Products register
+// alongside their reconciler, from the init function of their package. RegisterProductDashboard
+// panics if the product is registered twice
+func RegisterProductDashboard(product integreatlyv1alpha1.ProductName, factory ProductDashboardFactory) {
+	productDashboardsLock.Lock()
+	defer productDashboardsLock.Unlock()
+
+	if _, ok := productDashboards[product]; ok {
+		panic(fmt.Sprintf("grafana: dashboard of %s is already registered", product))
+	}
+	productDashboards[product] = factory
+}
+
+// UnregisterProductDashboard removes the panels of a product, allowing tests to replace them
+func UnregisterProductDashboard(product integreatlyv1alpha1.ProductName) {
+	productDashboardsLock.Lock()
+	defer productDashboardsLock.Unlock()
+
+	delete(productDashboards, product)
+}
+
+// GetProductDashboards returns the contributions of the enabled products of the installation, sorted by product
+func GetProductDashboards(installation *integreatlyv1alpha1.RHMI) []ProductDashboard {
+	productDashboardsLock.RLock()
+	defer productDashboardsLock.RUnlock()
+
+	var products []integreatlyv1alpha1.ProductName
+	for _, stage := range installation.Status.Stages {
+		for product := range stage.Products {
+			if _, ok := productDashboards[product]; ok && installation.IsProductEnabled(product) {
+				products = append(products, product)
+			}
+		}
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i] < products[j] })
+
+	result := make([]ProductDashboard, 0, len(products))
+	for _, product := range products {
+		dashboard := productDashboards[product](installation)
+		if dashboard.Title == "" {
+			dashboard.Title = string(product)
+		}
+		result = append(result, dashboard)
+	}
+	return result
+}
@@ -0,0 +1,122 @@
+package grafana
+
+import "strings"
+
+// VariableType is the grafana type of a templated variable
+type VariableType string
+
+const (
+	VariableTypeConstant VariableType = "constant"
+	VariableTypeQuery    VariableType = "query"
+	VariableTypeCustom   VariableType = "custom"
+	VariableTypeInterval VariableType = "interval"
+)
+
+// Variable is a templated variable of a dashboard, referenced as $<name> in the queries of the panels
+type Variable struct {
+	Type  VariableType
+	Name  string
+	Label string
+	// Query is the value of constants, the prometheus query of query variables or the comma separated values of
+	// custom and interval variables
+	Query string
+	// Current is the value selected by default of interval variables
+	Current string
+	// Regex extracts the values from the results of query variables
+	Regex  string
+	Hidden bool
+	// Multi allows more than one value to be selected, and IncludeAll adds an All option
+	Multi      bool
+	IncludeAll bool
+}
+
+// NewConstantVariable returns a hidden variable with a fixed value
+func NewConstantVariable(name, value string) *Variable {
+	return &Variable{Type: VariableTypeConstant, Name: name, Query: value, Hidden: true}
+}
+
+// NewQueryVariable returns a variable with the values of a prometheus query, such as label_values(up, namespace)
+func NewQueryVariable(name, label, query string) *Variable {
+	return &Variable{Type: VariableTypeQuery, Name: name, Label: label, Query: query}
+}
+
+// NewCustomVariable returns a variable with a fixed list of values
+func NewCustomVariable(name, label string, values ...string) *Variable {
+	return &Variable{Type: VariableTypeCustom, Name: name, Label: label, Query: strings.Join(values, ", ")}
+}
+
+// NewIntervalVariable returns a variable with a list of intervals, such as 1m, and an auto option picking an
+// interval from the time range of the dashboard
+func NewIntervalVariable(name, label, current string, intervals ...string) *Variable {
+	return &Variable{Type: VariableTypeInterval, Name: name, Label: label, Current: current, Query: strings.Join(intervals, ",")}
+}
+
+// WithAll allows selecting more than one value of the variable, all of them being selected by default
+func (v *Variable) WithAll() *Variable {
+	v.Multi = true
+	v.IncludeAll = true
+	return v
+}
+
+func (v *Variable) toJSON() map[string]interface{} {
+	hide := 0
+	if v.Hidden {
+		hide = 2
+	}
+	variable := map[string]interface{}{
+		"hide":        hide,
+		"label":       v.Label,
+		"name":        v.Name,
+		"query":       v.Query,
+		"skipUrlSync": false,
+		"type":        v.Type,
+	}
+
+	switch v.Type {
+	case VariableTypeConstant:
+		variable["current"] = map[string]string{"text": v.Query, "value": v.Query}
+		variable["options"] = []map[string]interface{}{{"selected": true, "text": v.Query, "value": v.Query}}
+	case VariableTypeQuery:
+		variable["allValue"] = nil
+		variable["current"] = map[string]string{}
+		variable["datasource"] = DefaultDatasource
+		variable["definition"] = v.Query
+		variable["includeAll"] = v.IncludeAll
+		variable["multi"] = v.Multi
+		variable["options"] = []interface{}{}
+		// refreshed when the time range changes, so that new label values are listed
+		variable["refresh"] = 2
+		variable["regex"] = v.Regex
+		variable["sort"] = 1
+		variable["tagValuesQuery"] = ""
+		variable["tags"] = []interface{}{}
+		variable["tagsQuery"] = ""
+		variable["useTags"] = false
+	case VariableTypeCustom:
+		var options []map[string]interface{}
+		if v.IncludeAll {
+			options = append(options, map[string]interface{}{"selected": true, "text": "All", "value": "$__all"})
+		}
+		for _, value := range strings.Split(v.Query, ",") {
+			value = strings.TrimSpace(value)
+			options = append(options, map[string]interface{}{"selected": !v.IncludeAll && len(options) == 0, "text": value, "value": value})
+		}
+		variable["allValue"] = nil
+		variable["current"] = map[string]interface{}{"selected": true, "text": options[0]["text"], "value": options[0]["value"]}
+		variable["includeAll"] = v.IncludeAll
+		variable["multi"] = v.Multi
+		variable["options"] = options
+	case VariableTypeInterval:
+		options := []map[string]interface{}{{"selected": false, "text": "auto", "value": "$__auto_interval_" + v.Name}}
+		for _, value := range strings.Split(v.Query, ",") {
+			options = append(options, map[string]interface{}{"selected": value == v.Current, "text": value, "value": value})
+		}
+		variable["auto"] = true
+		variable["auto_count"] = 10
+		variable["auto_min"] = "10s"
+		variable["current"] = map[string]string{"text": v.Current, "value": v.Current}
+		variable["options"] = options
+		variable["refresh"] = 2
+	}
+	return variable
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
@@ -13,27 +14,65 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func upgradeApproval(ctx context.Context, preUpgradeBackupExecutor backup.BackupExecutor, client k8sclient.Client, ip *v1alpha1.InstallPlan) error {
+// upgradeApproval approves the install plan, performing a pre-upgrade backup first when the product is already
+// installed. When operatorVersion is set, install plans for other versions of the operator are left unapproved, as
+// are install plans held back by the UpgradeGate of the context
+func upgradeApproval(ctx context.Context, preUpgradeBackupExecutor backup.BackupExecutor, client k8sclient.Client, ip *v1alpha1.InstallPlan, operatorVersion string) error {
 	if ip.Spec.Approved == false && len(ip.Spec.ClusterServiceVersionNames) > 0 {
-		logrus.Infof("Approving %s resource version: %s", ip.Name, ip.Spec.ClusterServiceVersionNames[0])
-		ip.Spec.Approved = true
-
-		// Perform a backup of the product before updating the InstalPlan. We
-		// must check that the product is already installed, as this function
-		// is also called when the product is first installed
-		if ip.Generation > 1 {
-			backupTimeout := time.Minute * 20
-			logrus.Infof("Triggering pre-upgrade backups with timeout of %v", backupTimeout)
-			if err := preUpgradeBackupExecutor.PerformBackup(client, backupTimeout); err != nil {
-				return fmt.Errorf("error performing pre-upgrade backup: %w", err)
-			}
+		if operatorVersion != "" && !installPlanHasVersion(ip, operatorVersion) {
+			logrus.Infof("Not approving %s resource version: %s, the operator is pinned to version %s", ip.Name, ip.Spec.ClusterServiceVersionNames[0], operatorVersion)
+			return nil
 		}
 
-		err := client.Update(ctx, ip)
+		gate := upgradeGateFrom(ctx)
+		if gate != nil && !gate.ApproveUpgrade(ip.Namespace, ip) {
+			logrus.Infof("Not approving %s resource version: %s, waiting for the staged upgrade of other products", ip.Name, ip.Spec.ClusterServiceVersionNames[0])
+			return nil
+		}
+
+		err := approveInstallPlan(ctx, preUpgradeBackupExecutor, client, ip)
+		if gate != nil {
+			gate.FinishUpgradeApproval(ip.Namespace, ip, err == nil)
+		}
+		return err
+	}
+	return nil
+}
+
+func approveInstallPlan(ctx context.Context, preUpgradeBackupExecutor backup.BackupExecutor, client k8sclient.Client, ip *v1alpha1.InstallPlan) error {
+	logrus.Infof("Approving %s resource version: %s", ip.Name, ip.Spec.ClusterServiceVersionNames[0])
+	ip.Spec.Approved = true
+
+	// Perform a backup of the product before updating the InstalPlan. We
+	// must check that the product is already installed, as this function
+	// is also called when the product is first installed
+	if ip.Generation > 1 {
+		backupTimeout := time.Minute * 20
+		logrus.Infof("Triggering pre-upgrade backups with timeout of %v", backupTimeout)
+		backups, err := preUpgradeBackupExecutor.PerformBackup(client, backupTimeout)
 		if err != nil {
-			return fmt.Errorf("error approving installplan: %w", err)
+			return fmt.Errorf("error performing pre-upgrade backup: %w", err)
+		}
+		if err := RecordPreUpgradeBackups(ctx, client, backups); err != nil {
+			logrus.Warnf("Failed to record pre-upgrade backups %v in the upgrade history: %v", backups, err)
 		}
+	}
 
+	err := client.Update(ctx, ip)
+	if err != nil {
+		return fmt.Errorf("error approving installplan: %w", err)
 	}
 	return nil
 }
+
+// installPlanHasVersion checks if the install plan installs the version of the operator. CSV names are
+// expected to follow the <package>.v<version> convention
+func installPlanHasVersion(ip *v1alpha1.InstallPlan, operatorVersion string) bool {
+	suffix := ".v" + strings.TrimPrefix(operatorVersion, "v")
+	for _, csvName := range ip.Spec.ClusterServiceVersionNames {
+		if strings.HasSuffix(csvName, suffix) {
+			return true
+		}
+	}
+	return false
+}
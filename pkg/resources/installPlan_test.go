@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/integr8ly/integreatly-operator/pkg/resources/backup"
+
+	alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpgradeApproval(t *testing.T) {
+	scheme, err := buildScheme()
+	if err != nil {
+		t.Fatalf("error creating scheme: %s", err.Error())
+	}
+
+	cases := []struct {
+		Name             string
+		OperatorVersion  string
+		ExpectedApproved bool
+	}{
+		{
+			Name:             "install plan is approved when the operator version is not pinned",
+			ExpectedApproved: true,
+		},
+		{
+			Name:             "install plan is approved when it installs the pinned operator version",
+			OperatorVersion:  "1.2.0",
+			ExpectedApproved: true,
+		},
+		{
+			Name:             "install plan is approved when the pinned operator version has a v prefix",
+			OperatorVersion:  "v1.2.0",
+			ExpectedApproved: true,
+		},
+		{
+			Name:             "install plan is not approved when it installs a different operator version",
+			OperatorVersion:  "1.3.0",
+			ExpectedApproved: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ip := &alpha1.InstallPlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "install-abcde",
+					Namespace: "test-ns",
+				},
+				Spec: alpha1.InstallPlanSpec{
+					ClusterServiceVersionNames: []string{"test-operator.v1.2.0"},
+				},
+			}
+			client := fakeclient.NewFakeClientWithScheme(scheme, ip.DeepCopy())
+
+			if err := upgradeApproval(context.TODO(), backup.NewNoopBackupExecutor(), client, ip, tc.OperatorVersion); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			updated := &alpha1.InstallPlan{}
+			if err := client.Get(context.TODO(), k8sclient.ObjectKey{Name: ip.Name, Namespace: ip.Namespace}, updated); err != nil {
+				t.Fatalf("failed to get install plan: %v", err)
+			}
+			if updated.Spec.Approved != tc.ExpectedApproved {
+				t.Fatalf("expected install plan approved to be %v but got %v", tc.ExpectedApproved, updated.Spec.Approved)
+			}
+		})
+	}
+}
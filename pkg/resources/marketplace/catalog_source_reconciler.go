@@ -3,10 +3,45 @@ package marketplace
 import (
 	"context"
 
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// DefaultExistingCatalogSourceNamespace is the namespace of existing catalog sources, the global catalog namespace of
+// OLM
+const DefaultExistingCatalogSourceNamespace = "openshift-marketplace"
+
 type CatalogSourceReconciler interface {
 	Reconcile(ctx context.Context) (reconcile.Result, error)
 	CatalogSourceName() string
+	CatalogSourceNamespace() string
+}
+
+// NewCatalogSourceReconciler returns the reconciler of the catalog source selected for a product, whose manifests are
+// embedded in the manifestsProductDirectory, and the target subscribing to it. The catalog sources managed by the
+// operator are created in the namespace of the target. Index images and existing catalog sources are not built for
+// the operator, so they can pin the package and channel of the product
+func NewCatalogSourceReconciler(spec integreatlyv1alpha1.CatalogSourceSpec, target Target, manifestsProductDirectory string, client k8sclient.Client, catalogSourceName string) (CatalogSourceReconciler, Target) {
+	if spec.Package != "" {
+		target.Package = spec.Package
+	}
+	if spec.Channel != "" {
+		target.Channel = spec.Channel
+	}
+
+	switch spec.Type {
+	case integreatlyv1alpha1.CatalogSourceRegistryImage:
+		return NewGRPCImageCatalogSourceReconciler(spec.Image, client, target.Namespace, catalogSourceName), target
+	case integreatlyv1alpha1.CatalogSourceIndexImage:
+		return NewIndexImageCatalogSourceReconciler(spec.Image, spec.PollInterval, client, target.Namespace, catalogSourceName), target
+	case integreatlyv1alpha1.CatalogSourceExisting:
+		namespace := spec.Namespace
+		if namespace == "" {
+			namespace = DefaultExistingCatalogSourceNamespace
+		}
+		return NewExistingCatalogSourceReconciler(client, namespace, spec.Name), target
+	default:
+		return NewConfigMapCatalogSourceReconciler(manifestsProductDirectory, client, target.Namespace, catalogSourceName), target
+	}
 }
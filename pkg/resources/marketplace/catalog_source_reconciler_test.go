@@ -0,0 +1,91 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewCatalogSourceReconciler(t *testing.T) {
+	target := Target{Namespace: "test-namespace", Pkg: "rhmi-3scale", Channel: IntegreatlyChannel}
+
+	scenarios := []struct {
+		Name            string
+		Spec            integreatlyv1alpha1.CatalogSourceSpec
+		Verify          func(reconciler CatalogSourceReconciler) bool
+		ExpectedName    string
+		ExpectedNS      string
+		ExpectedPkg     string
+		ExpectedChannel string
+	}{
+		{
+			Name: "Test embedded manifests by default",
+			Spec: integreatlyv1alpha1.CatalogSourceSpec{},
+			Verify: func(reconciler CatalogSourceReconciler) bool {
+				_, ok := reconciler.(*ConfigMapCatalogSourceReconciler)
+				return ok
+			},
+			ExpectedName:    CatalogSourceName,
+			ExpectedNS:      "test-namespace",
+			ExpectedChannel: IntegreatlyChannel,
+		},
+		{
+			Name: "Test registry image",
+			Spec: integreatlyv1alpha1.CatalogSourceSpec{Type: integreatlyv1alpha1.CatalogSourceRegistryImage, Image: "registry.example.com/rhmi/3scale:v0.6.0"},
+			Verify: func(reconciler CatalogSourceReconciler) bool {
+				r, ok := reconciler.(*GRPCImageCatalogSourceReconciler)
+				return ok && r.Image == "registry.example.com/rhmi/3scale:v0.6.0"
+			},
+			ExpectedName:    CatalogSourceName,
+			ExpectedNS:      "test-namespace",
+			ExpectedChannel: IntegreatlyChannel,
+		},
+		{
+			Name: "Test index image pinning the package and channel",
+			Spec: integreatlyv1alpha1.CatalogSourceSpec{
+				Type:         integreatlyv1alpha1.CatalogSourceIndexImage,
+				Image:        "registry.example.com/rhmi/index:v2.5",
+				PollInterval: &metav1.Duration{Duration: 30 * time.Minute},
+				Package:      "3scale-operator",
+				Channel:      "threescale-2.8",
+			},
+			Verify: func(reconciler CatalogSourceReconciler) bool {
+				r, ok := reconciler.(*IndexImageCatalogSourceReconciler)
+				return ok && r.PollInterval.Duration == 30*time.Minute
+			},
+			ExpectedName:    CatalogSourceName,
+			ExpectedNS:      "test-namespace",
+			ExpectedPkg:     "3scale-operator",
+			ExpectedChannel: "threescale-2.8",
+		},
+		{
+			Name: "Test existing catalog source in openshift-marketplace by default",
+			Spec: integreatlyv1alpha1.CatalogSourceSpec{Type: integreatlyv1alpha1.CatalogSourceExisting, Name: "redhat-operators"},
+			Verify: func(reconciler CatalogSourceReconciler) bool {
+				_, ok := reconciler.(*ExistingCatalogSourceReconciler)
+				return ok
+			},
+			ExpectedName:    "redhat-operators",
+			ExpectedNS:      DefaultExistingCatalogSourceNamespace,
+			ExpectedChannel: IntegreatlyChannel,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			reconciler, got := NewCatalogSourceReconciler(scenario.Spec, target, "integreatly-3scale", fake.NewFakeClient(), CatalogSourceName)
+			if !scenario.Verify(reconciler) {
+				t.Fatalf("Unexpected catalog source reconciler %#v", reconciler)
+			}
+			if reconciler.CatalogSourceName() != scenario.ExpectedName || reconciler.CatalogSourceNamespace() != scenario.ExpectedNS {
+				t.Fatalf("Expected catalog source %s/%s but got %s/%s", scenario.ExpectedNS, scenario.ExpectedName, reconciler.CatalogSourceNamespace(), reconciler.CatalogSourceName())
+			}
+			if got.Pkg != target.Pkg || got.Package != scenario.ExpectedPkg || got.Channel != scenario.ExpectedChannel {
+				t.Fatalf("Expected target to subscribe to package %q from channel %s but got %v", scenario.ExpectedPkg, scenario.ExpectedChannel, got)
+			}
+		})
+	}
+}
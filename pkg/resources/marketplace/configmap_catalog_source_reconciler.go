@@ -10,17 +10,21 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// ConfigMapCatalogSourceReconciler serves the manifests of a product from ConfigMaps. Manifests larger than a
+// ConfigMap are sharded across several ConfigMaps and catalog sources, the first one serving the newest versions
 type ConfigMapCatalogSourceReconciler struct {
 	ManifestsProductDirectory string
 	Client                    k8sclient.Client
 	Namespace                 string
 	CSName                    string
+	MaxConfigMapDataSize      int
 }
 
 var _ CatalogSourceReconciler = &ConfigMapCatalogSourceReconciler{}
@@ -31,6 +35,7 @@ func NewConfigMapCatalogSourceReconciler(manifestsProductDirectory string, clien
 		Client:                    client,
 		Namespace:                 namespace,
 		CSName:                    catalogSourceName,
+		MaxConfigMapDataSize:      MaxConfigMapDataSize,
 	}
 }
 
@@ -38,29 +43,74 @@ func (r *ConfigMapCatalogSourceReconciler) CatalogSourceName() string {
 	return r.CSName
 }
 
+func (r *ConfigMapCatalogSourceReconciler) CatalogSourceNamespace() string {
+	return r.Namespace
+}
+
 func (r *ConfigMapCatalogSourceReconciler) Reconcile(ctx context.Context) (reconcile.Result, error) {
-	configMapData, err := GenerateRegistryConfigMapFromManifest(r.ManifestsProductDirectory)
+	shards, err := GenerateRegistryConfigMapShardsFromManifest(r.ManifestsProductDirectory, r.MaxConfigMapDataSize)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("Failed to generated config map data from manifest: %w", err)
 	}
 
-	configMapName, err := r.reconcileRegistryConfigMap(ctx, configMapData)
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("Failed to reconcile config map for registry: %w", err)
+	for shard, configMapData := range shards {
+		configMapName, err := r.reconcileRegistryConfigMap(ctx, shard, configMapData)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to reconcile config map for registry: %w", err)
+		}
+
+		res, err := r.reconcileCatalogSource(ctx, shard, configMapName)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to reconcile catalog source for registry: %w", err)
+		}
+		if res.Requeue {
+			return res, nil
+		}
 	}
 
-	res, err := r.reconcileCatalogSource(ctx, configMapName)
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("Failed to reconcile catalog source for registry: %w", err)
+	if err := r.deleteStaleShards(ctx, len(shards)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed to delete stale registry shards: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// shardName returns the name of the ConfigMap or catalog source of a shard, the first shard keeping the name used
+// before the manifests were sharded
+func shardName(name string, shard int) string {
+	if shard == 0 {
+		return name
 	}
+	return fmt.Sprintf("%s-%d", name, shard)
+}
 
-	return res, nil
+// deleteStaleShards deletes the ConfigMaps and catalog sources of the shards beyond the current ones, left over
+// when the manifests shrink
+func (r *ConfigMapCatalogSourceReconciler) deleteStaleShards(ctx context.Context, shards int) error {
+	for shard := shards; shard < MaxRegistryShards; shard++ {
+		deleted := false
+		for _, obj := range []runtime.Object{
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: shardName("registry-cm-"+r.Namespace, shard), Namespace: r.Namespace}},
+			&coreosv1alpha1.CatalogSource{ObjectMeta: metav1.ObjectMeta{Name: shardName(r.CatalogSourceName(), shard), Namespace: r.Namespace}},
+		} {
+			err := r.Client.Delete(ctx, obj)
+			if err != nil && !k8serr.IsNotFound(err) {
+				return err
+			}
+			deleted = deleted || err == nil
+		}
+		if !deleted {
+			return nil
+		}
+		logrus.Infof("Deleted stale registry shard %d for namespace %s", shard, r.Namespace)
+	}
+	return nil
 }
 
-func (r *ConfigMapCatalogSourceReconciler) reconcileRegistryConfigMap(ctx context.Context, configMapData map[string]string) (string, error) {
+func (r *ConfigMapCatalogSourceReconciler) reconcileRegistryConfigMap(ctx context.Context, shard int, configMapData map[string]string) (string, error) {
 	logrus.Infof("Reconciling registry config map for namespace %s", r.Namespace)
 
-	configMapName := "registry-cm-" + r.Namespace
+	configMapName := shardName("registry-cm-"+r.Namespace, shard)
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: r.Namespace,
@@ -95,12 +145,12 @@ func (r *ConfigMapCatalogSourceReconciler) reconcileRegistryConfigMap(ctx contex
 	return configMapName, nil
 }
 
-func (r *ConfigMapCatalogSourceReconciler) reconcileCatalogSource(ctx context.Context, configMapName string) (reconcile.Result, error) {
+func (r *ConfigMapCatalogSourceReconciler) reconcileCatalogSource(ctx context.Context, shard int, configMapName string) (reconcile.Result, error) {
 	logrus.Infof("Reconciling registry catalog source for namespace %s", r.Namespace)
 
 	catalogSource := &coreosv1alpha1.CatalogSource{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      r.CatalogSourceName(),
+			Name:      shardName(r.CatalogSourceName(), shard),
 			Namespace: r.Namespace,
 		},
 	}
@@ -108,7 +158,7 @@ func (r *ConfigMapCatalogSourceReconciler) reconcileCatalogSource(ctx context.Co
 	catalogSourceSpec := coreosv1alpha1.CatalogSourceSpec{
 		SourceType:  coreosv1alpha1.SourceTypeConfigmap,
 		ConfigMap:   configMapName,
-		DisplayName: r.CatalogSourceName(),
+		DisplayName: catalogSource.Name,
 		Publisher:   Publisher,
 	}
 
@@ -146,7 +146,7 @@ func TestConfigMapCatalogSourceReconcilerReconcileCatalogSource(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.Name, func(t *testing.T) {
 			csReconciler := NewConfigMapCatalogSourceReconciler("example-manifestproduct-dir", scenario.FakeClient, testNameSpace, scenario.DesiredCatalogSourceName)
-			res, err := csReconciler.reconcileCatalogSource(context.TODO(), scenario.DesiredConfigMapName)
+			res, err := csReconciler.reconcileCatalogSource(context.TODO(), 0, scenario.DesiredConfigMapName)
 			scenario.Verify(scenario.DesiredCatalogSourceName, scenario.DesiredConfigMapName, res, err, scenario.FakeClient)
 		})
 	}
@@ -249,8 +249,53 @@ func TestConfigMapCatalogSourceReconcilerRegistryConfigMap(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.Name, func(t *testing.T) {
 			csReconciler := NewConfigMapCatalogSourceReconciler("example-manifestproduct-dir", scenario.FakeClient, testNameSpace, "csName")
-			desiredConfigMapName, err := csReconciler.reconcileRegistryConfigMap(context.TODO(), scenario.FakeMapData)
+			desiredConfigMapName, err := csReconciler.reconcileRegistryConfigMap(context.TODO(), 0, scenario.FakeMapData)
 			scenario.Verify(desiredConfigMapName, err, scenario.FakeClient, scenario.FakeMapData)
 		})
 	}
 }
+
+func TestConfigMapCatalogSourceReconcilerReconcileShards(t *testing.T) {
+	defer writeTestManifests(t)()
+
+	testNameSpace := "test-namespace"
+	fakeClient := fake.NewFakeClientWithScheme(buildConfigMapCatalogSourceReconcilerTestScheme(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "registry-cm-" + testNameSpace + "-3",
+			Namespace: testNameSpace,
+		},
+	})
+
+	csReconciler := NewConfigMapCatalogSourceReconciler(testManifestPackage, fakeClient, testNameSpace, "csName")
+	csReconciler.MaxConfigMapDataSize = 3000
+	if _, err := csReconciler.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	for shard, name := range []string{"csName", "csName-1", "csName-2"} {
+		catalogSource := &coreosv1alpha1.CatalogSource{}
+		if err := fakeClient.Get(context.TODO(), k8sclient.ObjectKey{Name: name, Namespace: testNameSpace}, catalogSource); err != nil {
+			t.Fatalf("Expected catalog source of shard %d to be created but wasn't: %v", shard, err)
+		}
+		configMap := &corev1.ConfigMap{}
+		if err := fakeClient.Get(context.TODO(), k8sclient.ObjectKey{Name: catalogSource.Spec.ConfigMap, Namespace: testNameSpace}, configMap); err != nil {
+			t.Fatalf("Expected config map of shard %d to be created but wasn't: %v", shard, err)
+		}
+	}
+	err := fakeClient.Get(context.TODO(), k8sclient.ObjectKey{Name: "registry-cm-" + testNameSpace + "-3", Namespace: testNameSpace}, &corev1.ConfigMap{})
+	if !k8serr.IsNotFound(err) {
+		t.Fatalf("Expected stale config map to be deleted, got %v", err)
+	}
+
+	csReconciler.MaxConfigMapDataSize = MaxConfigMapDataSize
+	if _, err := csReconciler.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	catalogSources := &coreosv1alpha1.CatalogSourceList{}
+	if err := fakeClient.List(context.TODO(), catalogSources); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(catalogSources.Items) != 1 || catalogSources.Items[0].Spec.ConfigMap != "registry-cm-"+testNameSpace {
+		t.Fatalf("Expected only the catalog source of the first shard to be left once the manifests fit, got %v", catalogSources.Items)
+	}
+}
@@ -0,0 +1,51 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	coreosv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ExistingCatalogSourceReconciler subscribes the product operator to a catalog source managed outside of the
+// operator, such as a mirror of the Red Hat operators in openshift-marketplace. It only checks that the catalog
+// source exists, as the subscription would otherwise never resolve
+type ExistingCatalogSourceReconciler struct {
+	Client    k8sclient.Client
+	Namespace string
+	CSName    string
+}
+
+var _ CatalogSourceReconciler = &ExistingCatalogSourceReconciler{}
+
+func NewExistingCatalogSourceReconciler(client k8sclient.Client, namespace string, catalogSourceName string) *ExistingCatalogSourceReconciler {
+	return &ExistingCatalogSourceReconciler{
+		Client:    client,
+		Namespace: namespace,
+		CSName:    catalogSourceName,
+	}
+}
+
+func (r *ExistingCatalogSourceReconciler) Reconcile(ctx context.Context) (reconcile.Result, error) {
+	catalogSource := &coreosv1alpha1.CatalogSource{}
+	err := r.Client.Get(ctx, k8sclient.ObjectKey{Name: r.CatalogSourceName(), Namespace: r.Namespace}, catalogSource)
+	if k8serr.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("catalog source %s not found in %s namespace", r.CatalogSourceName(), r.Namespace)
+	}
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get catalog source %s from %s namespace: %w", r.CatalogSourceName(), r.Namespace, err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *ExistingCatalogSourceReconciler) CatalogSourceName() string {
+	return r.CSName
+}
+
+func (r *ExistingCatalogSourceReconciler) CatalogSourceNamespace() string {
+	return r.Namespace
+}
@@ -0,0 +1,29 @@
+package marketplace
+
+import (
+	"context"
+	"testing"
+
+	coreosv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestExistingCatalogSourceReconcilerReconcile(t *testing.T) {
+	existing := &coreosv1alpha1.CatalogSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "redhat-operators",
+			Namespace: DefaultExistingCatalogSourceNamespace,
+		},
+	}
+
+	csReconciler := NewExistingCatalogSourceReconciler(fake.NewFakeClientWithScheme(buildGRPCImageCatalogSourceReconcilerTestScheme(), existing), DefaultExistingCatalogSourceNamespace, "redhat-operators")
+	if _, err := csReconciler.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	csReconciler = NewExistingCatalogSourceReconciler(fake.NewFakeClientWithScheme(buildGRPCImageCatalogSourceReconcilerTestScheme()), DefaultExistingCatalogSourceNamespace, "redhat-operators")
+	if _, err := csReconciler.Reconcile(context.TODO()); err == nil {
+		t.Fatalf("Expected error for a missing catalog source but got none")
+	}
+}
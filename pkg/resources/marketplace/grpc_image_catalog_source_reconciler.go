@@ -75,3 +75,7 @@ func (r *GRPCImageCatalogSourceReconciler) Reconcile(ctx context.Context) (recon
 func (r *GRPCImageCatalogSourceReconciler) CatalogSourceName() string {
 	return r.CSName
 }
+
+func (r *GRPCImageCatalogSourceReconciler) CatalogSourceNamespace() string {
+	return r.Namespace
+}
@@ -0,0 +1,90 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	coreosv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// IndexImageCatalogSourceReconciler serves the product operator from an index image holding the bundles of several
+// operators, such as a file-based catalog or a mirror of the Red Hat operator index. Unlike the registry image of a
+// product, an index image can be updated in place, so it is polled for updates when a poll interval is set
+type IndexImageCatalogSourceReconciler struct {
+	Image        string
+	PollInterval *metav1.Duration
+	Client       k8sclient.Client
+	Namespace    string
+	CSName       string
+}
+
+var _ CatalogSourceReconciler = &IndexImageCatalogSourceReconciler{}
+
+func NewIndexImageCatalogSourceReconciler(image string, pollInterval *metav1.Duration, client k8sclient.Client, namespace string, catalogSourceName string) *IndexImageCatalogSourceReconciler {
+	return &IndexImageCatalogSourceReconciler{
+		Image:        image,
+		PollInterval: pollInterval,
+		Client:       client,
+		Namespace:    namespace,
+		CSName:       catalogSourceName,
+	}
+}
+
+func (r *IndexImageCatalogSourceReconciler) Reconcile(ctx context.Context) (reconcile.Result, error) {
+	logrus.Infof("Reconciling index catalog source for namespace %s", r.Namespace)
+
+	catalogSource := &coreosv1alpha1.CatalogSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.CatalogSourceName(),
+			Namespace: r.Namespace,
+		},
+	}
+
+	catalogSourceSpec := coreosv1alpha1.CatalogSourceSpec{
+		SourceType:  coreosv1alpha1.SourceTypeGrpc,
+		Image:       r.Image,
+		DisplayName: r.CatalogSourceName(),
+		Publisher:   Publisher,
+	}
+	if r.PollInterval != nil {
+		catalogSourceSpec.UpdateStrategy = &coreosv1alpha1.UpdateStrategy{
+			RegistryPoll: &coreosv1alpha1.RegistryPoll{Interval: r.PollInterval},
+		}
+	}
+
+	or, err := controllerutil.CreateOrUpdate(ctx, r.Client, catalogSource, func() error {
+		catalogSource.Spec = catalogSourceSpec
+		return nil
+	})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create/update index catalog source for namespace '%s': %w", r.Namespace, err)
+	}
+
+	switch or {
+	case controllerutil.OperationResultCreated:
+		logrus.Infof("Created index catalog source for namespace %s", r.Namespace)
+	case controllerutil.OperationResultUpdated:
+		logrus.Infof("Updated index catalog source for namespace %s", r.Namespace)
+	case controllerutil.OperationResultNone:
+		break
+	default:
+		return reconcile.Result{}, fmt.Errorf("Unknown controllerutil.OperationResult '%v'", or)
+	}
+
+	logrus.Infof("Successfully reconciled index catalog source for namespace %s", r.Namespace)
+
+	return reconcile.Result{}, nil
+}
+
+func (r *IndexImageCatalogSourceReconciler) CatalogSourceName() string {
+	return r.CSName
+}
+
+func (r *IndexImageCatalogSourceReconciler) CatalogSourceNamespace() string {
+	return r.Namespace
+}
@@ -0,0 +1,60 @@
+package marketplace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coreosv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIndexImageCatalogSourceReconcilerReconcile(t *testing.T) {
+
+	testNameSpace := "test-namespace"
+
+	scenarios := []struct {
+		Name         string
+		PollInterval *metav1.Duration
+		Verify       func(catalogSource *coreosv1alpha1.CatalogSource)
+	}{
+		{
+			Name: "Test catalog source created without polling",
+			Verify: func(catalogSource *coreosv1alpha1.CatalogSource) {
+				if catalogSource.Spec.UpdateStrategy != nil {
+					t.Fatalf("Unexpected CatalogSource 'updateStrategy' attribute set")
+				}
+			},
+		},
+		{
+			Name:         "Test catalog source created polling the index image",
+			PollInterval: &metav1.Duration{Duration: 30 * time.Minute},
+			Verify: func(catalogSource *coreosv1alpha1.CatalogSource) {
+				if catalogSource.Spec.UpdateStrategy == nil || catalogSource.Spec.UpdateStrategy.RegistryPoll.Interval.Duration != 30*time.Minute {
+					t.Fatalf("CatalogSource poll interval not reconciled: %v", catalogSource.Spec.UpdateStrategy)
+				}
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			fakeClient := fake.NewFakeClientWithScheme(buildGRPCImageCatalogSourceReconcilerTestScheme())
+			csReconciler := NewIndexImageCatalogSourceReconciler("registry.example.com/rhmi/index:v2.5", scenario.PollInterval, fakeClient, testNameSpace, CatalogSourceName)
+			if _, err := csReconciler.Reconcile(context.TODO()); err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			catalogSource := &coreosv1alpha1.CatalogSource{}
+			if err := fakeClient.Get(context.TODO(), k8sclient.ObjectKey{Name: CatalogSourceName, Namespace: testNameSpace}, catalogSource); err != nil {
+				t.Fatalf("Expected catalog source to be created but wasn't: %v", err)
+			}
+			if catalogSource.Spec.SourceType != coreosv1alpha1.SourceTypeGrpc || catalogSource.Spec.Image != "registry.example.com/rhmi/index:v2.5" {
+				t.Fatalf("CatalogSource not reconciled from the index image: %v", catalogSource.Spec)
+			}
+			scenario.Verify(catalogSource)
+		})
+	}
+}
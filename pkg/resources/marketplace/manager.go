@@ -37,6 +37,13 @@ type Target struct {
 	Namespace,
 	Pkg,
 	Channel string
+
+	// Package, when set, is the package subscribed to, as named in the catalog source. Pkg still names the
+	// subscription. Defaults to Pkg
+	Package string
+
+	// OperatorVersion, when set, is the only version of the operator whose install plans are approved
+	OperatorVersion string
 }
 
 func (m *Manager) InstallOperator(ctx context.Context, serverClient k8sclient.Client, t Target, operatorGroupNamespaces []string, approvalStrategy coreosv1alpha1.Approval, catalogSourceReconciler CatalogSourceReconciler) error {
@@ -47,10 +54,9 @@ func (m *Manager) InstallOperator(ctx context.Context, serverClient k8sclient.Cl
 		},
 	}
 	sub.Spec = &coreosv1alpha1.SubscriptionSpec{
-		InstallPlanApproval:    approvalStrategy,
-		Channel:                t.Channel,
-		Package:                t.Pkg,
-		CatalogSourceNamespace: t.Namespace,
+		InstallPlanApproval: approvalStrategy,
+		Channel:             t.Channel,
+		Package:             t.subscriptionPackage(),
 	}
 
 	res, err := catalogSourceReconciler.Reconcile(ctx)
@@ -61,6 +67,7 @@ func (m *Manager) InstallOperator(ctx context.Context, serverClient k8sclient.Cl
 		return err
 	}
 	sub.Spec.CatalogSource = catalogSourceReconciler.CatalogSourceName()
+	sub.Spec.CatalogSourceNamespace = catalogSourceReconciler.CatalogSourceNamespace()
 
 	//catalog source is ready create the other stuff
 	og := &v1.OperatorGroup{
@@ -85,11 +92,48 @@ func (m *Manager) InstallOperator(ctx context.Context, serverClient k8sclient.Cl
 		logrus.Infof("error creating sub")
 		return err
 	}
+	if k8serr.IsAlreadyExists(err) {
+		return m.reconcileSubscriptionSource(ctx, serverClient, t, sub.Spec)
+	}
 
 	return nil
 
 }
 
+// subscriptionPackage is the package subscribed to in the catalog source
+func (t Target) subscriptionPackage() string {
+	if t.Package != "" {
+		return t.Package
+	}
+	return t.Pkg
+}
+
+// reconcileSubscriptionSource moves an existing subscription to the catalog source, package and channel of the
+// target, allowing the channel or catalog source of a product to be changed after it is installed
+func (m *Manager) reconcileSubscriptionSource(ctx context.Context, serverClient k8sclient.Client, t Target, spec *coreosv1alpha1.SubscriptionSpec) error {
+	sub, err := m.getSubscription(ctx, serverClient, t.Pkg, t.Namespace)
+	if err != nil {
+		return err
+	}
+	if sub.Spec == nil ||
+		(sub.Spec.Channel == spec.Channel &&
+			sub.Spec.Package == spec.Package &&
+			sub.Spec.CatalogSource == spec.CatalogSource &&
+			sub.Spec.CatalogSourceNamespace == spec.CatalogSourceNamespace) {
+		return nil
+	}
+
+	logrus.Infof("moving subscription %s in ns %s from %s/%s channel %s to %s/%s channel %s", t.Pkg, t.Namespace, sub.Spec.CatalogSourceNamespace, sub.Spec.CatalogSource, sub.Spec.Channel, spec.CatalogSourceNamespace, spec.CatalogSource, spec.Channel)
+	sub.Spec.Channel = spec.Channel
+	sub.Spec.Package = spec.Package
+	sub.Spec.CatalogSource = spec.CatalogSource
+	sub.Spec.CatalogSourceNamespace = spec.CatalogSourceNamespace
+	if err := serverClient.Update(ctx, sub); err != nil {
+		return fmt.Errorf("error updating subscription %s: %w", t.Pkg, err)
+	}
+	return nil
+}
+
 func (m *Manager) getSubscription(ctx context.Context, serverClient k8sclient.Client, subName, ns string) (*coreosv1alpha1.Subscription, error) {
 	sub := &coreosv1alpha1.Subscription{
 		ObjectMeta: metav1.ObjectMeta{
@@ -239,14 +239,19 @@ func ProcessYamlFile(path string, stringList *strings.Builder) error {
 
 // Process each line of files from manifest for correct yaml format to use in config map
 func ReadAndFormatManifestYamlFile(path string) (string, error) {
-	var formattedString strings.Builder
-
 	content, err := ioutil.ReadFile(path)
 	if err != nil {
 		return "", err
 	}
 
-	linesSplit := strings.Split(string(content), "\n")
+	return formatManifestYaml(string(content)), nil
+}
+
+// formatManifestYaml formats the content of a manifest yaml file as an item of a yaml list
+func formatManifestYaml(content string) string {
+	var formattedString strings.Builder
+
+	linesSplit := strings.Split(content, "\n")
 
 	for i := 0; i < len(linesSplit); i++ {
 		// For the first line check and append - at start of line
@@ -264,7 +269,7 @@ func ReadAndFormatManifestYamlFile(path string) (string, error) {
 
 	}
 
-	return formattedString.String(), nil
+	return formattedString.String()
 }
 
 // Gets the version number from the package yaml string
@@ -0,0 +1,309 @@
+package marketplace
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"gopkg.in/yaml.v2"
+)
+
+// MaxConfigMapDataSize is the size of the data of a ConfigMap accepted by the API server
+const MaxConfigMapDataSize = 1024 * 1024
+
+// MaxRegistryShards is the number of ConfigMaps and catalog sources a manifest package can be sharded across. The
+// operator is only allowed to update and delete the catalog sources of these shards, see deploy/role.yaml
+const MaxRegistryShards = 4
+
+// manifestBundle is a version folder of a manifest package
+type manifestBundle struct {
+	version  string
+	csvName  string
+	csvPath  string
+	crdPaths []string
+}
+
+type manifestPackage struct {
+	PackageName    string            `yaml:"packageName"`
+	Channels       []manifestChannel `yaml:"channels"`
+	DefaultChannel string            `yaml:"defaultChannel,omitempty"`
+}
+
+type manifestChannel struct {
+	Name       string `yaml:"name"`
+	CurrentCSV string `yaml:"currentCSV"`
+}
+
+// GenerateRegistryConfigMapShardsFromManifest generates the data of the ConfigMaps serving a manifest package, none
+// of them larger than maxSize. The package is served from a single ConfigMap, as generated by
+// GenerateRegistryConfigMapFromManifest, while it fits. Otherwise its versions are split across shards, newest first,
+// each shard serving the package with its newest version as the current CSV. The oldest version of a shard skips the
+// versions of the following shards instead of replacing one of them, so that the catalog of each shard is complete
+// and that an operator installed from any version upgrades from the first shard
+func GenerateRegistryConfigMapShardsFromManifest(manifestPackageName string, maxSize int) ([]map[string]string, error) {
+	configMapData, err := GenerateRegistryConfigMapFromManifest(manifestPackageName)
+	if err != nil {
+		return nil, err
+	}
+	if configMapDataSize(configMapData) <= maxSize {
+		return []map[string]string{configMapData}, nil
+	}
+
+	manifestDir := fmt.Sprintf("%s/%s", GetManifestDirEnvVar(), manifestPackageName)
+	packagePath, pkg, err := readManifestPackage(manifestDir)
+	if err != nil {
+		return nil, err
+	}
+	bundles, err := readManifestBundles(manifestDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var shards []map[string]string
+	for start := 0; start < len(bundles); {
+		end := start + 1
+		shard, err := generateRegistryConfigMapShard(packagePath, pkg, bundles, start, end)
+		if err != nil {
+			return nil, err
+		}
+		if configMapDataSize(shard) > maxSize {
+			return nil, fmt.Errorf("version %s of %s does not fit in a config map of %d bytes", bundles[start].version, manifestPackageName, maxSize)
+		}
+		for ; end < len(bundles); end++ {
+			next, err := generateRegistryConfigMapShard(packagePath, pkg, bundles, start, end+1)
+			if err != nil {
+				return nil, err
+			}
+			if configMapDataSize(next) > maxSize {
+				break
+			}
+			shard = next
+		}
+		shards = append(shards, shard)
+		start = end
+	}
+	if len(shards) > MaxRegistryShards {
+		return nil, fmt.Errorf("%s needs %d shards, more than the %d allowed", manifestPackageName, len(shards), MaxRegistryShards)
+	}
+	return shards, nil
+}
+
+// generateRegistryConfigMapShard generates the data of the ConfigMap serving the bundles from start to end, the
+// bundles being ordered newest first. The CRDs of each kind are the ones of the newest bundle of the shard
+func generateRegistryConfigMapShard(packagePath string, pkg manifestPackage, bundles []manifestBundle, start, end int) (map[string]string, error) {
+	var csvStringList, crdStringList strings.Builder
+	crdKinds := map[string]bool{}
+	for i := start; i < end; i++ {
+		bundle := bundles[i]
+		if i == end-1 && end < len(bundles) {
+			csv, err := skipOlderVersions(bundle.csvPath, bundles[end:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to skip older versions in %s: %w", bundle.csvPath, err)
+			}
+			csvStringList.WriteString(csv)
+		} else if err := ProcessYamlFile(bundle.csvPath, &csvStringList); err != nil {
+			return nil, err
+		}
+
+		for _, crdPath := range bundle.crdPaths {
+			kind, err := getCRDKind(crdPath)
+			if err != nil {
+				return nil, err
+			}
+			if crdKinds[kind] {
+				continue
+			}
+			crdKinds[kind] = true
+			if err := ProcessYamlFile(crdPath, &crdStringList); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	packageString, err := ReadAndFormatManifestYamlFile(packagePath)
+	if err != nil {
+		return nil, err
+	}
+	if start > 0 {
+		for i := range pkg.Channels {
+			pkg.Channels[i].CurrentCSV = bundles[start].csvName
+		}
+		content, err := yaml.Marshal(pkg)
+		if err != nil {
+			return nil, err
+		}
+		packageString = formatManifestYaml(string(content))
+	}
+
+	return map[string]string{
+		"clusterServiceVersions":    csvStringList.String(),
+		"customResourceDefinitions": crdStringList.String(),
+		"packages":                  packageString,
+	}, nil
+}
+
+// skipOlderVersions returns the CSV with the version it replaces moved to its skips, along with the older versions
+func skipOlderVersions(csvPath string, older []manifestBundle) (string, error) {
+	content, err := ioutil.ReadFile(csvPath)
+	if err != nil {
+		return "", err
+	}
+	csv := yaml.MapSlice{}
+	if err := yaml.Unmarshal(content, &csv); err != nil {
+		return "", err
+	}
+
+	for i, item := range csv {
+		if item.Key != "spec" {
+			continue
+		}
+		spec, ok := item.Value.(yaml.MapSlice)
+		if !ok {
+			return "", fmt.Errorf("invalid spec of cluster service version")
+		}
+
+		skips := []interface{}{}
+		rewritten := yaml.MapSlice{}
+		for _, field := range spec {
+			switch field.Key {
+			case "replaces":
+			case "skips":
+				if values, ok := field.Value.([]interface{}); ok {
+					skips = append(skips, values...)
+				}
+			default:
+				rewritten = append(rewritten, field)
+			}
+		}
+		for _, bundle := range older {
+			if !containsValue(skips, bundle.csvName) {
+				skips = append(skips, bundle.csvName)
+			}
+		}
+		csv[i].Value = append(rewritten, yaml.MapItem{Key: "skips", Value: skips})
+	}
+
+	content, err = yaml.Marshal(csv)
+	if err != nil {
+		return "", err
+	}
+	return formatManifestYaml(string(content)), nil
+}
+
+// readManifestPackage reads the package yaml of the manifest package
+func readManifestPackage(manifestDir string) (string, manifestPackage, error) {
+	pkg := manifestPackage{}
+	packagePaths, err := filepath.Glob(filepath.Join(manifestDir, "*.package.yaml"))
+	if err != nil {
+		return "", pkg, err
+	}
+	if len(packagePaths) != 1 {
+		return "", pkg, fmt.Errorf("expected a package yaml in %s, found %d", manifestDir, len(packagePaths))
+	}
+
+	content, err := ioutil.ReadFile(packagePaths[0])
+	if err != nil {
+		return "", pkg, err
+	}
+	if err := yaml.Unmarshal(content, &pkg); err != nil {
+		return "", pkg, fmt.Errorf("failed to parse %s: %w", packagePaths[0], err)
+	}
+	return packagePaths[0], pkg, nil
+}
+
+// readManifestBundles reads the version folders of the manifest package, newest first
+func readManifestBundles(manifestDir string) ([]manifestBundle, error) {
+	files, err := ioutil.ReadDir(manifestDir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []*semver.Version
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		version, err := semver.NewVersion(f.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse version folder %s of %s: %w", f.Name(), manifestDir, err)
+		}
+		versions = append(versions, version)
+	}
+	sort.Sort(sort.Reverse(semver.Collection(versions)))
+
+	var bundles []manifestBundle
+	for _, version := range versions {
+		bundle := manifestBundle{version: version.Original()}
+		bundleDir := filepath.Join(manifestDir, version.Original())
+		files, err := ioutil.ReadDir(bundleDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			switch {
+			case strings.HasSuffix(f.Name(), "clusterserviceversion.yaml"):
+				bundle.csvPath = filepath.Join(bundleDir, f.Name())
+			case strings.HasSuffix(f.Name(), "crd.yaml"):
+				bundle.crdPaths = append(bundle.crdPaths, filepath.Join(bundleDir, f.Name()))
+			}
+		}
+		if bundle.csvPath == "" {
+			return nil, fmt.Errorf("no cluster service version found in %s", bundleDir)
+		}
+
+		csv := struct {
+			Metadata struct {
+				Name string `yaml:"name"`
+			} `yaml:"metadata"`
+		}{}
+		content, err := ioutil.ReadFile(bundle.csvPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(content, &csv); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", bundle.csvPath, err)
+		}
+		bundle.csvName = csv.Metadata.Name
+		bundles = append(bundles, bundle)
+	}
+	return bundles, nil
+}
+
+// getCRDKind returns the group and kind of a CRD, which identify it across the versions of a package
+func getCRDKind(crdPath string) (string, error) {
+	content, err := ioutil.ReadFile(crdPath)
+	if err != nil {
+		return "", err
+	}
+	crd := struct {
+		Spec struct {
+			Group string `yaml:"group"`
+			Names struct {
+				Kind string `yaml:"kind"`
+			} `yaml:"names"`
+		} `yaml:"spec"`
+	}{}
+	if err := yaml.Unmarshal(content, &crd); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", crdPath, err)
+	}
+	return crd.Spec.Group + "/" + crd.Spec.Names.Kind, nil
+}
+
+func configMapDataSize(configMapData map[string]string) int {
+	size := 0
+	for key, value := range configMapData {
+		size += len(key) + len(value)
+	}
+	return size
+}
+
+func containsValue(values []interface{}, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package marketplace
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+const testManifestPackage = "integreatly-test"
+
+// writeTestManifests writes a manifest package of three versions, each about 2KB, and points the manifest dir to it
+func writeTestManifests(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "manifests")
+	if err != nil {
+		t.Fatalf("failed to create manifest dir: %v", err)
+	}
+	packageDir := filepath.Join(dir, testManifestPackage)
+
+	files := map[string]string{
+		"test.package.yaml": "packageName: rhmi-test\nchannels:\n- name: rhmi\n  currentCSV: test-operator.v2.0.0\ndefaultChannel: rhmi\n",
+	}
+	replaces := ""
+	for _, version := range []string{"1.0.0", "1.1.0", "2.0.0"} {
+		csv := fmt.Sprintf("apiVersion: operators.coreos.com/v1alpha1\nkind: ClusterServiceVersion\nmetadata:\n  name: test-operator.v%s\nspec:\n  description: %s\n", version, strings.Repeat("x", 2000))
+		if replaces != "" {
+			csv += fmt.Sprintf("  replaces: %s\n", replaces)
+		}
+		csv += fmt.Sprintf("  version: %s\n", version)
+		replaces = "test-operator.v" + version
+
+		files[filepath.Join(version, "test-operator.v"+version+".clusterserviceversion.yaml")] = csv
+		files[filepath.Join(version, "example_v1_test_crd.yaml")] = fmt.Sprintf("apiVersion: apiextensions.k8s.io/v1beta1\nkind: CustomResourceDefinition\nmetadata:\n  name: tests.example.com\nspec:\n  group: example.com\n  names:\n    kind: Test\n  version: v%s\n", version)
+	}
+
+	for name, content := range files {
+		path := filepath.Join(packageDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create manifest dir: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write manifest: %v", err)
+		}
+	}
+
+	manifestDir := os.Getenv(manifestEnvVarKey)
+	os.Setenv(manifestEnvVarKey, dir)
+	return func() {
+		os.Setenv(manifestEnvVarKey, manifestDir)
+		os.RemoveAll(dir)
+	}
+}
+
+type testCSV struct {
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Replaces string   `yaml:"replaces"`
+		Skips    []string `yaml:"skips"`
+	} `yaml:"spec"`
+}
+
+func TestGenerateRegistryConfigMapShardsFromManifest(t *testing.T) {
+	defer writeTestManifests(t)()
+
+	shards, err := GenerateRegistryConfigMapShardsFromManifest(testManifestPackage, MaxConfigMapDataSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configMapData, _ := GenerateRegistryConfigMapFromManifest(testManifestPackage)
+	if len(shards) != 1 || shards[0]["packages"] != configMapData["packages"] || shards[0]["clusterServiceVersions"] != configMapData["clusterServiceVersions"] {
+		t.Fatalf("expected the manifests to be served from a single config map while they fit, got %d shards", len(shards))
+	}
+
+	shards, err = GenerateRegistryConfigMapShardsFromManifest(testManifestPackage, 3000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(shards) != 3 {
+		t.Fatalf("expected a shard per version, got %d shards", len(shards))
+	}
+
+	expected := []struct {
+		csv      string
+		replaces string
+		skips    []string
+	}{
+		{csv: "test-operator.v2.0.0", skips: []string{"test-operator.v1.1.0", "test-operator.v1.0.0"}},
+		{csv: "test-operator.v1.1.0", skips: []string{"test-operator.v1.0.0"}},
+		{csv: "test-operator.v1.0.0"},
+	}
+	for i, shard := range shards {
+		if size := configMapDataSize(shard); size > 3000 {
+			t.Fatalf("expected shard %d to fit in the config map, got %d bytes", i, size)
+		}
+
+		csvs := []testCSV{}
+		if err := yaml.Unmarshal([]byte(shard["clusterServiceVersions"]), &csvs); err != nil {
+			t.Fatalf("failed to parse the cluster service versions of shard %d: %v", i, err)
+		}
+		if len(csvs) != 1 || csvs[0].Metadata.Name != expected[i].csv || csvs[0].Spec.Replaces != expected[i].replaces || fmt.Sprint(csvs[0].Spec.Skips) != fmt.Sprint(expected[i].skips) {
+			t.Fatalf("expected shard %d to serve %s skipping %v, got %v", i, expected[i].csv, expected[i].skips, csvs)
+		}
+
+		packages := []manifestPackage{}
+		if err := yaml.Unmarshal([]byte(shard["packages"]), &packages); err != nil {
+			t.Fatalf("failed to parse the package of shard %d: %v", i, err)
+		}
+		if len(packages) != 1 || packages[0].PackageName != "rhmi-test" || packages[0].Channels[0].CurrentCSV != expected[i].csv {
+			t.Fatalf("expected the current csv of shard %d to be %s, got %v", i, expected[i].csv, packages)
+		}
+
+		if !strings.Contains(shard["customResourceDefinitions"], "version: v"+strings.TrimPrefix(expected[i].csv, "test-operator.v")) {
+			t.Fatalf("expected shard %d to serve the crds of its version, got %s", i, shard["customResourceDefinitions"])
+		}
+	}
+
+	if _, err := GenerateRegistryConfigMapShardsFromManifest(testManifestPackage, 1000); err == nil {
+		t.Fatal("expected an error when a version does not fit in a config map")
+	}
+}
@@ -20,6 +20,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/alerts"
 	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
 	"strings"
 
@@ -53,14 +54,20 @@ const (
 )
 
 func ReconcilePostgresAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) (v1alpha1.StatusPhase, error) {
+	// the overrides of the RHMIConfig are read once for all the alerts
+	overrides, err := GetAlertOverrides(ctx, client)
+	if err != nil {
+		return v1alpha1.PhaseFailed, err
+	}
+
 	// create prometheus failed rule
-	_, err := createPostgresResourceStatusPhaseFailedAlert(ctx, client, inst, cr)
+	_, err = createPostgresResourceStatusPhaseFailedAlert(ctx, client, inst, cr, overrides)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres failure alert for %s: %w", cr.Name, err)
 	}
 
 	// create the prometheus deletion rule
-	if _, err = createPostgresResourceDeletionStatusFailedAlert(ctx, client, inst, cr); err != nil {
+	if _, err = createPostgresResourceDeletionStatusFailedAlert(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres deletion prometheus alert for %s: %w", cr.Name, err)
 	}
 
@@ -69,37 +76,37 @@ func ReconcilePostgresAlerts(ctx context.Context, client k8sclient.Client, inst
 	}
 
 	// create the prometheus pending rule
-	_, err = createPostgresResourceStatusPhasePendingAlert(ctx, client, inst, cr)
+	_, err = createPostgresResourceStatusPhasePendingAlert(ctx, client, inst, cr, overrides)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres pending alert for %s: %w", cr.Name, err)
 	}
 
 	// create the prometheus availability rule
-	if _, err = createPostgresAvailabilityAlert(ctx, client, inst, cr); err != nil {
+	if _, err = createPostgresAvailabilityAlert(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres prometheus alert for %s: %w", cr.Name, err)
 	}
 
 	// create the prometheus connectivity rule
-	if _, err = createPostgresConnectivityAlert(ctx, client, inst, cr); err != nil {
+	if _, err = createPostgresConnectivityAlert(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres connectivity prometheus alert for %s: %w", cr.Name, err)
 	}
 
 	// create the prometheus deletion rule
-	if _, err = createPostgresResourceDeletionStatusFailedAlert(ctx, client, inst, cr); err != nil {
+	if _, err = createPostgresResourceDeletionStatusFailedAlert(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres deletion prometheus alert for %s: %w", cr.Name, err)
 	}
 
 	// create the prometheus free storage alert rules
-	if err = reconcilePostgresFreeStorageAlerts(ctx, client, inst, cr); err != nil {
+	if err = reconcilePostgresFreeStorageAlerts(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres free storage prometheus alerts for %s: %w", cr.Name, err)
 	}
 
-	if err = reconcilePostgresFreeableMemoryAlert(ctx, client, inst, cr); err != nil {
+	if err = reconcilePostgresFreeableMemoryAlert(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres freeable memory alert for %s: %w", cr.Name, err)
 	}
 
 	// create the prometheus high cpu alert rule
-	if err = reconcilePostgresCPUUtilizationAlerts(ctx, client, inst, cr); err != nil {
+	if err = reconcilePostgresCPUUtilizationAlerts(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create postgres cpu utilization prometheus alerts for %s: %w", cr.Name, err)
 	}
 
@@ -107,15 +114,20 @@ func ReconcilePostgresAlerts(ctx context.Context, client k8sclient.Client, inst
 }
 
 func ReconcileRedisAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) (v1alpha1.StatusPhase, error) {
+	// the overrides of the RHMIConfig are read once for all the alerts
+	overrides, err := GetAlertOverrides(ctx, client)
+	if err != nil {
+		return v1alpha1.PhaseFailed, err
+	}
 
 	// redis cr returning a failed state
-	_, err := createRedisResourceStatusPhaseFailedAlert(ctx, client, inst, cr)
+	_, err = createRedisResourceStatusPhaseFailedAlert(ctx, client, inst, cr, overrides)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create redis failure alert %s: %w", cr.Name, err)
 	}
 
 	// redis cr returning a failed state during deletion
-	_, err = createRedisResourceDeletionStatusFailedAlert(ctx, client, inst, cr)
+	_, err = createRedisResourceDeletionStatusFailedAlert(ctx, client, inst, cr, overrides)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create redis deletion failure alert for %s: %w", cr.Name, err)
 	}
@@ -125,29 +137,29 @@ func ReconcileRedisAlerts(ctx context.Context, client k8sclient.Client, inst *v1
 	}
 
 	// create prometheus pending rule
-	_, err = createRedisResourceStatusPhasePendingAlert(ctx, client, inst, cr)
+	_, err = createRedisResourceStatusPhasePendingAlert(ctx, client, inst, cr, overrides)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create redis pending alert %s: %w", cr.Name, err)
 	}
 
 	// create the prometheus availability rule
-	_, err = createRedisAvailabilityAlert(ctx, client, inst, cr)
+	_, err = createRedisAvailabilityAlert(ctx, client, inst, cr, overrides)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create redis prometheus alert for %s: %w", cr.Name, err)
 	}
 	// create backend connectivity alert
-	_, err = createRedisConnectivityAlert(ctx, client, inst, cr)
+	_, err = createRedisConnectivityAlert(ctx, client, inst, cr, overrides)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create redis prometheus connectivity alert for %s: %w", cr.Name, err)
 	}
 
 	// create Redis Memory Usage High alert
-	if err = createRedisMemoryUsageAlerts(ctx, client, inst, cr); err != nil {
+	if err = createRedisMemoryUsageAlerts(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create redis prometheus memory usage high alerts for %s: %w", cr.Name, err)
 	}
 
 	// create Redis Cpu Usage High Alert
-	if err = CreateRedisCpuUsageAlerts(ctx, client, inst, cr); err != nil {
+	if err = createRedisCpuUsageAlerts(ctx, client, inst, cr, overrides); err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create redis prometheus cpu usage high alerts for %s: %w", cr.Name, err)
 	}
 
@@ -166,8 +178,12 @@ func CreateSmtpSecretExists(ctx context.Context, client k8sclient.Client, cr *v1
 	labels := map[string]string{
 		"severity": "warning",
 	}
+	overrides, err := GetAlertOverrides(ctx, client)
+	if err != nil {
+		return v1alpha1.PhaseFailed, err
+	}
 	// create the rule
-	_, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlSendGridSmtpSecretExists, alertFor10Mins, alertExp, labels)
+	_, err = reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlSendGridSmtpSecretExists, alertFor10Mins, alertExp, labels)
 	if err != nil {
 		return v1alpha1.PhaseFailed, fmt.Errorf("failed to create sendgrid smtp exists rule err: %s", err)
 	}
@@ -176,7 +192,7 @@ func CreateSmtpSecretExists(ctx context.Context, client k8sclient.Client, cr *v1
 
 // createPostgresAvailabilityAlert creates a PrometheusRule alert to watch for the availability
 // of a Postgres instance
-func createPostgresAvailabilityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) (*prometheusv1.PrometheusRule, error) {
+func createPostgresAvailabilityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres alert creation, useClusterStorage is true")
 		return nil, nil
@@ -196,7 +212,7 @@ func createPostgresAvailabilityAlert(ctx context.Context, client k8sclient.Clien
 		"productName": cr.Labels["productName"],
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresInstanceUnavailable, alertFor5Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresInstanceUnavailable, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +221,7 @@ func createPostgresAvailabilityAlert(ctx context.Context, client k8sclient.Clien
 
 // createPostgresConnectivityAlert creates a PrometheusRule alert to watch for the connectivity
 // of a Postgres instance
-func createPostgresConnectivityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) (*prometheusv1.PrometheusRule, error) {
+func createPostgresConnectivityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres connectivity alert creation, useClusterStorage is true")
 		return nil, nil
@@ -224,7 +240,7 @@ func createPostgresConnectivityAlert(ctx context.Context, client k8sclient.Clien
 		"productName": cr.Labels["productName"],
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresConnectionFailed, alertFor5Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresConnectionFailed, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +248,7 @@ func createPostgresConnectivityAlert(ctx context.Context, client k8sclient.Clien
 }
 
 // createPostgresResourceStatusPhasePendingAlert creates a PrometheusRule alert to watch for Postgres CR state
-func createPostgresResourceStatusPhasePendingAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) (*prometheusv1.PrometheusRule, error) {
+func createPostgresResourceStatusPhasePendingAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres state alert creation, useClusterStorage is true")
 		return nil, nil
@@ -251,7 +267,7 @@ func createPostgresResourceStatusPhasePendingAlert(ctx context.Context, client k
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresResourceStatusPhasePending, alertFor20Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresResourceStatusPhasePending, alertFor20Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -259,7 +275,7 @@ func createPostgresResourceStatusPhasePendingAlert(ctx context.Context, client k
 }
 
 // createPostgresResourceStatusPhaseFailedAlert creates a PrometheusRule alert to watch for Postgres CR state
-func createPostgresResourceStatusPhaseFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) (*prometheusv1.PrometheusRule, error) {
+func createPostgresResourceStatusPhaseFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres state alert creation, useClusterStorage is true")
 		return nil, nil
@@ -278,7 +294,7 @@ func createPostgresResourceStatusPhaseFailedAlert(ctx context.Context, client k8
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresResourceStatusPhaseFailed, alertFor5Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresResourceStatusPhaseFailed, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -286,7 +302,7 @@ func createPostgresResourceStatusPhaseFailedAlert(ctx context.Context, client k8
 }
 
 // createPostgresResourceDeletionStatusFailedAlert creates a PrometheusRule alert that watches for failed deletions of Postgres CRs
-func createPostgresResourceDeletionStatusFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) (*prometheusv1.PrometheusRule, error) {
+func createPostgresResourceDeletionStatusFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres state alert creation, useClusterStorage is true")
 		return nil, nil
@@ -304,7 +320,7 @@ func createPostgresResourceDeletionStatusFailedAlert(ctx context.Context, client
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlCloudResourceDeletionStatusFailed, alertFor5Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlCloudResourceDeletionStatusFailed, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -317,7 +333,7 @@ func createPostgresResourceDeletionStatusFailedAlert(ctx context.Context, client
 //
 // the low storage alert fires if storage is under 10% of current capacity, with a 30 minute alertOn value to allow for any
 // provider autoscaling to happen, if after 30 minutes the instance will require manual intervention
-func reconcilePostgresFreeStorageAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) error {
+func reconcilePostgresFreeStorageAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) error {
 	// dont create the alert if we are using in cluster storage
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres free storage alert creation, useClusterStorage is true")
@@ -341,9 +357,9 @@ func reconcilePostgresFreeStorageAlerts(ctx context.Context, client k8sclient.Cl
 	// and matching by label `job` if the current time is greater than 1 hour of the process start time for the cloud resource operator metrics.
 	//    * on(job) - matching queries by label job across both metrics
 	alertExp := intstr.FromString(
-		fmt.Sprintf("(predict_linear(cro_postgres_free_storage_average{job='%s'}[1h], 5 * 3600) <= 0 and on(job) (time() - process_start_time_seconds{job='%s'}) / 3600 > 2) and (cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100) * 25))", job, job))
+		fmt.Sprintf("(predict_linear(cro_postgres_free_storage_average{job='%s'}[1h], 5 * 3600) <= 0 and on(job) (time() - process_start_time_seconds{job='%s'}) / 3600 > 2) and (cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100) * %s))", job, job, alerts.ThresholdPlaceholder))
 
-	_, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresWillFill, alertFor60Mins, alertExp, labels)
+	_, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresWillFill, alertFor60Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
@@ -362,9 +378,9 @@ func reconcilePostgresFreeStorageAlerts(ctx context.Context, client k8sclient.Cl
 	// and matching by label `job` if the current time is greater than 6 hour of the process start time for the cloud resource operator metrics.
 	//    * on(job) - matching queries by label job across both metrics
 	alertExp = intstr.FromString(
-		fmt.Sprintf("(predict_linear(cro_postgres_free_storage_average{job='%s'}[6h], 4 * 24 * 3600) <= 0 and on(job) (time() - process_start_time_seconds{job='%s'}) / 3600 > 2 ) and (cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100) * 25))", job, job))
+		fmt.Sprintf("(predict_linear(cro_postgres_free_storage_average{job='%s'}[6h], 4 * 24 * 3600) <= 0 and on(job) (time() - process_start_time_seconds{job='%s'}) / 3600 > 2 ) and (cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100) * %s))", job, job, alerts.ThresholdPlaceholder))
 
-	_, err = reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresWillFill, alertFor60Mins, alertExp, labels)
+	_, err = reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresWillFill, alertFor60Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
@@ -377,17 +393,17 @@ func reconcilePostgresFreeStorageAlerts(ctx context.Context, client k8sclient.Cl
 		"severity": "critical",
 	}
 
-	// checking if the percentage of free storage is less than 10% of the current allocated storage
-	alertExp = intstr.FromString("cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100 ) * 10)")
+	// checking if the percentage of free storage is less than the threshold, 10% by default, of the current allocated storage
+	alertExp = intstr.FromString(fmt.Sprintf("cro_postgres_free_storage_average < ((cro_postgres_current_allocated_storage / 100 ) * %s)", alerts.ThresholdPlaceholder))
 
-	_, err = reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresWillFill, alertFor30Mins, alertExp, labels)
+	_, err = reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresWillFill, alertFor30Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func reconcilePostgresFreeableMemoryAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) error {
+func reconcilePostgresFreeableMemoryAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) error {
 	// dont create the alert if we are using in cluster storage
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres free storage alert creation, useClusterStorage is true")
@@ -402,19 +418,19 @@ func reconcilePostgresFreeableMemoryAlert(ctx context.Context, client k8sclient.
 		"severity": "warning",
 	}
 
-	// checking if the percentage of freeable memory is less than 10% of the max memory
+	// checking if the percentage of freeable memory is less than the threshold, 10% by default, of the max memory
 	// cro_postgres_max_memory is in MiB so cro_postgres_freeable_memory_average needs to be converted from bytes to MiB
 	// conversion formula is MiB = bytes / (1024^2)
-	alertExp := intstr.FromString("(cro_postgres_freeable_memory_average / (1024*1024)) < ((cro_postgres_max_memory / 100 ) * 10)")
+	alertExp := intstr.FromString(fmt.Sprintf("(cro_postgres_freeable_memory_average / (1024*1024)) < ((cro_postgres_max_memory / 100 ) * %s)", alerts.ThresholdPlaceholder))
 
-	_, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresFreeableMemoryLow, alertFor5Mins, alertExp, labels)
+	_, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresFreeableMemoryLow, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func reconcilePostgresCPUUtilizationAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres) error {
+func reconcilePostgresCPUUtilizationAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Postgres, overrides map[string]v1alpha1.AlertOverride) error {
 	// dont create the alert if we are using in cluster storage
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping postgres free storage alert creation, useClusterStorage is true")
@@ -430,7 +446,7 @@ func reconcilePostgresCPUUtilizationAlerts(ctx context.Context, client k8sclient
 
 	alertExp := intstr.FromString("cro_postgres_cpu_utilization_average > 90")
 
-	_, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresCpuUsageHigh, alertFor15Mins, alertExp, labels)
+	_, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlPostgresCpuUsageHigh, alertFor15Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
@@ -438,7 +454,7 @@ func reconcilePostgresCPUUtilizationAlerts(ctx context.Context, client k8sclient
 }
 
 // createRedisResourceStatusPhasePendingAlert creates a PrometheusRule alert to watch for Redis CR state
-func createRedisResourceStatusPhasePendingAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) (*prometheusv1.PrometheusRule, error) {
+func createRedisResourceStatusPhasePendingAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping redis alert creation, useClusterStorage is true")
 		return nil, nil
@@ -457,7 +473,7 @@ func createRedisResourceStatusPhasePendingAlert(ctx context.Context, client k8sc
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisResourceStatusPhasePending, alertFor20Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisResourceStatusPhasePending, alertFor20Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -466,7 +482,7 @@ func createRedisResourceStatusPhasePendingAlert(ctx context.Context, client k8sc
 
 // CreateRedisMemoryUsageHighAlert creates a PrometheusRule alert to watch for High Memory usage
 // of a Redis cache
-func createRedisMemoryUsageAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) error {
+func createRedisMemoryUsageAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis, overrides map[string]v1alpha1.AlertOverride) error {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping redis memory usage high alert creation, useClusterStorage is true")
 		return nil
@@ -483,7 +499,7 @@ func createRedisMemoryUsageAlerts(ctx context.Context, client k8sclient.Client,
 
 	alertExp := intstr.FromString(fmt.Sprintf("cro_redis_memory_usage_percentage_average > %s", alertPercentage))
 
-	_, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisMemoryUsageHigh, alertFor60Mins, alertExp, labels)
+	_, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisMemoryUsageHigh, alertFor60Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
@@ -505,7 +521,7 @@ func createRedisMemoryUsageAlerts(ctx context.Context, client k8sclient.Client,
 	//    * on(job) - matching queries by label job across both metrics
 	alertExp = intstr.FromString(fmt.Sprintf("predict_linear(cro_redis_memory_usage_percentage_average{job='%s'}[1h], 5 * 3600) >= 100 and on(job) (time() - process_start_time_seconds{job='%s'}) / 3600 > 1", job, job))
 
-	_, err = reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisMemoryUsageHigh, alertFor60Mins, alertExp, labels)
+	_, err = reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisMemoryUsageHigh, alertFor60Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
@@ -524,7 +540,7 @@ func createRedisMemoryUsageAlerts(ctx context.Context, client k8sclient.Client,
 	//    * on(job) - matching queries by label job across both metrics
 	alertExp = intstr.FromString(fmt.Sprintf("predict_linear(cro_redis_memory_usage_percentage_average{job='%s'}[6h], 4 * 24 * 3600) >= 100 and on(job) (time() - process_start_time_seconds{job='%s'}) / 3600 > 1", job, job))
 
-	_, err = reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisMemoryUsageHigh, alertFor60Mins, alertExp, labels)
+	_, err = reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisMemoryUsageHigh, alertFor60Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
@@ -533,7 +549,7 @@ func createRedisMemoryUsageAlerts(ctx context.Context, client k8sclient.Client,
 }
 
 // createRedisResourceStatusPhaseFailedAlert creates a PrometheusRule alert to watch for Redis CR state
-func createRedisResourceStatusPhaseFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) (*prometheusv1.PrometheusRule, error) {
+func createRedisResourceStatusPhaseFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping redis alert creation, useClusterStorage is true")
 		return nil, nil
@@ -552,7 +568,7 @@ func createRedisResourceStatusPhaseFailedAlert(ctx context.Context, client k8scl
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisResourceStatusPhaseFailed, alertFor5Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisResourceStatusPhaseFailed, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -560,7 +576,7 @@ func createRedisResourceStatusPhaseFailedAlert(ctx context.Context, client k8scl
 }
 
 // createRedisResourceDeletionStatusFailedAlert creates a PrometheusRule alert that watches for failed deletions of Redis CRs
-func createRedisResourceDeletionStatusFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) (*prometheusv1.PrometheusRule, error) {
+func createRedisResourceDeletionStatusFailedAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping redis state alert creation, useClusterStorage is true")
 		return nil, nil
@@ -578,7 +594,7 @@ func createRedisResourceDeletionStatusFailedAlert(ctx context.Context, client k8
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlCloudResourceDeletionStatusFailed, alertFor5Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlCloudResourceDeletionStatusFailed, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -587,7 +603,7 @@ func createRedisResourceDeletionStatusFailedAlert(ctx context.Context, client k8
 
 // createRedisAvailabilityAlert creates a PrometheusRule alert to watch for the availability
 // of a Redis cache
-func createRedisAvailabilityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) (*prometheusv1.PrometheusRule, error) {
+func createRedisAvailabilityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping redis alert creation, useClusterStorage is true")
 		return nil, nil
@@ -606,7 +622,7 @@ func createRedisAvailabilityAlert(ctx context.Context, client k8sclient.Client,
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisCacheUnavailable, alertFor5Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisCacheUnavailable, alertFor5Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -615,7 +631,7 @@ func createRedisAvailabilityAlert(ctx context.Context, client k8sclient.Client,
 
 // createRedisConnectivityAlert creates a PrometheusRule alert to watch for the connectivity
 // of a Redis cache
-func createRedisConnectivityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) (*prometheusv1.PrometheusRule, error) {
+func createRedisConnectivityAlert(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis, overrides map[string]v1alpha1.AlertOverride) (*prometheusv1.PrometheusRule, error) {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping redis connectivity alert creation, useClusterStorage is true")
 		return nil, nil
@@ -634,7 +650,7 @@ func createRedisConnectivityAlert(ctx context.Context, client k8sclient.Client,
 		"productName": productName,
 	}
 	// create the rule
-	pr, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisConnectionFailed, alertFor60Mins, alertExp, labels)
+	pr, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisConnectionFailed, alertFor60Mins, alertExp, labels)
 	if err != nil {
 		return nil, err
 	}
@@ -644,6 +660,14 @@ func createRedisConnectivityAlert(ctx context.Context, client k8sclient.Client,
 // CreateRedisCpuUsageAlerts creates a PrometheusRule alerts to watch for High Cpu usage
 // of a Redis cache
 func CreateRedisCpuUsageAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis) error {
+	overrides, err := GetAlertOverrides(ctx, client)
+	if err != nil {
+		return err
+	}
+	return createRedisCpuUsageAlerts(ctx, client, inst, cr, overrides)
+}
+
+func createRedisCpuUsageAlerts(ctx context.Context, client k8sclient.Client, inst *v1alpha1.RHMI, cr *crov1.Redis, overrides map[string]v1alpha1.AlertOverride) error {
 	if strings.ToLower(inst.Spec.UseClusterStorage) == "true" {
 		logrus.Info("skipping redis memory usage high alert creation, useClusterStorage is true")
 		return nil
@@ -659,7 +683,7 @@ func CreateRedisCpuUsageAlerts(ctx context.Context, client k8sclient.Client, ins
 
 	alertExp := intstr.FromString(fmt.Sprintf("cro_redis_engine_cpu_utilization_average > %s", alertPercentage))
 
-	_, err := reconcilePrometheusRule(ctx, client, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisCpuUsageHigh, alertFor15Mins, alertExp, labels)
+	_, err := reconcilePrometheusRule(ctx, client, overrides, ruleName, cr.Namespace, alertName, alertDescription, sopUrlRedisCpuUsageHigh, alertFor15Mins, alertExp, labels)
 	if err != nil {
 		return err
 	}
@@ -667,24 +691,26 @@ func CreateRedisCpuUsageAlerts(ctx context.Context, client k8sclient.Client, ins
 }
 
 // reconcilePrometheusRule will create a PrometheusRule object
-func reconcilePrometheusRule(ctx context.Context, client k8sclient.Client, ruleName, ns, alertName, desc, sopURL, alertFor string, alertExp intstr.IntOrString, labels map[string]string) (*prometheusv1.PrometheusRule, error) {
+func reconcilePrometheusRule(ctx context.Context, client k8sclient.Client, overrides map[string]v1alpha1.AlertOverride, ruleName, ns, alertName, desc, sopURL, alertFor string, alertExp intstr.IntOrString, labels map[string]string) (*prometheusv1.PrometheusRule, error) {
 	alertGroupName := alertName + "Group"
-	groups := []prometheusv1.RuleGroup{
+	// a disabled alert leaves the group of the rule empty
+	alertRules := applyAlertOverrides([]prometheusv1.Rule{
 		{
-			Name: alertGroupName,
-			Rules: []prometheusv1.Rule{
-				{
-					Alert:  alertName,
-					Expr:   alertExp,
-					For:    alertFor,
-					Labels: labels,
-					Annotations: map[string]string{
-						"description": desc,
-						"sop_url":     sopURL,
-					},
-				},
+			Alert:  alertName,
+			Expr:   alertExp,
+			For:    alertFor,
+			Labels: labels,
+			Annotations: map[string]string{
+				"description": desc,
+				"sop_url":     sopURL,
 			},
 		},
+	}, overrides)
+	groups := []prometheusv1.RuleGroup{
+		{
+			Name:  alertGroupName,
+			Rules: alertRules,
+		},
 	}
 
 	rule := &prometheusv1.PrometheusRule{
@@ -704,23 +730,7 @@ func reconcilePrometheusRule(ctx context.Context, client k8sclient.Client, ruleN
 	_, err := controllerutil.CreateOrUpdate(ctx, client, rule, func() error {
 		rule.Name = ruleName
 		rule.Namespace = ns
-		rule.Spec.Groups = []prometheusv1.RuleGroup{
-			{
-				Name: alertGroupName,
-				Rules: []prometheusv1.Rule{
-					{
-						Alert:  alertName,
-						Expr:   alertExp,
-						For:    alertFor,
-						Labels: labels,
-						Annotations: map[string]string{
-							"description": desc,
-							"sop_url":     sopURL,
-						},
-					},
-				},
-			},
-		}
+		rule.Spec.Groups = groups
 		return nil
 	})
 	if err != nil {
@@ -0,0 +1,141 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Check validates that the cluster is ready for the installation before any product is installed
+type Check interface {
+	// Name identifies the check in the status of the installation
+	Name() string
+	// Run returns the result of the check. An error is reported as a failed check and the check is run again on
+	// the next reconcile
+	Run(ctx context.Context, deps Dependencies) (Result, error)
+}
+
+// ProductDetector finds an existing installation of a product, it is implemented by the product reconcilers
+type ProductDetector interface {
+	GetPreflightObject(ns string) runtime.Object
+}
+
+// Dependencies are the clients and objects shared with every check
+type Dependencies struct {
+	// Client must not be cached, as the checks read objects the operator does not watch
+	Client       k8sclient.Client
+	Discovery    discovery.DiscoveryInterface
+	Installation *integreatlyv1alpha1.RHMI
+	// Products are the enabled products of the installation
+	Products map[integreatlyv1alpha1.ProductName]ProductDetector
+}
+
+// NewCheck builds a check from a function
+func NewCheck(name string, run func(ctx context.Context, deps Dependencies) (Result, error)) Check {
+	return &checkFunc{name: name, run: run}
+}
+
+type checkFunc struct {
+	name string
+	run  func(ctx context.Context, deps Dependencies) (Result, error)
+}
+
+func (c *checkFunc) Name() string {
+	return c.name
+}
+
+func (c *checkFunc) Run(ctx context.Context, deps Dependencies) (Result, error) {
+	return c.run(ctx, deps)
+}
+
+type Result struct {
+	Outcome integreatlyv1alpha1.PreflightCheckResult
+	Message string
+}
+
+func Passed(format string, args ...interface{}) Result {
+	return Result{Outcome: integreatlyv1alpha1.PreflightCheckPassed, Message: fmt.Sprintf(format, args...)}
+}
+
+// Warning reports a problem that does not block the installation
+func Warning(format string, args ...interface{}) Result {
+	return Result{Outcome: integreatlyv1alpha1.PreflightCheckWarning, Message: fmt.Sprintf(format, args...)}
+}
+
+// Failed reports a problem that blocks the installation until it is fixed
+func Failed(format string, args ...interface{}) Result {
+	return Result{Outcome: integreatlyv1alpha1.PreflightCheckFailed, Message: fmt.Sprintf(format, args...)}
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]Check{}
+)
+
+// Register adds a check to the checks run before the installation. Built-in checks are registered from the files of
+// this package. Register panics if a check with the same name is registered twice
+func Register(check Check) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, ok := registry[check.Name()]; ok {
+		panic(fmt.Sprintf("preflight: check %s is already registered", check.Name()))
+	}
+	registry[check.Name()] = check
+}
+
+// Unregister removes a check from the registry, allowing tests to replace a check with a fake
+func Unregister(name string) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	delete(registry, name)
+}
+
+// RegisteredChecks returns every registered check, sorted by name
+func RegisteredChecks() []Check {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	checks := make([]Check, 0, len(registry))
+	for _, check := range registry {
+		checks = append(checks, check)
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name() < checks[j].Name() })
+	return checks
+}
+
+// RunChecks runs every registered check independently of the results of the others
+func RunChecks(ctx context.Context, deps Dependencies) []integreatlyv1alpha1.PreflightCheckStatus {
+	checks := RegisteredChecks()
+	statuses := make([]integreatlyv1alpha1.PreflightCheckStatus, 0, len(checks))
+	for _, check := range checks {
+		result, err := check.Run(ctx, deps)
+		if err != nil {
+			result = Failed("check could not be run, will retry: %v", err)
+		}
+		statuses = append(statuses, integreatlyv1alpha1.PreflightCheckStatus{
+			Name:    check.Name(),
+			Result:  result.Outcome,
+			Message: result.Message,
+		})
+	}
+	return statuses
+}
+
+// Blocked checks if any of the checks failed
+func Blocked(statuses []integreatlyv1alpha1.PreflightCheckStatus) bool {
+	for _, status := range statuses {
+		if status.Result == integreatlyv1alpha1.PreflightCheckFailed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+)
+
+func TestRegister(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected registering a check twice to panic")
+		}
+	}()
+	Register(NewCheck("cluster-storage", checkClusterStorage))
+}
+
+func TestRunChecks(t *testing.T) {
+	// replace the registered checks with fakes, restoring them once the test completes
+	registered := RegisteredChecks()
+	for _, check := range registered {
+		Unregister(check.Name())
+	}
+	defer func() {
+		for _, check := range RegisteredChecks() {
+			Unregister(check.Name())
+		}
+		for _, check := range registered {
+			Register(check)
+		}
+	}()
+
+	Register(NewCheck("warns", func(context.Context, Dependencies) (Result, error) {
+		return Warning("small cluster"), nil
+	}))
+	Register(NewCheck("errors", func(context.Context, Dependencies) (Result, error) {
+		return Result{}, errors.New("api unavailable")
+	}))
+	Register(NewCheck("passes", func(context.Context, Dependencies) (Result, error) {
+		return Passed("ok"), nil
+	}))
+
+	statuses := RunChecks(context.TODO(), Dependencies{})
+	if len(statuses) != 3 {
+		t.Fatalf("expected every check to run, got %v", statuses)
+	}
+	expected := map[string]integreatlyv1alpha1.PreflightCheckResult{
+		"errors": integreatlyv1alpha1.PreflightCheckFailed,
+		"passes": integreatlyv1alpha1.PreflightCheckPassed,
+		"warns":  integreatlyv1alpha1.PreflightCheckWarning,
+	}
+	for _, status := range statuses {
+		if status.Result != expected[status.Name] {
+			t.Fatalf("expected check %s to report %s, got %s", status.Name, expected[status.Name], status.Result)
+		}
+	}
+	if !Blocked(statuses) {
+		t.Fatalf("expected the failed check to block the installation")
+	}
+	if Blocked(statuses[1:]) {
+		t.Fatalf("expected warnings to not block the installation")
+	}
+}
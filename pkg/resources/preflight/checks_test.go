@@ -0,0 +1,113 @@
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type namespaceDetector string
+
+func (d namespaceDetector) GetPreflightObject(ns string) runtime.Object {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: string(d), Namespace: ns}}
+}
+
+func buildScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = configv1.AddToScheme(scheme)
+	return scheme
+}
+
+func TestChecks(t *testing.T) {
+	installation := &integreatlyv1alpha1.RHMI{
+		ObjectMeta: metav1.ObjectMeta{Name: "rhmi", Namespace: "redhat-rhmi-operator"},
+		Spec: integreatlyv1alpha1.RHMISpec{
+			Type:                 string(integreatlyv1alpha1.InstallationTypeManaged),
+			UseClusterStorage:    "false",
+			PagerDutySecret:      "redhat-rhmi-pagerduty",
+			DeadMansSnitchSecret: "redhat-rhmi-deadmanssnitch",
+			SMTPSecret:           "redhat-rhmi-smtp",
+		},
+	}
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: integreatlyv1alpha1.DefaultOriginPullSecretName, Namespace: integreatlyv1alpha1.DefaultOriginPullSecretNamespace},
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.redhat.io":{"auth":"dGVzdA=="}}}`)},
+	}
+	smtpSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "redhat-rhmi-smtp", Namespace: "redhat-rhmi-operator"},
+		Data:       map[string][]byte{"host": []byte("smtp.example.com"), "port": []byte("587"), "username": []byte("user")},
+	}
+	pagerDutySecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "redhat-rhmi-pagerduty", Namespace: "redhat-rhmi-operator"}}
+	clusterVersion := &configv1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterVersionName},
+		Status: configv1.ClusterVersionStatus{
+			History: []configv1.UpdateHistory{{State: configv1.CompletedUpdate, Version: "4.3.18"}},
+		},
+	}
+	worker := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+		Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("4"),
+			corev1.ResourceMemory: resource.MustParse("16Gi"),
+		}},
+	}
+	conflict := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "3scale", Namespace: "my-3scale"}}
+	namespaces := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "my-3scale"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "openshift-3scale"}},
+	}
+
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{
+		{GroupVersion: "operators.coreos.com/v1alpha1", APIResources: []metav1.APIResource{{Name: "subscriptions"}, {Name: "installplans"}, {Name: "catalogsources"}, {Name: "clusterserviceversions"}}},
+		{GroupVersion: "operators.coreos.com/v1", APIResources: []metav1.APIResource{{Name: "operatorgroups"}}},
+		{GroupVersion: "monitoring.coreos.com/v1", APIResources: []metav1.APIResource{{Name: "servicemonitors"}}},
+	}}}
+
+	objects := append(namespaces, pullSecret, smtpSecret, pagerDutySecret, clusterVersion, worker, conflict)
+	deps := Dependencies{
+		Client:       fake.NewFakeClientWithScheme(buildScheme(), objects...),
+		Discovery:    discoveryClient,
+		Installation: installation,
+		Products: map[integreatlyv1alpha1.ProductName]ProductDetector{
+			integreatlyv1alpha1.Product3Scale: namespaceDetector("3scale"),
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Check    func(context.Context, Dependencies) (Result, error)
+		Expected integreatlyv1alpha1.PreflightCheckResult
+	}{
+		{Name: "cluster storage is set", Check: checkClusterStorage, Expected: integreatlyv1alpha1.PreflightCheckPassed},
+		{Name: "dead mans snitch secret is missing", Check: checkRequiredSecrets, Expected: integreatlyv1alpha1.PreflightCheckFailed},
+		{Name: "3scale found outside of the openshift namespaces", Check: checkConflictingProducts, Expected: integreatlyv1alpha1.PreflightCheckFailed},
+		{Name: "small cluster warns", Check: checkClusterCapacity, Expected: integreatlyv1alpha1.PreflightCheckWarning},
+		{Name: "openshift version is too old", Check: checkOpenShiftVersion, Expected: integreatlyv1alpha1.PreflightCheckFailed},
+		{Name: "prometheus rules are not served", Check: checkRequiredAPIs, Expected: integreatlyv1alpha1.PreflightCheckFailed},
+		{Name: "pull secret has credentials", Check: checkPullSecret, Expected: integreatlyv1alpha1.PreflightCheckPassed},
+		{Name: "smtp secret without password warns", Check: checkSMTPSecret, Expected: integreatlyv1alpha1.PreflightCheckWarning},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := tc.Check(context.TODO(), deps)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Outcome != tc.Expected {
+				t.Fatalf("expected %s, got %s: %s", tc.Expected, result.Outcome, result.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterVersionName = "version"
+	masterNodeLabel    = "node-role.kubernetes.io/master"
+)
+
+var (
+	// MinOpenShiftVersion is the oldest version of OpenShift the products support
+	MinOpenShiftVersion = semver.MustParse("4.4.0")
+
+	// MinWorkerCPU and MinWorkerMemory are the allocatable resources of the worker nodes recommended to install
+	// every product. Smaller clusters only report a warning, as fewer products can be enabled
+	MinWorkerCPU    = resource.MustParse("24")
+	MinWorkerMemory = resource.MustParse("96Gi")
+
+	// RequiredAPIs are the resources of OLM and the monitoring stack the installation depends on, keyed by group
+	// version
+	RequiredAPIs = map[string][]string{
+		"operators.coreos.com/v1alpha1": {"subscriptions", "installplans", "catalogsources", "clusterserviceversions"},
+		"operators.coreos.com/v1":       {"operatorgroups"},
+		"monitoring.coreos.com/v1":      {"prometheusrules", "servicemonitors"},
+	}
+)
+
+func init() {
+	Register(NewCheck("cluster-capacity", checkClusterCapacity))
+	Register(NewCheck("openshift-version", checkOpenShiftVersion))
+	Register(NewCheck("required-apis", checkRequiredAPIs))
+}
+
+// checkClusterCapacity compares the allocatable resources of the worker nodes with the recommended resources
+func checkClusterCapacity(ctx context.Context, deps Dependencies) (Result, error) {
+	nodes := &corev1.NodeList{}
+	if err := deps.Client.List(ctx, nodes); err != nil {
+		return Result{}, fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	cpu, memory := resource.Quantity{}, resource.Quantity{}
+	workers := 0
+	for _, node := range nodes.Items {
+		if _, ok := node.Labels[masterNodeLabel]; ok || node.Spec.Unschedulable {
+			continue
+		}
+		workers++
+		cpu.Add(node.Status.Allocatable[corev1.ResourceCPU])
+		memory.Add(node.Status.Allocatable[corev1.ResourceMemory])
+	}
+
+	if cpu.Cmp(MinWorkerCPU) < 0 || memory.Cmp(MinWorkerMemory) < 0 {
+		return Warning("%d worker nodes have %s CPU and %s memory allocatable, %s CPU and %s memory are recommended",
+			workers, cpu.String(), memory.String(), MinWorkerCPU.String(), MinWorkerMemory.String()), nil
+	}
+	return Passed("%d worker nodes have %s CPU and %s memory allocatable", workers, cpu.String(), memory.String()), nil
+}
+
+// checkOpenShiftVersion ensures the cluster runs a version of OpenShift supported by the products
+func checkOpenShiftVersion(ctx context.Context, deps Dependencies) (Result, error) {
+	clusterVersion := &configv1.ClusterVersion{}
+	err := deps.Client.Get(ctx, k8sclient.ObjectKey{Name: clusterVersionName}, clusterVersion)
+	if k8serr.IsNotFound(err) {
+		return Warning("cluster version %s not found, the version of OpenShift could not be verified", clusterVersionName), nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	version := clusterVersion.Status.Desired.Version
+	for _, update := range clusterVersion.Status.History {
+		if update.State == configv1.CompletedUpdate {
+			version = update.Version
+			break
+		}
+	}
+	parsed, err := semver.ParseTolerant(version)
+	if err != nil {
+		return Warning("failed to parse OpenShift version %q, the version could not be verified", version), nil
+	}
+	if parsed.LT(MinOpenShiftVersion) {
+		return Failed("OpenShift %s is not supported, %s or later is required", version, MinOpenShiftVersion), nil
+	}
+	return Passed("OpenShift %s is supported", version), nil
+}
+
+// checkRequiredAPIs ensures OLM and the CRDs the installation depends on are available in the cluster
+func checkRequiredAPIs(_ context.Context, deps Dependencies) (Result, error) {
+	missing := []string{}
+	for groupVersion, resources := range RequiredAPIs {
+		list, err := deps.Discovery.ServerResourcesForGroupVersion(groupVersion)
+		if k8serr.IsNotFound(err) {
+			missing = append(missing, groupVersion)
+			continue
+		}
+		if err != nil {
+			return Result{}, fmt.Errorf("error discovering %s: %w", groupVersion, err)
+		}
+
+		served := map[string]bool{}
+		for _, r := range list.APIResources {
+			served[r.Name] = true
+		}
+		for _, r := range resources {
+			if !served[r] {
+				missing = append(missing, fmt.Sprintf("%s/%s", groupVersion, r))
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return Failed("required APIs are not available: %s", strings.Join(missing, ", ")), nil
+	}
+	return Passed("required APIs are available"), nil
+}
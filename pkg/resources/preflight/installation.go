@@ -0,0 +1,51 @@
+package preflight
+
+import (
+	"context"
+	"strings"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	Register(NewCheck("cluster-storage", checkClusterStorage))
+	Register(NewCheck("required-secrets", checkRequiredSecrets))
+}
+
+// checkClusterStorage ensures the installation decides where the cloud resources of the products are created
+func checkClusterStorage(_ context.Context, deps Dependencies) (Result, error) {
+	useClusterStorage := strings.ToLower(deps.Installation.Spec.UseClusterStorage)
+	if useClusterStorage != "true" && useClusterStorage != "false" {
+		return Failed("Spec.useClusterStorage must be set to either 'true' or 'false' to continue"), nil
+	}
+	return Passed("useClusterStorage is %s", useClusterStorage), nil
+}
+
+// checkRequiredSecrets ensures the PagerDuty and Dead Man's Snitch secrets alerting depends on exist for managed
+// installations
+func checkRequiredSecrets(ctx context.Context, deps Dependencies) (Result, error) {
+	installation := deps.Installation
+	if installation.Spec.Type != string(integreatlyv1alpha1.InstallationTypeManaged) && installation.Spec.Type != string(integreatlyv1alpha1.InstallationTypeManagedApi) {
+		return Passed("no secrets are required for %s installations", installation.Spec.Type), nil
+	}
+
+	missing := []string{}
+	for _, secretName := range []string{installation.Spec.PagerDutySecret, installation.Spec.DeadMansSnitchSecret} {
+		err := deps.Client.Get(ctx, k8sclient.ObjectKey{Name: secretName, Namespace: installation.Namespace}, &corev1.Secret{})
+		if k8serr.IsNotFound(err) {
+			missing = append(missing, secretName)
+			continue
+		}
+		if err != nil {
+			return Result{}, err
+		}
+	}
+	if len(missing) > 0 {
+		return Failed("Could not find %s secret in %s namespace", strings.Join(missing, ", "), installation.Namespace), nil
+	}
+	return Passed("found required secrets"), nil
+}
@@ -0,0 +1,55 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func init() {
+	Register(NewCheck("conflicting-products", checkConflictingProducts))
+}
+
+// checkConflictingProducts looks for products installed in the cluster outside of the installation, in every
+// namespace not managed by OpenShift
+func checkConflictingProducts(ctx context.Context, deps Dependencies) (Result, error) {
+	namespaces := &corev1.NamespaceList{}
+	if err := deps.Client.List(ctx, namespaces); err != nil {
+		return Result{}, fmt.Errorf("error listing namespaces: %w", err)
+	}
+
+	conflicts := []string{}
+	for _, ns := range namespaces.Items {
+		if strings.HasPrefix(ns.Name, "openshift-") || strings.HasPrefix(ns.Name, "kube-") {
+			continue
+		}
+		found := []string{}
+		for product, detector := range deps.Products {
+			search := detector.GetPreflightObject(ns.Name)
+			if search == nil {
+				continue
+			}
+			exists, err := resources.Exists(ctx, deps.Client, search)
+			if err != nil {
+				return Result{}, fmt.Errorf("error looking for %s in namespace %s: %w", product, ns.Name, err)
+			}
+			if exists {
+				found = append(found, string(product))
+			}
+		}
+		if len(found) > 0 {
+			sort.Strings(found)
+			conflicts = append(conflicts, fmt.Sprintf("%s in namespace: %s", strings.Join(found, ", "), ns.Name))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return Failed("found conflicting packages: %s", strings.Join(conflicts, "; ")), nil
+	}
+	return Passed("no conflicting packages found"), nil
+}
@@ -0,0 +1,84 @@
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// smtpSecretKeys are the keys of the SMTP secret read by the products sending emails
+var smtpSecretKeys = []string{"host", "port", "username", "password"}
+
+func init() {
+	Register(NewCheck("pull-secret", checkPullSecret))
+	Register(NewCheck("smtp-secret", checkSMTPSecret))
+}
+
+// checkPullSecret ensures the pull secret copied to the product namespaces holds credentials for at least one
+// registry
+func checkPullSecret(ctx context.Context, deps Dependencies) (Result, error) {
+	spec := deps.Installation.GetPullSecretSpec()
+
+	secret := &corev1.Secret{}
+	err := deps.Client.Get(ctx, k8sclient.ObjectKey{Name: spec.Name, Namespace: spec.Namespace}, secret)
+	if k8serr.IsNotFound(err) {
+		return Failed("pull secret %s not found in namespace %s", spec.Name, spec.Namespace), nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	data, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return Failed("pull secret %s has no %s key", spec.Name, corev1.DockerConfigJsonKey), nil
+	}
+	dockerConfig := struct {
+		Auths map[string]json.RawMessage `json:"auths"`
+	}{}
+	if err := json.Unmarshal(data, &dockerConfig); err != nil {
+		return Failed("pull secret %s is not valid: %v", spec.Name, err), nil
+	}
+	if len(dockerConfig.Auths) == 0 {
+		return Failed("pull secret %s has no registry credentials", spec.Name), nil
+	}
+	return Passed("pull secret %s has credentials for %d registries", spec.Name, len(dockerConfig.Auths)), nil
+}
+
+// checkSMTPSecret verifies the shape of the SMTP secret. The secret can be created after the installation, so
+// problems with it only report a warning
+func checkSMTPSecret(ctx context.Context, deps Dependencies) (Result, error) {
+	installation := deps.Installation
+	if installation.Spec.SMTPSecret == "" {
+		return Passed("no SMTP secret is set"), nil
+	}
+
+	secret := &corev1.Secret{}
+	err := deps.Client.Get(ctx, k8sclient.ObjectKey{Name: installation.Spec.SMTPSecret, Namespace: installation.Namespace}, secret)
+	if k8serr.IsNotFound(err) {
+		return Warning("SMTP secret %s not found in namespace %s, emails are not sent until it is created", installation.Spec.SMTPSecret, installation.Namespace), nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	missing := []string{}
+	for _, key := range smtpSecretKeys {
+		if len(secret.Data[key]) == 0 {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return Warning("SMTP secret %s is missing keys: %s", installation.Spec.SMTPSecret, strings.Join(missing, ", ")), nil
+	}
+	if _, err := strconv.Atoi(string(secret.Data["port"])); err != nil {
+		return Warning("SMTP secret %s has an invalid port %q", installation.Spec.SMTPSecret, string(secret.Data["port"])), nil
+	}
+	return Passed("SMTP secret %s is valid", installation.Spec.SMTPSecret), nil
+}
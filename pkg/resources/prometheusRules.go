@@ -27,11 +27,17 @@ type AlertReconcilerImpl struct {
 
 var _ AlertReconciler = &AlertReconcilerImpl{}
 
+// AlertProductLabel is set on the alerts of a product to the name of the product, the alert receivers of the
+// RHMIConfig route the alerts of their products on it
+const AlertProductLabel = "product"
+
 type AlertConfiguration struct {
 	AlertName string
 	GroupName string
 	Namespace string
-	Rules     []monitoringv1.Rule
+	// Product is set as the product label of the alerts of the rules, unless they set it
+	Product integreatlyv1alpha1.ProductName
+	Rules   []monitoringv1.Rule
 }
 
 func (r *AlertReconcilerImpl) ReconcileAlerts(ctx context.Context, client k8sclient.Client) (integreatlyv1alpha1.StatusPhase, error) {
@@ -46,7 +52,14 @@ func (r *AlertReconcilerImpl) ReconcileAlerts(ctx context.Context, client k8scli
 
 	monitoringConfig := config.NewMonitoring(config.ProductConfig{})
 
+	overrides, err := GetAlertOverrides(ctx, client)
+	if err != nil {
+		return integreatlyv1alpha1.PhaseFailed, err
+	}
+
 	for _, alert := range r.Alerts {
+		alert.Rules = applyAlertOverrides(alert.Rules, overrides)
+		alert.Rules = setAlertProduct(alert.Rules, alert.Product)
 		if or, err := r.reconcileRule(ctx, client, monitoringConfig, alert); err != nil {
 			return integreatlyv1alpha1.PhaseFailed, err
 		} else if or != controllerutil.OperationResultNone {
@@ -87,6 +100,25 @@ func (r *AlertReconcilerImpl) reconcileRule(ctx context.Context, client k8sclien
 	})
 }
 
+// setAlertProduct returns a copy of the rules where the alerts without a product label are labelled with the product
+func setAlertProduct(rules []monitoringv1.Rule, product integreatlyv1alpha1.ProductName) []monitoringv1.Rule {
+	if product == "" {
+		return rules
+	}
+	labelled := make([]monitoringv1.Rule, len(rules))
+	for i, rule := range rules {
+		labelled[i] = rule
+		if rule.Alert == "" || rule.Labels[AlertProductLabel] != "" {
+			continue
+		}
+		labelled[i].Labels = map[string]string{AlertProductLabel: string(product)}
+		for key, value := range rule.Labels {
+			labelled[i].Labels[key] = value
+		}
+	}
+	return labelled
+}
+
 func (r *AlertReconcilerImpl) deleteAlerts(ctx context.Context, client k8sclient.Client) error {
 	rule := &monitoringv1.PrometheusRule{}
 
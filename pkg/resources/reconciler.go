@@ -157,7 +157,7 @@ func (r *Reconciler) ReconcileNamespace(ctx context.Context, namespace string, i
 type finalizerFunc func() (integreatlyv1alpha1.StatusPhase, error)
 
 func (r *Reconciler) ReconcileFinalizer(ctx context.Context, client k8sclient.Client, inst *integreatlyv1alpha1.RHMI, productName string, finalFunc finalizerFunc) (integreatlyv1alpha1.StatusPhase, error) {
-	finalizer := "finalizer." + productName + ".integreatly.org"
+	finalizer := GetProductFinalizer(productName)
 	// Add finalizer if not there
 	err := AddFinalizer(ctx, inst, client, finalizer)
 	if err != nil {
@@ -194,7 +194,7 @@ func (r *Reconciler) ReconcilePullSecret(ctx context.Context, destSecretNamespac
 }
 
 func (r *Reconciler) ReconcileSubscription(ctx context.Context, target marketplace.Target, operandNS []string, preUpgradeBackupExecutor backup.BackupExecutor, client k8sclient.Client, catalogSourceReconciler marketplace.CatalogSourceReconciler) (integreatlyv1alpha1.StatusPhase, error) {
-	logrus.Infof("reconciling subscription %s from channel %s in namespace: %s", target.Pkg, marketplace.IntegreatlyChannel, target.Namespace)
+	logrus.Infof("reconciling subscription %s from channel %s in namespace: %s", target.Pkg, target.Channel, target.Namespace)
 	err := r.mpm.InstallOperator(ctx, client, target, operandNS, operatorsv1alpha1.ApprovalManual, catalogSourceReconciler)
 
 	if err != nil && !k8serr.IsAlreadyExists(err) {
@@ -214,7 +214,7 @@ func (r *Reconciler) ReconcileSubscription(ctx context.Context, target marketpla
 	}
 
 	for _, ip := range ips.Items {
-		err = upgradeApproval(ctx, preUpgradeBackupExecutor, client, &ip)
+		err = upgradeApproval(ctx, preUpgradeBackupExecutor, client, &ip, target.OperatorVersion)
 		if err != nil {
 			return integreatlyv1alpha1.PhaseFailed, fmt.Errorf("error approving installplan for %v: %w", target.Pkg, err)
 		}
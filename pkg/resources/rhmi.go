@@ -3,11 +3,17 @@ package resources
 import (
 	"context"
 	"fmt"
+
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
 	"github.com/sirupsen/logrus"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const RHMIConfigName = "rhmi-config"
+
 func GetRhmiCr(client k8sclient.Client, ctx context.Context, namespace string) (*integreatlyv1alpha1.RHMI, error) {
 	logrus.Infof("Looking for RHMI CR in %s namespace", namespace)
 
@@ -27,3 +33,29 @@ func GetRhmiCr(client k8sclient.Client, ctx context.Context, namespace string) (
 	}
 	return &installationList.Items[0], nil
 }
+
+// RecordPreUpgradeBackups adds the names of the backups performed before upgrading a product to the upgrade of RHMI
+// in progress in the RHMIConfig upgrade history. Nothing is recorded when no upgrade of RHMI is in progress
+func RecordPreUpgradeBackups(ctx context.Context, client k8sclient.Client, backups []string) error {
+	if len(backups) == 0 {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		config := &integreatlyv1alpha1.RHMIConfig{}
+		err := client.Get(ctx, k8sclient.ObjectKey{Name: RHMIConfigName, Namespace: global.NamespacePrefix + "operator"}, config)
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		upgrade := config.GetUpgradeInProgress()
+		if upgrade == nil {
+			return nil
+		}
+		upgrade.Backups = append(upgrade.Backups, backups...)
+		return client.Status().Update(ctx, config)
+	})
+}
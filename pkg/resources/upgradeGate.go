@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+)
+
+// UpgradeGate decides if an install plan upgrading a product operator can be approved. It's passed to the product
+// reconcilers through the context of the reconcile, as the approval of install plans is shared by every product
+type UpgradeGate interface {
+	// ApproveUpgrade returns true when the install plan upgrading the operator in the namespace can be approved now.
+	// The gate holds back the other upgrades until FinishUpgradeApproval is called
+	ApproveUpgrade(namespace string, ip *v1alpha1.InstallPlan) bool
+	// FinishUpgradeApproval reports whether the install plan allowed by ApproveUpgrade was approved, the pre-upgrade
+	// backups and the update of the install plan can fail
+	FinishUpgradeApproval(namespace string, ip *v1alpha1.InstallPlan, approved bool)
+}
+
+type upgradeGateKey struct{}
+
+// WithUpgradeGate returns a context for the product reconcilers that gates the approval of operator upgrades
+func WithUpgradeGate(ctx context.Context, gate UpgradeGate) context.Context {
+	return context.WithValue(ctx, upgradeGateKey{}, gate)
+}
+
+func upgradeGateFrom(ctx context.Context) UpgradeGate {
+	gate, _ := ctx.Value(upgradeGateKey{}).(UpgradeGate)
+	return gate
+}
@@ -0,0 +1,216 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
+	usersv1 "github.com/openshift/api/user/v1"
+	"github.com/sirupsen/logrus"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DefaultDevelopersGroup      = integreatlyv1alpha1.DefaultDevelopersGroup
+	DefaultAdminGroup           = "dedicated-admins"
+	DefaultMaxSyncChanges       = 100
+	DefaultSyncChangesPerSecond = 10
+)
+
+var (
+	DefaultExclusionGroups = []string{
+		"layered-cs-sre-admins",
+		"osd-sre-admins",
+	}
+)
+
+// Policy resolves which OpenShift users are synchronised to the products and which of them are admins, from the
+// users policy of the RHMIConfig. Every user sync shares it so that the products agree on the roles of a user
+type Policy struct {
+	exclusionGroups      []string
+	userSelector         labels.Selector
+	developersGroup      string
+	adminGroups          []string
+	productAdmins        map[integreatlyv1alpha1.ProductName][]string
+	dryRun               bool
+	maxSyncChanges       int
+	syncChangesPerSecond int
+}
+
+// DefaultPolicy excludes the SRE groups, synchronises every other user and makes the dedicated admins the admins of
+// the products
+func DefaultPolicy() *Policy {
+	return &Policy{
+		exclusionGroups:      DefaultExclusionGroups,
+		userSelector:         labels.Everything(),
+		developersGroup:      DefaultDevelopersGroup,
+		adminGroups:          []string{DefaultAdminGroup},
+		productAdmins:        map[integreatlyv1alpha1.ProductName][]string{},
+		maxSyncChanges:       DefaultMaxSyncChanges,
+		syncChangesPerSecond: DefaultSyncChangesPerSecond,
+	}
+}
+
+// NewPolicy returns the policy of the users policy of the RHMIConfig, unset fields keeping their defaults
+func NewPolicy(spec integreatlyv1alpha1.UserPolicy) (*Policy, error) {
+	if err := integreatlyv1alpha1.ValidateUserPolicy(spec); err != nil {
+		return nil, err
+	}
+
+	policy := DefaultPolicy()
+	if len(spec.ExclusionGroups) > 0 {
+		policy.exclusionGroups = spec.ExclusionGroups
+	}
+	if spec.UserSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(spec.UserSelector)
+		if err != nil {
+			return nil, err
+		}
+		policy.userSelector = selector
+	}
+	if spec.DevelopersGroup != "" {
+		policy.developersGroup = spec.DevelopersGroup
+	}
+	if len(spec.AdminGroups) > 0 {
+		policy.adminGroups = spec.AdminGroups
+	}
+	for _, product := range spec.Products {
+		policy.productAdmins[product.Product] = product.AdminGroups
+	}
+	policy.dryRun = spec.Sync.DryRun
+	if spec.Sync.MaxChanges > 0 {
+		policy.maxSyncChanges = spec.Sync.MaxChanges
+	}
+	if spec.Sync.ChangesPerSecond > 0 {
+		policy.syncChangesPerSecond = spec.Sync.ChangesPerSecond
+	}
+	return policy, nil
+}
+
+// GetPolicy returns the policy of the RHMIConfig. The default policy is returned when the RHMIConfig does not exist,
+// and when its users policy is invalid, as it is validated by the webhook
+func GetPolicy(ctx context.Context, client k8sclient.Client) (*Policy, error) {
+	config := &integreatlyv1alpha1.RHMIConfig{}
+	err := client.Get(ctx, k8sclient.ObjectKey{Name: resources.RHMIConfigName, Namespace: global.NamespacePrefix + "operator"}, config)
+	if k8serr.IsNotFound(err) {
+		return DefaultPolicy(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve rhmi config: %w", err)
+	}
+
+	policy, err := NewPolicy(config.Spec.Users)
+	if err != nil {
+		logrus.Warnf("ignoring invalid users policy: %v", err)
+		return DefaultPolicy(), nil
+	}
+	return policy, nil
+}
+
+// DevelopersGroup is the name of the OpenShift group the synchronised users are added to
+func (p *Policy) DevelopersGroup() string {
+	return p.developersGroup
+}
+
+// DryRun is true when the changes to the users of the products are planned without being applied
+func (p *Policy) DryRun() bool {
+	return p.dryRun
+}
+
+// MaxSyncChanges is the number of changes applied to the users of a product per sync
+func (p *Policy) MaxSyncChanges() int {
+	return p.maxSyncChanges
+}
+
+// SyncChangesPerSecond is the number of changes applied to the users of a product per second
+func (p *Policy) SyncChangesPerSecond() int {
+	return p.syncChangesPerSecond
+}
+
+// AdminGroups are the names of the OpenShift groups whose members are admins of the product
+func (p *Policy) AdminGroups(product integreatlyv1alpha1.ProductName) []string {
+	if groups, ok := p.productAdmins[product]; ok {
+		return groups
+	}
+	return p.adminGroups
+}
+
+// AdminSubjects are the role binding subjects of the admin groups of the product
+func (p *Policy) AdminSubjects(product integreatlyv1alpha1.ProductName) []rbacv1.Subject {
+	subjects := []rbacv1.Subject{}
+	for _, group := range p.AdminGroups(product) {
+		subjects = append(subjects, rbacv1.Subject{
+			Name: group,
+			Kind: "Group",
+		})
+	}
+	return subjects
+}
+
+// IsExcluded is true for users in an exclusion group or not matching the user selector
+func (p *Policy) IsExcluded(user usersv1.User, groups *usersv1.GroupList) bool {
+	if !p.userSelector.Matches(labels.Set(user.Labels)) {
+		return true
+	}
+	return inGroups(user.Name, p.exclusionGroups, groups)
+}
+
+// IsAdmin is true for users in one of the admin groups of the product. User names are compared case insensitively,
+// as some products lower case them
+func (p *Policy) IsAdmin(product integreatlyv1alpha1.ProductName, userName string, groups *usersv1.GroupList) bool {
+	return inGroups(userName, p.AdminGroups(product), groups)
+}
+
+// Admins returns the users in one of the admin groups of the product
+func (p *Policy) Admins(product integreatlyv1alpha1.ProductName, users []usersv1.User, groups *usersv1.GroupList) []usersv1.User {
+	var admins []usersv1.User
+	for _, user := range users {
+		if p.IsAdmin(product, user.Name, groups) {
+			admins = append(admins, user)
+		}
+	}
+	return admins
+}
+
+// Developers returns the users synchronised to the products
+func (p *Policy) Developers(users []usersv1.User, groups *usersv1.GroupList) []usersv1.User {
+	var developers []usersv1.User
+	for _, user := range users {
+		if !p.IsExcluded(user, groups) {
+			developers = append(developers, user)
+		}
+	}
+	return developers
+}
+
+// NOTE: The users type has a Groups field on it but it does not seem to get populated
+// hence the need to check the members of the groups by name
+func inGroups(userName string, groupNames []string, groups *usersv1.GroupList) bool {
+	for _, group := range groups.Items {
+		if !contains(groupNames, group.Name) {
+			continue
+		}
+		for _, groupUser := range group.Users {
+			if strings.EqualFold(groupUser, userName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/resources"
+	"github.com/integr8ly/integreatly-operator/pkg/resources/global"
+	userv1 "github.com/openshift/api/user/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testGroups() *userv1.GroupList {
+	return &userv1.GroupList{
+		Items: []userv1.Group{
+			{ObjectMeta: v1.ObjectMeta{Name: "osd-sre-admins"}, Users: []string{"sre"}},
+			{ObjectMeta: v1.ObjectMeta{Name: "dedicated-admins"}, Users: []string{"Admin"}},
+			{ObjectMeta: v1.ObjectMeta{Name: "api-admins"}, Users: []string{"api-admin"}},
+			{ObjectMeta: v1.ObjectMeta{Name: "contractors"}, Users: []string{"contractor"}},
+		},
+	}
+}
+
+func testUsers() []userv1.User {
+	return []userv1.User{
+		{ObjectMeta: v1.ObjectMeta{Name: "sre"}},
+		{ObjectMeta: v1.ObjectMeta{Name: "admin"}},
+		{ObjectMeta: v1.ObjectMeta{Name: "api-admin", Labels: map[string]string{"rhmi": "true"}}},
+		{ObjectMeta: v1.ObjectMeta{Name: "contractor", Labels: map[string]string{"rhmi": "true"}}},
+	}
+}
+
+func userNames(users []userv1.User) []string {
+	names := []string{}
+	for _, user := range users {
+		names = append(names, user.Name)
+	}
+	return names
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	policy := DefaultPolicy()
+
+	if policy.DevelopersGroup() != DefaultDevelopersGroup {
+		t.Fatalf("expected the developers group to be %s, got %s", DefaultDevelopersGroup, policy.DevelopersGroup())
+	}
+	if developers := userNames(policy.Developers(testUsers(), testGroups())); len(developers) != 3 || contains(developers, "sre") {
+		t.Fatalf("expected every user but the sre to be a developer, got %v", developers)
+	}
+	for _, product := range integreatlyv1alpha1.UserRoleProducts {
+		if admins := userNames(policy.Admins(product, testUsers(), testGroups())); len(admins) != 1 || admins[0] != "admin" {
+			t.Fatalf("expected the dedicated admins to be the admins of %s, got %v", product, admins)
+		}
+	}
+	if policy.MaxSyncChanges() != DefaultMaxSyncChanges || policy.SyncChangesPerSecond() != DefaultSyncChangesPerSecond {
+		t.Fatalf("expected the default sync settings, got %d changes at %d per second", policy.MaxSyncChanges(), policy.SyncChangesPerSecond())
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	policy, err := NewPolicy(integreatlyv1alpha1.UserPolicy{
+		ExclusionGroups: []string{"contractors"},
+		UserSelector:    &v1.LabelSelector{MatchLabels: map[string]string{"rhmi": "true"}},
+		DevelopersGroup: "rhmi-developers-team",
+		Products: []integreatlyv1alpha1.ProductUserRoles{
+			{Product: integreatlyv1alpha1.Product3Scale, AdminGroups: []string{"api-admins"}},
+		},
+		Sync: integreatlyv1alpha1.UserSyncPolicy{MaxChanges: 20, ChangesPerSecond: 2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if policy.DevelopersGroup() != "rhmi-developers-team" {
+		t.Fatalf("expected the developers group to be rhmi-developers-team, got %s", policy.DevelopersGroup())
+	}
+	if developers := userNames(policy.Developers(testUsers(), testGroups())); len(developers) != 1 || developers[0] != "api-admin" {
+		t.Fatalf("expected only the selected users outside the exclusion groups to be rhmi-developers-team, got %v", developers)
+	}
+	if !policy.IsAdmin(integreatlyv1alpha1.Product3Scale, "API-Admin", testGroups()) || policy.IsAdmin(integreatlyv1alpha1.Product3Scale, "admin", testGroups()) {
+		t.Fatal("expected the admin groups of 3scale to replace the dedicated admins")
+	}
+	if !policy.IsAdmin(integreatlyv1alpha1.ProductRHSSOUser, "admin", testGroups()) {
+		t.Fatal("expected the dedicated admins to be the admins of the other products")
+	}
+	if subjects := policy.AdminSubjects(integreatlyv1alpha1.Product3Scale); len(subjects) != 1 || subjects[0].Name != "api-admins" || subjects[0].Kind != "Group" {
+		t.Fatalf("expected the admin groups of 3scale as subjects, got %v", subjects)
+	}
+	if policy.MaxSyncChanges() != 20 || policy.SyncChangesPerSecond() != 2 {
+		t.Fatalf("expected the sync settings of the policy, got %d changes at %d per second", policy.MaxSyncChanges(), policy.SyncChangesPerSecond())
+	}
+
+	if _, err := NewPolicy(integreatlyv1alpha1.UserPolicy{DevelopersGroup: "rhmi-developers-admins", AdminGroups: []string{"rhmi-developers-admins"}}); err == nil {
+		t.Fatal("expected an error for an invalid policy")
+	}
+}
+
+func TestGetPolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := integreatlyv1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatalf("Error creating build scheme")
+	}
+
+	config := &integreatlyv1alpha1.RHMIConfig{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      resources.RHMIConfigName,
+			Namespace: global.NamespacePrefix + "operator",
+		},
+		Spec: integreatlyv1alpha1.RHMIConfigSpec{
+			Users: integreatlyv1alpha1.UserPolicy{DevelopersGroup: "rhmi-developers-team"},
+		},
+	}
+
+	policy, err := GetPolicy(context.TODO(), fake.NewFakeClientWithScheme(scheme))
+	if err != nil || policy.DevelopersGroup() != DefaultDevelopersGroup {
+		t.Fatalf("expected the default policy without a rhmi config, got %v: %v", policy, err)
+	}
+
+	policy, err = GetPolicy(context.TODO(), fake.NewFakeClientWithScheme(scheme, config))
+	if err != nil || policy.DevelopersGroup() != "rhmi-developers-team" {
+		t.Fatalf("expected the policy of the rhmi config, got %v: %v", policy, err)
+	}
+
+	config.Spec.Users.AdminGroups = []string{"rhmi-developers-team"}
+	policy, err = GetPolicy(context.TODO(), fake.NewFakeClientWithScheme(scheme, config))
+	if err != nil || policy.DevelopersGroup() != DefaultDevelopersGroup {
+		t.Fatalf("expected the default policy for an invalid users policy, got %v: %v", policy, err)
+	}
+}
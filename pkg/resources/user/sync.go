@@ -0,0 +1,130 @@
+package user
+
+import (
+	"context"
+	"sort"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/integreatly-operator/pkg/metrics"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncChange is a change to a user of a product, made by Apply
+type SyncChange struct {
+	Action integreatlyv1alpha1.UserSyncAction
+	User   string
+	Apply  func(ctx context.Context) error
+}
+
+// Sync applies the changes planned by the user sync of a product. Each change is applied on its own, a failed change
+// is reported without stopping the others and is planned again by the next sync as the users still differ. Changes
+// beyond the max changes of the policy are deferred to the next sync, and none are applied in a dry run
+type Sync struct {
+	target     integreatlyv1alpha1.ProductName
+	dryRun     bool
+	maxChanges int
+	limiter    *rate.Limiter
+	logger     *logrus.Entry
+
+	attempted int
+	capped    bool
+	changes   []integreatlyv1alpha1.UserSyncChange
+	counts    map[integreatlyv1alpha1.UserSyncAction]map[integreatlyv1alpha1.UserSyncResult]int
+}
+
+// NewSync returns a sync of the users of the target product following the sync settings of the policy
+func NewSync(target integreatlyv1alpha1.ProductName, policy *Policy, logger *logrus.Entry) *Sync {
+	return &Sync{
+		target:     target,
+		dryRun:     policy.DryRun(),
+		maxChanges: policy.MaxSyncChanges(),
+		limiter:    rate.NewLimiter(rate.Limit(policy.SyncChangesPerSecond()), 1),
+		logger:     logger,
+		counts:     map[integreatlyv1alpha1.UserSyncAction]map[integreatlyv1alpha1.UserSyncResult]int{},
+	}
+}
+
+// Apply applies the changes ordered by action and user name, so that the same changes are deferred by every sync.
+// It can be called several times, when a plan depends on the changes of a previous one, the max changes covering
+// every call. The results of the changes are returned in the order they were applied
+func (s *Sync) Apply(ctx context.Context, changes []SyncChange) []integreatlyv1alpha1.UserSyncChange {
+	sorted := append([]SyncChange{}, changes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Action != sorted[j].Action {
+			return actionOrder(sorted[i].Action) < actionOrder(sorted[j].Action)
+		}
+		return sorted[i].User < sorted[j].User
+	})
+
+	results := []integreatlyv1alpha1.UserSyncChange{}
+	for _, change := range sorted {
+		result := integreatlyv1alpha1.UserSyncChange{Action: change.Action, User: change.User}
+		switch {
+		case s.dryRun:
+			result.Result = integreatlyv1alpha1.UserSyncPlanned
+		case s.attempted >= s.maxChanges:
+			if !s.capped {
+				s.capped = true
+				s.logger.Warnf("Reached the max of %d changes to the users of %s, the remaining changes are deferred to the next sync", s.maxChanges, s.target)
+			}
+			result.Result = integreatlyv1alpha1.UserSyncDeferred
+		default:
+			s.attempted++
+			result.Result = integreatlyv1alpha1.UserSyncApplied
+			err := s.limiter.Wait(ctx)
+			if err == nil {
+				err = change.Apply(ctx)
+			}
+			if err != nil {
+				result.Result = integreatlyv1alpha1.UserSyncFailed
+				result.Message = err.Error()
+				s.logger.Errorf("Failed to %s user %s of %s: %v", change.Action, change.User, s.target, err)
+			}
+		}
+		s.record(result)
+		results = append(results, result)
+	}
+	return results
+}
+
+// Status returns the status of the changes applied so far, listing at most integreatlyv1alpha1.MaxUserSyncChanges of
+// them, and exposes them as metrics
+func (s *Sync) Status() integreatlyv1alpha1.UserSyncStatus {
+	status := integreatlyv1alpha1.UserSyncStatus{
+		Target:   s.target,
+		LastSync: metav1.Now(),
+		DryRun:   s.dryRun,
+	}
+	for _, results := range s.counts {
+		status.Planned += results[integreatlyv1alpha1.UserSyncPlanned]
+		status.Applied += results[integreatlyv1alpha1.UserSyncApplied]
+		status.Failed += results[integreatlyv1alpha1.UserSyncFailed]
+		status.Deferred += results[integreatlyv1alpha1.UserSyncDeferred]
+	}
+	status.Changes = s.changes
+	if len(status.Changes) > integreatlyv1alpha1.MaxUserSyncChanges {
+		status.Changes = status.Changes[:integreatlyv1alpha1.MaxUserSyncChanges]
+	}
+
+	metrics.SetUserSync(string(s.target), s.counts, status.LastSync.Time)
+	return status
+}
+
+func (s *Sync) record(change integreatlyv1alpha1.UserSyncChange) {
+	if s.counts[change.Action] == nil {
+		s.counts[change.Action] = map[integreatlyv1alpha1.UserSyncResult]int{}
+	}
+	s.counts[change.Action][change.Result]++
+	s.changes = append(s.changes, change)
+}
+
+func actionOrder(action integreatlyv1alpha1.UserSyncAction) int {
+	for i, a := range integreatlyv1alpha1.UserSyncActions {
+		if a == action {
+			return i
+		}
+	}
+	return len(integreatlyv1alpha1.UserSyncActions)
+}
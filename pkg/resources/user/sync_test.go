@@ -0,0 +1,131 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+func testPolicy(t *testing.T, sync integreatlyv1alpha1.UserSyncPolicy) *Policy {
+	policy, err := NewPolicy(integreatlyv1alpha1.UserPolicy{Sync: sync})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return policy
+}
+
+// testChanges returns a change of each action, recording the users they were applied to
+func testChanges(applied *[]string, failing string) []SyncChange {
+	changes := []SyncChange{}
+	for _, change := range []struct {
+		action integreatlyv1alpha1.UserSyncAction
+		user   string
+	}{
+		{integreatlyv1alpha1.UserSyncDemote, "dan"},
+		{integreatlyv1alpha1.UserSyncDelete, "carol"},
+		{integreatlyv1alpha1.UserSyncAdd, "bob"},
+		{integreatlyv1alpha1.UserSyncAdd, "alice"},
+		{integreatlyv1alpha1.UserSyncPromote, "erin"},
+	} {
+		user := change.user
+		changes = append(changes, SyncChange{
+			Action: change.action,
+			User:   user,
+			Apply: func(ctx context.Context) error {
+				if user == failing {
+					return errors.New("api unavailable")
+				}
+				*applied = append(*applied, user)
+				return nil
+			},
+		})
+	}
+	return changes
+}
+
+func TestSync(t *testing.T) {
+	applied := []string{}
+	sync := NewSync(integreatlyv1alpha1.ProductRHSSO, DefaultPolicy(), logrus.NewEntry(logrus.New()))
+
+	results := sync.Apply(context.TODO(), testChanges(&applied, "carol"))
+	if fmt.Sprint(applied) != "[alice bob erin dan]" {
+		t.Fatalf("expected the changes to be applied by action and user, the failed deletion not stopping the others, got %v", applied)
+	}
+	if results[2].Action != integreatlyv1alpha1.UserSyncDelete || results[2].Result != integreatlyv1alpha1.UserSyncFailed || results[2].Message != "api unavailable" {
+		t.Fatalf("expected the deletion to fail, got %v", results[2])
+	}
+
+	status := sync.Status()
+	if status.Target != integreatlyv1alpha1.ProductRHSSO || status.DryRun || status.Applied != 4 || status.Failed != 1 || len(status.Changes) != 5 {
+		t.Fatalf("expected 4 applied and 1 failed change in the status, got %v", status)
+	}
+}
+
+func TestSyncDryRun(t *testing.T) {
+	applied := []string{}
+	sync := NewSync(integreatlyv1alpha1.ProductRHSSO, testPolicy(t, integreatlyv1alpha1.UserSyncPolicy{DryRun: true}), logrus.NewEntry(logrus.New()))
+
+	for _, result := range sync.Apply(context.TODO(), testChanges(&applied, "")) {
+		if result.Result != integreatlyv1alpha1.UserSyncPlanned {
+			t.Fatalf("expected every change to be planned, got %v", result)
+		}
+	}
+	if len(applied) != 0 {
+		t.Fatalf("expected no change to be applied in a dry run, got %v", applied)
+	}
+	if status := sync.Status(); !status.DryRun || status.Planned != 5 || status.Applied != 0 {
+		t.Fatalf("expected 5 planned changes in the status, got %v", status)
+	}
+}
+
+func TestSyncMaxChanges(t *testing.T) {
+	applied := []string{}
+	sync := NewSync(integreatlyv1alpha1.Product3Scale, testPolicy(t, integreatlyv1alpha1.UserSyncPolicy{MaxChanges: 3}), logrus.NewEntry(logrus.New()))
+
+	sync.Apply(context.TODO(), testChanges(&applied, "alice"))
+	if fmt.Sprint(applied) != "[bob carol]" {
+		t.Fatalf("expected the failed change to count towards the max changes, got %v", applied)
+	}
+
+	// the max changes cover every call of the sync
+	results := sync.Apply(context.TODO(), testChanges(&applied, ""))
+	for _, result := range results {
+		if result.Result != integreatlyv1alpha1.UserSyncDeferred {
+			t.Fatalf("expected every change beyond the max changes to be deferred, got %v", result)
+		}
+	}
+
+	status := sync.Status()
+	if status.Applied != 2 || status.Failed != 1 || status.Deferred != 7 {
+		t.Fatalf("expected 2 applied, 1 failed and 7 deferred changes in the status, got %v", status)
+	}
+}
+
+func TestSyncStatusChanges(t *testing.T) {
+	changes := []SyncChange{}
+	for i := 0; i < integreatlyv1alpha1.MaxUserSyncChanges+10; i++ {
+		changes = append(changes, SyncChange{
+			Action: integreatlyv1alpha1.UserSyncDelete,
+			User:   fmt.Sprintf("user-%03d", i),
+		})
+	}
+	sync := NewSync(integreatlyv1alpha1.ProductRHSSOUser, testPolicy(t, integreatlyv1alpha1.UserSyncPolicy{DryRun: true}), logrus.NewEntry(logrus.New()))
+	sync.Apply(context.TODO(), changes)
+
+	status := sync.Status()
+	if status.Planned != integreatlyv1alpha1.MaxUserSyncChanges+10 || len(status.Changes) != integreatlyv1alpha1.MaxUserSyncChanges {
+		t.Fatalf("expected every change to be counted and %d to be listed, got %d counted and %d listed", integreatlyv1alpha1.MaxUserSyncChanges, status.Planned, len(status.Changes))
+	}
+
+	installation := &integreatlyv1alpha1.RHMI{}
+	installation.SetUserSyncStatus(integreatlyv1alpha1.UserSyncStatus{Target: integreatlyv1alpha1.ProductRHSSOUser})
+	installation.SetUserSyncStatus(integreatlyv1alpha1.UserSyncStatus{Target: integreatlyv1alpha1.Product3Scale})
+	installation.SetUserSyncStatus(status)
+	if len(installation.Status.UserSync) != 2 || installation.Status.UserSync[0].Planned != status.Planned {
+		t.Fatalf("expected the status of the sync to replace the previous one of the target, got %v", installation.Status.UserSync)
+	}
+}
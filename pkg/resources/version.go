@@ -1,12 +1,18 @@
 package resources
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+
+	coreosv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Version struct {
@@ -43,3 +49,56 @@ func (v *Version) IsNewerThan(other *Version) bool {
 func (v *Version) AsString() string {
 	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
 }
+
+// productVersionLabel is the label set by Red Hat operators on the pods of their operands with the version of the
+// product they install
+const productVersionLabel = "com.redhat.product-version"
+
+// GetInstalledOperatorVersion reads the version of the operator installed by a subscription from the CSV the
+// subscription installed. An empty version is returned while the subscription has not installed a CSV
+func GetInstalledOperatorVersion(ctx context.Context, serverClient k8sclient.Client, subscriptionName, namespace string) (integreatlyv1alpha1.OperatorVersion, error) {
+	csv, err := getInstalledCSV(ctx, serverClient, subscriptionName, namespace)
+	if err != nil || csv == nil {
+		return "", err
+	}
+	return integreatlyv1alpha1.OperatorVersion(csv.Spec.Version.String()), nil
+}
+
+// GetInstalledProductVersion reads the version of the product installed by a subscription from the product version
+// label of the deployments of the CSV the subscription installed. An empty version is returned while the subscription
+// has not installed a CSV, or when the CSV does not label its deployments with the product version
+func GetInstalledProductVersion(ctx context.Context, serverClient k8sclient.Client, subscriptionName, namespace string) (integreatlyv1alpha1.ProductVersion, error) {
+	csv, err := getInstalledCSV(ctx, serverClient, subscriptionName, namespace)
+	if err != nil || csv == nil {
+		return "", err
+	}
+	for _, deployment := range csv.Spec.InstallStrategy.StrategySpec.DeploymentSpecs {
+		if version, ok := deployment.Spec.Template.Labels[productVersionLabel]; ok {
+			return integreatlyv1alpha1.ProductVersion(version), nil
+		}
+	}
+	return "", nil
+}
+
+// getInstalledCSV returns the CSV installed by a subscription, or nil while the subscription has not installed one
+func getInstalledCSV(ctx context.Context, serverClient k8sclient.Client, subscriptionName, namespace string) (*coreosv1alpha1.ClusterServiceVersion, error) {
+	subscription := &coreosv1alpha1.Subscription{}
+	if err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: subscriptionName, Namespace: namespace}, subscription); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get subscription %s: %w", subscriptionName, err)
+	}
+	if subscription.Status.InstalledCSV == "" {
+		return nil, nil
+	}
+
+	csv := &coreosv1alpha1.ClusterServiceVersion{}
+	if err := serverClient.Get(ctx, k8sclient.ObjectKey{Name: subscription.Status.InstalledCSV, Namespace: namespace}, csv); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get csv %s: %w", subscription.Status.InstalledCSV, err)
+	}
+	return csv, nil
+}
@@ -1,9 +1,18 @@
 package resources
 
 import (
+	"context"
 	"testing"
 
+	"github.com/blang/semver"
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+
+	alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/apis/operators/v1alpha1"
+	olmversion "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/version"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestVersion(t *testing.T) {
@@ -301,3 +310,113 @@ func TestComparisons(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInstalledOperatorVersion(t *testing.T) {
+	scheme, err := buildScheme()
+	if err != nil {
+		t.Fatalf("error creating scheme: %s", err.Error())
+	}
+
+	subscription := func(installedCSV string) *alpha1.Subscription {
+		return &alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "rhmi-test", Namespace: "test-ns"},
+			Status:     alpha1.SubscriptionStatus{InstalledCSV: installedCSV},
+		}
+	}
+	csv := &alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-operator.v1.2.0", Namespace: "test-ns"},
+		Spec: alpha1.ClusterServiceVersionSpec{
+			Version: olmversion.OperatorVersion{Version: semver.MustParse("1.2.0")},
+		},
+	}
+
+	cases := []struct {
+		Name            string
+		Objects         []runtime.Object
+		ExpectedVersion integreatlyv1alpha1.OperatorVersion
+	}{
+		{
+			Name:            "test version is read from the installed csv",
+			Objects:         []runtime.Object{subscription("test-operator.v1.2.0"), csv},
+			ExpectedVersion: "1.2.0",
+		},
+		{
+			Name:    "test no version while the subscription has not installed a csv",
+			Objects: []runtime.Object{subscription(""), csv},
+		},
+		{
+			Name: "test no version without a subscription",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			client := fakeclient.NewFakeClientWithScheme(scheme, tc.Objects...)
+			version, err := GetInstalledOperatorVersion(context.TODO(), client, "rhmi-test", "test-ns")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if version != tc.ExpectedVersion {
+				t.Fatalf("expected version %q but got %q", tc.ExpectedVersion, version)
+			}
+		})
+	}
+}
+
+func TestGetInstalledProductVersion(t *testing.T) {
+	scheme, err := buildScheme()
+	if err != nil {
+		t.Fatalf("error creating scheme: %s", err.Error())
+	}
+
+	subscription := &alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "rhmi-test", Namespace: "test-ns"},
+		Status:     alpha1.SubscriptionStatus{InstalledCSV: "test-operator.v1.2.0"},
+	}
+	csv := func(labels map[string]string) *alpha1.ClusterServiceVersion {
+		deployment := alpha1.StrategyDeploymentSpec{Name: "test-operator"}
+		deployment.Spec.Template.Labels = labels
+		return &alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-operator.v1.2.0", Namespace: "test-ns"},
+			Spec: alpha1.ClusterServiceVersionSpec{
+				InstallStrategy: alpha1.NamedInstallStrategy{
+					StrategySpec: alpha1.StrategyDetailsDeployment{
+						DeploymentSpecs: []alpha1.StrategyDeploymentSpec{deployment},
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name            string
+		Objects         []runtime.Object
+		ExpectedVersion integreatlyv1alpha1.ProductVersion
+	}{
+		{
+			Name:            "test version is read from the product version label of the installed csv",
+			Objects:         []runtime.Object{subscription, csv(map[string]string{"com.redhat.product-version": "2.9"})},
+			ExpectedVersion: "2.9",
+		},
+		{
+			Name:    "test no version when the installed csv has no product version label",
+			Objects: []runtime.Object{subscription, csv(nil)},
+		},
+		{
+			Name: "test no version without a subscription",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			client := fakeclient.NewFakeClientWithScheme(scheme, tc.Objects...)
+			version, err := GetInstalledProductVersion(context.TODO(), client, "rhmi-test", "test-ns")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if version != tc.ExpectedVersion {
+				t.Fatalf("expected version %q but got %q", tc.ExpectedVersion, version)
+			}
+		})
+	}
+}
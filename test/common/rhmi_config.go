@@ -31,81 +31,108 @@ type MaintenanceBackup struct {
 
 // this state check covers test case - A22
 // verify that the RHMIConfig validation webhook for Maintenance and Backup values work as expected
-var maintenanceBackupStates = map[MaintenanceBackup]func(*testing.T) func(error){
+var maintenanceBackupStates = []struct {
+	state     MaintenanceBackup
+	assertion func(*testing.T) func(error)
+}{
 	// we expect no error as blank strings will be set to default vals
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "",
+			},
 		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "",
-		},
-	}: assertNoError,
+		assertion: assertNoError,
+	},
 	// valid input format hh:mm and ddd hh:mm
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "20:05",
-		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "Sun 22:10",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "20:05",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "Sun 22:10",
+			},
 		},
-	}: assertNoError,
+		assertion: assertNoError,
+	},
 	// we expect an error due to both times being parsed as a 1 hour window
 	// for aws these windows can not overlap
 	// this state provides overlapping times
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "20:05",
-		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "Sun 20:15",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "20:05",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "Sun 20:15",
+			},
 		},
-	}: assertValidationError,
+		assertion: assertValidationError,
+	},
 	// another overlap check, we want to ensure we get an error from a single minute overlap
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "20:15",
-		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "Thu 19:16",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "20:15",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "Thu 19:16",
+			},
 		},
-	}: assertValidationError,
+		assertion: assertValidationError,
+	},
 	// we expect the following :
 	//  * Backup hh:mm
 	//  * Maintenance ddd hh:mm
 	// the following checks will verify malformed times
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "26:00",
-		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "Sun 12:05",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "26:00",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "Sun 12:05",
+			},
 		},
-	}: assertValidationError,
+		assertion: assertValidationError,
+	},
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "22:00",
-		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "Malformed 12:05",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "22:00",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "Malformed 12:05",
+			},
 		},
-	}: assertValidationError,
+		assertion: assertValidationError,
+	},
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "malformed",
-		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "Sun 20:00",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "malformed",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "Sun 20:00",
+			},
 		},
-	}: assertValidationError,
+		assertion: assertValidationError,
+	},
 	{
-		Backup: v1alpha1.Backup{
-			ApplyOn: "20:00",
-		},
-		Maintenance: v1alpha1.Maintenance{
-			ApplyFrom: "malformed",
+		state: MaintenanceBackup{
+			Backup: v1alpha1.Backup{
+				ApplyOn: "20:00",
+			},
+			Maintenance: v1alpha1.Maintenance{
+				ApplyFrom: "malformed",
+			},
 		},
-	}: assertValidationError,
+		assertion: assertValidationError,
+	},
 }
 
 var upgradeSectionStates = map[v1alpha1.Upgrade]func(*testing.T) func(error){
@@ -164,8 +191,9 @@ func TestRHMIConfigCRs(t *testing.T, ctx *TestingContext) {
 	}
 
 	// test for possible state changes for the Backup and Maintenance section
-	for state, assertion := range maintenanceBackupStates {
-		verifyRHMIConfigValidation(ctx.Client, assertion(t), func(cr *v1alpha1.RHMIConfig) {
+	for _, maintenanceBackup := range maintenanceBackupStates {
+		state := maintenanceBackup.state
+		verifyRHMIConfigValidation(ctx.Client, maintenanceBackup.assertion(t), func(cr *v1alpha1.RHMIConfig) {
 			cr.Spec.Maintenance.ApplyFrom = state.Maintenance.ApplyFrom
 			cr.Spec.Backup.ApplyOn = state.Backup.ApplyOn
 		})
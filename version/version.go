@@ -4,6 +4,7 @@ import (
 	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
 	"github.com/sirupsen/logrus"
 	"os"
+	"strings"
 )
 
 const (
@@ -15,21 +16,43 @@ var (
 	managedAPIVersion = "1.0.0"
 )
 
+// VerifyProductAndOperatorVersion checks the versions of a product match the expected versions. The observed versions
+// reported by the reconciler of the product are used when available, so a failed partial upgrade is not masked by the
+// versions recorded from the config of the product
 func VerifyProductAndOperatorVersion(product integreatlyv1alpha1.RHMIProductStatus, expectedProductVersion string, expectedOpVersion string) bool {
 	installedOpVersion := string(product.OperatorVersion)
+	if product.ObservedOperatorVersion != "" {
+		installedOpVersion = string(product.ObservedOperatorVersion)
+	}
 	installedProductVersion := string(product.Version)
+	if product.ObservedVersion != "" {
+		installedProductVersion = string(product.ObservedVersion)
+	}
 
-	if expectedOpVersion != installedOpVersion {
+	if !Matches(expectedOpVersion, installedOpVersion) {
 		logrus.Debugf("%s Operator Version is not as expected. Expected %s, Actual %s", product.Name, expectedOpVersion, installedOpVersion)
 		return false
 	}
-	if expectedProductVersion != installedProductVersion {
+	if !Matches(expectedProductVersion, installedProductVersion) {
 		logrus.Debugf("%s Version is not as expected. Expected %s, Actual %s", product.Name, expectedProductVersion, installedProductVersion)
 		return false
 	}
 	return true
 }
 
+// Matches checks if an installed version matches an expected version. Installed versions are often more specific than
+// the expected ones, e.g. the observed version 7.4.2.GA matches the expected version 7.4
+func Matches(expected, installed string) bool {
+	if expected == installed {
+		return true
+	}
+	if !strings.HasPrefix(installed, expected) || expected == "" {
+		return false
+	}
+	separator := installed[len(expected)]
+	return separator == '.' || separator == '-'
+}
+
 func GetVersion() string {
 	installTypeEnv, _ := os.LookupEnv(installTypeEnvName)
 
@@ -0,0 +1,77 @@
+package version
+
+import (
+	"testing"
+
+	integreatlyv1alpha1 "github.com/integr8ly/integreatly-operator/pkg/apis/integreatly/v1alpha1"
+)
+
+func TestMatches(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Expected  string
+		Installed string
+		Matches   bool
+	}{
+		{Name: "test equal versions match", Expected: "1.2.3", Installed: "1.2.3", Matches: true},
+		{Name: "test more specific installed version matches", Expected: "7.4", Installed: "7.4.2.GA", Matches: true},
+		{Name: "test pre-release installed version matches", Expected: "0.5.1", Installed: "0.5.1-alpha", Matches: true},
+		{Name: "test different minor version does not match", Expected: "7.4", Installed: "7.41", Matches: false},
+		{Name: "test older installed version does not match", Expected: "2.9", Installed: "2.8.1", Matches: false},
+		{Name: "test empty expected version does not match", Expected: "", Installed: "1.0", Matches: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if matches := Matches(tc.Expected, tc.Installed); matches != tc.Matches {
+				t.Fatalf("expected Matches(%q, %q) to be %v", tc.Expected, tc.Installed, tc.Matches)
+			}
+		})
+	}
+}
+
+func TestVerifyProductAndOperatorVersion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Product  integreatlyv1alpha1.RHMIProductStatus
+		Verified bool
+	}{
+		{
+			Name:     "test recorded versions are verified",
+			Product:  integreatlyv1alpha1.RHMIProductStatus{Version: "7.4", OperatorVersion: "10.0.0"},
+			Verified: true,
+		},
+		{
+			Name: "test observed versions are verified",
+			Product: integreatlyv1alpha1.RHMIProductStatus{
+				Version:                 "7.4",
+				OperatorVersion:         "10.0.0",
+				ObservedVersion:         "7.4.2.GA",
+				ObservedOperatorVersion: "10.0.0",
+			},
+			Verified: true,
+		},
+		{
+			Name: "test observed operator version of a failed upgrade is not verified",
+			Product: integreatlyv1alpha1.RHMIProductStatus{
+				Version:                 "7.4",
+				OperatorVersion:         "10.0.0",
+				ObservedOperatorVersion: "9.0.0",
+			},
+			Verified: false,
+		},
+		{
+			Name:     "test different recorded version is not verified",
+			Product:  integreatlyv1alpha1.RHMIProductStatus{Version: "7.3", OperatorVersion: "10.0.0"},
+			Verified: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if verified := VerifyProductAndOperatorVersion(tc.Product, "7.4", "10.0.0"); verified != tc.Verified {
+				t.Fatalf("expected the product versions to be verified: %v", tc.Verified)
+			}
+		})
+	}
+}